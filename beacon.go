@@ -0,0 +1,103 @@
+package isbot
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JS is a script to detect headless browsers and automation tools such as
+// Selenium, Puppeteer, and Playwright. It sends one of the BotJS* constants (or
+// 0) to a Beacon.
+//
+// This is a copy of isbot.js; serve it from your application.
+//
+//go:embed isbot.js
+var JS string
+
+// Beacon is a HTTP handler to receive the result from JS and BehaviourJS.
+//
+// Every page view gets a signed nonce from Nonce(), which is passed to the
+// script. This ties the result to the page view and the client, and ensures
+// that clients can't report results for page views that never happened. The
+// nonce is only valid for the same User-Agent and the same /24 (IPv4) or /64
+// (IPv6) prefix. The same nonce may be posted more than once, so Record should
+// only use the first result for a page view.
+type Beacon struct {
+	// Key to sign the nonce with. This must be set, and should be at least 32
+	// random bytes.
+	Key []byte
+
+	// How long a nonce is valid for; the default is 1 hour.
+	MaxAge time.Duration
+
+	// Resolver to get the client address; the default is to use
+	// r.RemoteAddr.
+	Resolver Resolver
+
+	// Record the result; id is the page view ID as passed to Nonce(), and res
+	// is either NoBotKnown or one of the BotJS* constants.
+	Record func(r *http.Request, id string, res Result)
+
+	// RecordBehaviour records what was sent by BehaviourJS, along with the
+	// result and confidence from Behaviour.Score(). This is optional; if it's
+	// nil then behaviour is rejected.
+	RecordBehaviour func(r *http.Request, id string, b Behaviour, res Result, confidence float64)
+}
+
+// Nonce creates a signed nonce for the page view with the given ID, for the
+// client that sent r.
+func (b *Beacon) Nonce(r *http.Request, id string) string {
+	return signToken(b.Key, "beacon", time.Now().Add(b.maxAge()), b.binding(r)+":"+id)
+}
+
+func (b *Beacon) binding(r *http.Request) string {
+	return clientBinding((&Detector{Resolver: b.Resolver}).ClientIP(r), r.UserAgent())
+}
+
+func (b *Beacon) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "isbot: method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, 16384)
+	data, ok := verifyToken(b.Key, "beacon", r.PostFormValue("nonce"), time.Now())
+	bind, id, _ := strings.Cut(data, ":")
+	if !ok || len(b.Key) == 0 || bind != b.binding(r) {
+		http.Error(w, "isbot: invalid nonce", http.StatusBadRequest)
+		return
+	}
+
+	if bh := r.PostFormValue("behaviour"); bh != "" {
+		var beh Behaviour
+		if b.RecordBehaviour == nil || json.Unmarshal([]byte(bh), &beh) != nil || !beh.Valid() {
+			http.Error(w, "isbot: invalid behaviour", http.StatusBadRequest)
+			return
+		}
+		res, conf := beh.Score()
+		b.RecordBehaviour(r, id, beh, res, conf)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	code, err := strconv.ParseUint(r.PostFormValue("code"), 10, 8)
+	if res := Result(code); b.Record == nil || err != nil || (res != NoBotKnown && !IsJS(res)) {
+		http.Error(w, "isbot: invalid code", http.StatusBadRequest)
+		return
+	}
+
+	b.Record(r, id, Result(code))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (b *Beacon) maxAge() time.Duration {
+	if b.MaxAge == 0 {
+		return time.Hour
+	}
+	return b.MaxAge
+}
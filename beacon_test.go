@@ -0,0 +1,91 @@
+package isbot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestBeacon(t *testing.T) {
+	var (
+		gotID  string
+		gotRes Result
+	)
+	b := &Beacon{
+		Key: []byte("secret"),
+		Record: func(r *http.Request, id string, res Result) {
+			gotID, gotRes = id, res
+		},
+	}
+	page := func(remote, ua string) *http.Request {
+		r := httptest.NewRequest("GET", "/", nil)
+		r.RemoteAddr = remote
+		r.Header.Set("User-Agent", ua)
+		return r
+	}
+	var (
+		ua       = "Mozilla/5.0 (X11; Linux x86_64; rv:75.0) Gecko/20100101 Firefox/75.0"
+		nonce    = b.Nonce(page("192.0.2.1:1234", ua), "page-42")
+		other    = (&Beacon{Key: []byte("other")}).Nonce(page("192.0.2.1:1234", ua), "page-42")
+		sameNet  = b.Nonce(page("192.0.2.99:1234", ua), "page-42")
+		otherNet = b.Nonce(page("198.51.100.1:1234", ua), "page-42")
+		otherUA  = b.Nonce(page("192.0.2.1:1234", ua+"x"), "page-42")
+		noKey    = (&Beacon{}).Nonce(page("192.0.2.1:1234", ua), "page-42")
+	)
+
+	tests := []struct {
+		method, nonce, code string
+		wantCode            int
+		wantRes             Result
+	}{
+		{"GET", nonce, "0", 405, 0},
+		{"POST", "", "0", 400, 0},
+		{"POST", other, "0", 400, 0},
+		{"POST", nonce + "x", "0", 400, 0},
+		{"POST", otherNet, "0", 400, 0},
+		{"POST", otherUA, "0", 400, 0},
+		{"POST", sameNet, "0", 204, NoBotKnown},
+		{"POST", nonce, "", 400, 0},
+		{"POST", nonce, "5", 400, 0},
+		{"POST", nonce, "256", 400, 0},
+		{"POST", nonce, "0", 204, NoBotKnown},
+		{"POST", nonce, "152", 204, BotJSSelenium},
+		{"POST", nonce, "156", 204, BotJSCDP},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			gotID, gotRes = "", 255
+			body := url.Values{"nonce": {tt.nonce}, "code": {tt.code}}.Encode()
+			r := httptest.NewRequest(tt.method, "/isbot", strings.NewReader(body))
+			r.RemoteAddr = "192.0.2.1:5678"
+			r.Header.Set("User-Agent", ua)
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rr := httptest.NewRecorder()
+			b.ServeHTTP(rr, r)
+
+			if rr.Code != tt.wantCode {
+				t.Fatalf("status %d; want %d: %s", rr.Code, tt.wantCode, rr.Body)
+			}
+			if tt.wantCode == 204 && (gotID != "page-42" || gotRes != tt.wantRes) {
+				t.Errorf("recorded %q %s; want %q %s", gotID, gotRes, "page-42", tt.wantRes)
+			}
+		})
+	}
+
+	body := url.Values{"nonce": {noKey}, "code": {"0"}}.Encode()
+	r := httptest.NewRequest("POST", "/isbot", strings.NewReader(body))
+	r.RemoteAddr = "192.0.2.1:5678"
+	r.Header.Set("User-Agent", ua)
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	(&Beacon{Record: func(*http.Request, string, Result) {}}).ServeHTTP(rr, r)
+	if rr.Code != 400 {
+		t.Errorf("no key: %d", rr.Code)
+	}
+
+	if !strings.Contains(JS, "return 157") || strings.Contains(JS, "plugins.length") {
+		t.Error("JS doesn't look right")
+	}
+}
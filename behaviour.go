@@ -0,0 +1,77 @@
+package isbot
+
+import (
+	_ "embed"
+	"time"
+)
+
+// BehaviourJS is a script to record some coarse interaction signals, which
+// are sent to a Beacon when the page is hidden.
+//
+// This is a copy of behaviour.js; serve it from your application.
+//
+//go:embed behaviour.js
+var BehaviourJS string
+
+// Behaviour is what BehaviourJS records.
+type Behaviour struct {
+	Pointer    bool    `json:"pointer"`    // Any pointer (mouse or touch) movement.
+	Scroll     bool    `json:"scroll"`     // Scrolled the page.
+	Keys       bool    `json:"keys"`       // Pressed any key.
+	Focus      int     `json:"focus"`      // Number of focus and blur events.
+	Visibility int     `json:"visibility"` // Number of visibility changes.
+	First      int     `json:"first"`      // Milliseconds until the first interaction; 0 if there was none.
+	Visible    int     `json:"visible"`    // Milliseconds the page was visible.
+	Events     int     `json:"events"`     // Number of clicks and key presses.
+	Jitter     float64 `json:"jitter"`     // Coefficient of variation of the time between clicks and key presses.
+}
+
+// Valid reports if all values are in range; BehaviourJS never sends negative
+// values.
+func (b Behaviour) Valid() bool {
+	return b.Focus >= 0 && b.Visibility >= 0 && b.First >= 0 && b.Visible >= 0 &&
+		b.Events >= 0 && b.Jitter >= 0
+}
+
+// Score the behaviour.
+//
+// It returns BotJSInhuman or BotJSNoInteraction if this looks like a bot, or
+// NoBotNoMatch otherwise. The confidence is between 0 and 1, and indicates how
+// sure we are about the result; it's 0 if there's not enough data or if the
+// behaviour isn't Valid().
+//
+// Stealthy bots can fake all of this, so this is best used together with
+// other checks.
+func (b Behaviour) Score() (Result, float64) {
+	var (
+		visible  = time.Duration(b.Visible) * time.Millisecond
+		interact = b.Pointer || b.Scroll || b.Keys || b.Events > 0
+	)
+	switch {
+	case !b.Valid():
+		return NoBotNoMatch, 0
+	// People aren't metronomes; the time between key presses and clicks
+	// varies quite a bit, whereas scripts usually use a fixed delay.
+	case b.Events >= 10 && b.Jitter < 0.05:
+		return BotJSInhuman, max(0, min(1, 1-b.Jitter*10))
+	// Nobody interacts with a page within 50ms after it's loaded.
+	case b.First > 0 && b.First < 50:
+		return BotJSInhuman, 0.6
+	// Didn't do anything; this could just be a short page, so only consider
+	// it after a while, and get more sure as time goes on.
+	case !interact:
+		if visible < 5*time.Second {
+			return NoBotNoMatch, 0
+		}
+		conf := 0.3 + 0.5*min(1, (visible-5*time.Second).Seconds()/55)
+		// People switch tabs and windows; the page is hidden once when it's
+		// closed, but it never lost focus or was hidden before that.
+		if b.Focus == 0 && b.Visibility <= 1 {
+			conf += 0.1
+		}
+		return BotJSNoInteraction, conf
+	}
+
+	// Some interaction, with nothing suspicious.
+	return NoBotNoMatch, min(0.9, 0.5+0.05*float64(b.Events))
+}
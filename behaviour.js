@@ -0,0 +1,81 @@
+// behaviour.js records some coarse interaction signals, and sends them to a
+// Beacon when the page is hidden.
+//
+// Load it with the beacon URL and a nonce from Beacon.Nonce():
+//
+//   <script src="/behaviour.js" data-beacon="/isbot" data-nonce="…"></script>
+//
+// Nothing identifying is recorded: just if there was any pointer movement,
+// scrolling, or key presses, the number of focus and visibility changes, and
+// the timing between clicks and key presses.
+(function() {
+	'use strict';
+
+	var w = window, d = document, s = d.currentScript
+	if (!s || !s.dataset.beacon)
+		return
+
+	var start = performance.now(),
+		visibleSince = d.visibilityState === 'visible' ? start : -1,
+		last = 0, intervals = [], sent = false,
+		b = {pointer: false, scroll: false, keys: false, focus: 0, visibility: 0, first: 0, visible: 0, events: 0, jitter: 0}
+
+	var interact = function(now) {
+		if (!b.first)
+			b.first = Math.max(1, Math.round(now - start))
+	}
+	var flag = function(k) {
+		return function() {
+			b[k] = true
+			interact(performance.now())
+		}
+	}
+	// Clicks and key presses are discrete events, so the intervals between
+	// them are meaningful; mouse movement and scrolling fire on every frame.
+	var discrete = function(e) {
+		var now = performance.now()
+		if (e.type === 'keydown')
+			b.keys = true
+		interact(now)
+		if (last && intervals.length < 100)
+			intervals.push(now - last)
+		last = now
+		b.events++
+	}
+
+	var send = function() {
+		if (sent)
+			return
+		sent = true
+		if (visibleSince >= 0)
+			b.visible += performance.now() - visibleSince
+		b.visible = Math.round(b.visible)
+		if (intervals.length > 1) {
+			var mean = intervals.reduce(function(a, b) { return a + b }, 0) / intervals.length
+			var variance = intervals.reduce(function(a, b) { return a + (b - mean) * (b - mean) }, 0) / intervals.length
+			b.jitter = mean > 0 ? Math.sqrt(variance) / mean : 0
+		}
+
+		var body = new URLSearchParams({nonce: s.dataset.nonce || '', behaviour: JSON.stringify(b)})
+		if (!navigator.sendBeacon || !navigator.sendBeacon(s.dataset.beacon, body))
+			fetch(s.dataset.beacon, {method: 'POST', body: body, keepalive: true})
+	}
+
+	var opt = {passive: true, capture: true}
+	w.addEventListener('pointermove', flag('pointer'), opt)
+	w.addEventListener('touchmove', flag('pointer'), opt)
+	w.addEventListener('scroll', flag('scroll'), opt)
+	w.addEventListener('wheel', flag('scroll'), opt)
+	w.addEventListener('pointerdown', discrete, opt)
+	w.addEventListener('keydown', discrete, opt)
+	w.addEventListener('focus', function() { b.focus++ }, opt)
+	w.addEventListener('blur', function() { b.focus++ }, opt)
+	d.addEventListener('visibilitychange', function() {
+		b.visibility++
+		if (d.visibilityState === 'visible')
+			visibleSince = performance.now()
+		else
+			send()
+	})
+	w.addEventListener('pagehide', send)
+})();
@@ -0,0 +1,74 @@
+package isbot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestBehaviourScore(t *testing.T) {
+	tests := []struct {
+		in       Behaviour
+		want     Result
+		min, max float64
+	}{
+		{Behaviour{}, NoBotNoMatch, 0, 0},
+		{Behaviour{Visible: 3000}, NoBotNoMatch, 0, 0},
+		{Behaviour{Visible: 10_000}, BotJSNoInteraction, 0.4, 0.5},
+		{Behaviour{Visible: 120_000}, BotJSNoInteraction, 0.9, 0.9},
+		{Behaviour{Visible: 120_000, Visibility: 1}, BotJSNoInteraction, 0.9, 0.9},
+		{Behaviour{Visible: 120_000, Focus: 2, Visibility: 3}, BotJSNoInteraction, 0.8, 0.8},
+		{Behaviour{Visible: 120_000, Focus: -1}, NoBotNoMatch, 0, 0},
+		{Behaviour{Visible: 120_000, Pointer: true, First: 20}, BotJSInhuman, 0.6, 0.6},
+		{Behaviour{Visible: 120_000, Keys: true, First: 900, Events: 30, Jitter: 0.01}, BotJSInhuman, 0.9, 0.9},
+		{Behaviour{Visible: 120_000, Keys: true, First: 900, Events: 30, Jitter: 0.4}, NoBotNoMatch, 0.9, 0.9},
+		{Behaviour{Visible: 4000, Scroll: true, First: 1200}, NoBotNoMatch, 0.5, 0.5},
+		{Behaviour{Visible: 120_000, Keys: true, First: 900, Events: 30, Jitter: 0}, BotJSInhuman, 1, 1},
+		{Behaviour{Visible: 120_000, Keys: true, First: 900, Events: 30, Jitter: -5}, NoBotNoMatch, 0, 0},
+		{Behaviour{Visible: -1}, NoBotNoMatch, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			got, conf := tt.in.Score()
+			if got != tt.want || conf < tt.min-0.001 || conf > tt.max+0.001 {
+				t.Errorf("got %s %.2f; want %s %.2f-%.2f", got, conf, tt.want, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestBeaconBehaviour(t *testing.T) {
+	var got Result
+	b := &Beacon{
+		Key: []byte("secret"),
+		RecordBehaviour: func(r *http.Request, id string, b Behaviour, res Result, confidence float64) {
+			got = res
+		},
+	}
+
+	for _, tt := range []struct {
+		behaviour string
+		wantCode  int
+		wantRes   Result
+	}{
+		{`{"visible": 60000}`, 204, BotJSNoInteraction},
+		{`{"visible": 60000, "scroll": true, "first": 800}`, 204, NoBotNoMatch},
+		{`{"visible": 60000, "focus": 4, "visibility": 3}`, 204, BotJSNoInteraction},
+		{`{"visible": "x"}`, 400, 0},
+		{`{"visible": 60000, "events": 30, "jitter": -5}`, 400, 0},
+	} {
+		t.Run("", func(t *testing.T) {
+			got = 0
+			body := url.Values{"nonce": {b.Nonce(httptest.NewRequest("GET", "/", nil), "x")}, "behaviour": {tt.behaviour}}.Encode()
+			r := httptest.NewRequest("POST", "/isbot", strings.NewReader(body))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rr := httptest.NewRecorder()
+			b.ServeHTTP(rr, r)
+			if rr.Code != tt.wantCode || got != tt.wantRes {
+				t.Errorf("got %d %s; want %d %s", rr.Code, got, tt.wantCode, tt.wantRes)
+			}
+		})
+	}
+}
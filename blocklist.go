@@ -0,0 +1,81 @@
+package isbot
+
+import (
+	"cmp"
+	"net/http"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+// Blocklist is a list of IP addresses that expire after some time.
+//
+// IPv6 addresses are added as the /64 prefix, as that's what people usually
+// get from their ISP.
+//
+// Blocklist implements both Checker and Ranges.
+type Blocklist struct {
+	// Name and Result for addresses on the list. The default Result is
+	// BotBlocklist.
+	Name   string
+	Result Result
+
+	// How long to keep addresses on the list; the default is 24 hours.
+	Expire time.Duration
+
+	// Maximum number of addresses; the addresses that were added least recently
+	// are removed if there are more. The default is 100,000. This is only used
+	// if Store is nil.
+	MaxAddrs int
+
+	// Store to keep state in; the default is a MemoryStore. Addresses are
+	// stored per Name, so several lists can share a Store if they have
+	// different names.
+	Store Store
+
+	once  sync.Once
+	store Store
+}
+
+func (b *Blocklist) init() {
+	b.once.Do(func() {
+		b.store = storeOr(b.Store, cmp.Or(b.MaxAddrs, 100_000))
+	})
+}
+
+// Add an address to the list, or reset the expiry if it's already on the list.
+func (b *Blocklist) Add(addr netip.Addr) {
+	b.init()
+	if addr.IsValid() {
+		add(b.store, "block:"+b.Name+":"+clientKey(addr), "", cmp.Or(b.Expire, 24*time.Hour))
+	}
+}
+
+// Lookup implements Ranges.
+func (b *Blocklist) Lookup(addr netip.Addr) (Range, bool) {
+	b.init()
+	if !addr.IsValid() || !has(b.store, "block:"+b.Name+":"+clientKey(addr), "") {
+		return Range{}, false
+	}
+	addr = addr.Unmap()
+	p := netip.PrefixFrom(addr, 32)
+	if addr.Is6() {
+		p, _ = addr.Prefix(64)
+	}
+	return Range{Prefix: p, Result: b.result(), Name: b.Name}, true
+}
+
+// Check implements Checker.
+func (b *Blocklist) Check(_ *http.Request, addr netip.Addr) Result {
+	if _, ok := b.Lookup(addr); ok {
+		return b.result()
+	}
+	return NoBotNoMatch
+}
+
+func (b *Blocklist) result() Result {
+	if b.Result == NoBotKnown {
+		return BotBlocklist
+	}
+	return b.Result
+}
@@ -0,0 +1,33 @@
+package isbot
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestBlocklist(t *testing.T) {
+	b := &Blocklist{Name: "test"}
+	b.Add(netip.MustParseAddr("192.0.2.1"))
+	b.Add(netip.MustParseAddr("2001:db8::1"))
+
+	for _, tt := range []struct {
+		addr string
+		want Result
+	}{
+		{"192.0.2.1", BotBlocklist},
+		{"192.0.2.2", NoBotNoMatch},
+		{"2001:db8::ffff", BotBlocklist},
+		{"2001:db8:0:1::1", NoBotNoMatch},
+	} {
+		t.Run(tt.addr, func(t *testing.T) {
+			if got := b.Check(nil, netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Errorf("got %s; want %s", got, tt.want)
+			}
+		})
+	}
+
+	rng, ok := b.Lookup(netip.MustParseAddr("2001:db8::1"))
+	if !ok || rng.Result != BotBlocklist || rng.Prefix != netip.MustParsePrefix("2001:db8::/64") {
+		t.Errorf("Lookup: %v %v", rng, ok)
+	}
+}
@@ -0,0 +1,240 @@
+package isbot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A bundle is a DB with a header that has the version, the time it was
+// generated, and an Ed25519 signature:
+//
+//	isbot-bundle 1
+//	version 2024-06-01
+//	generated 2024-06-01T12:00:00Z
+//	signature [base64]
+//
+//	[DB]
+//
+// The signature is for everything except the signature line.
+
+const bundleMagic = "isbot-bundle 1"
+
+// SignBundle writes the DB as a signed bundle.
+//
+// The version is taken from d.Version, and the generated time should be the
+// current time; Verifier rejects bundles that were generated before the last
+// bundle it accepted.
+func SignBundle(w io.Writer, d *DB, generated time.Time, key ed25519.PrivateKey) error {
+	if len(key) != ed25519.PrivateKeySize {
+		return errors.New("isbot.SignBundle: invalid private key")
+	}
+	if d.Version == "" || strings.ContainsAny(d.Version, "\r\n") {
+		return fmt.Errorf("isbot.SignBundle: invalid version %q", d.Version)
+	}
+
+	body := new(bytes.Buffer)
+	if _, err := d.WriteTo(body); err != nil {
+		return fmt.Errorf("isbot.SignBundle: %w", err)
+	}
+	head := fmt.Sprintf("%s\nversion %s\ngenerated %s\n", bundleMagic, d.Version,
+		generated.UTC().Format(time.RFC3339))
+	sig := ed25519.Sign(key, bundleMessage(head, body.Bytes()))
+
+	_, err := fmt.Fprintf(w, "%ssignature %s\n\n%s", head, base64.StdEncoding.EncodeToString(sig), body)
+	if err != nil {
+		return fmt.Errorf("isbot.SignBundle: %w", err)
+	}
+	return nil
+}
+
+func bundleMessage(head string, body []byte) []byte {
+	return append([]byte(head+"\n"), body...)
+}
+
+// Verifier verifies signed bundles.
+//
+// Bundles that were generated before the last accepted bundle are rejected, so
+// that an older bundle can't be served to roll back updates. This is only kept
+// in memory: after a restart any correctly signed bundle is accepted again,
+// unless After is set.
+type Verifier struct {
+	// Public keys to accept signatures from; there should usually be just one,
+	// but it can be useful to accept more than one when rotating keys.
+	Keys []ed25519.PublicKey
+
+	// Reject bundles generated before this time. Set this to the Generated time
+	// of the last bundle you stored to keep the rollback protection across
+	// restarts.
+	After time.Time
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+// Verify reads and verifies a bundle.
+//
+// This returns an error if the bundle isn't signed by any of the Keys, or if
+// it was generated before After or the last bundle that was accepted.
+func (v *Verifier) Verify(r io.Reader) (*DB, error) {
+	var (
+		br    = bufio.NewReader(r)
+		head  strings.Builder
+		sig   []byte
+		ver   string
+		gen   time.Time
+		lines int
+	)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("isbot.Verifier.Verify: reading header: %w", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			break
+		}
+		if lines++; lines == 1 {
+			if line != bundleMagic {
+				return nil, errors.New("isbot.Verifier.Verify: not a bundle")
+			}
+			head.WriteString(line + "\n")
+			continue
+		}
+
+		k, val, _ := strings.Cut(line, " ")
+		switch k {
+		case "version":
+			ver = val
+		case "generated":
+			gen, err = time.Parse(time.RFC3339, val)
+			if err != nil {
+				return nil, fmt.Errorf("isbot.Verifier.Verify: %w", err)
+			}
+		case "signature":
+			sig, err = base64.StdEncoding.DecodeString(val)
+			if err != nil {
+				return nil, fmt.Errorf("isbot.Verifier.Verify: signature: %w", err)
+			}
+			continue
+		}
+		head.WriteString(line + "\n")
+	}
+	if sig == nil {
+		return nil, errors.New("isbot.Verifier.Verify: bundle is not signed")
+	}
+	if ver == "" || gen.IsZero() {
+		return nil, errors.New("isbot.Verifier.Verify: no version or generated time")
+	}
+
+	body, err := io.ReadAll(br)
+	if err != nil {
+		return nil, fmt.Errorf("isbot.Verifier.Verify: %w", err)
+	}
+	msg := bundleMessage(head.String(), body)
+	ok := false
+	for _, k := range v.Keys {
+		if len(k) == ed25519.PublicKeySize && ed25519.Verify(k, msg, sig) {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return nil, errors.New("isbot.Verifier.Verify: invalid signature")
+	}
+
+	d, err := ReadDB(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("isbot.Verifier.Verify: %w", err)
+	}
+	d.Version, d.Generated = ver, gen
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	last := v.last
+	if v.After.After(last) {
+		last = v.After
+	}
+	if gen.Before(last) {
+		return nil, fmt.Errorf("isbot.Verifier.Verify: bundle %q from %s is older than the current one from %s",
+			ver, gen.Format(time.RFC3339), last.Format(time.RFC3339))
+	}
+	v.last = gen
+	return d, nil
+}
+
+// Fetcher fetches signed bundles over HTTP.
+type Fetcher struct {
+	// URL to fetch the bundle from.
+	URL string
+
+	// Verifier to verify the bundle with; this must be set.
+	Verifier *Verifier
+
+	// HTTP client to use; the default is http.DefaultClient.
+	Client *http.Client
+
+	mu   sync.Mutex
+	etag string
+}
+
+// Fetch the bundle.
+//
+// The ETag from the last successful response is sent in If-None-Match; this
+// returns nil if the bundle wasn't modified.
+func (f *Fetcher) Fetch(ctx context.Context) (*DB, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("isbot.Fetcher.Fetch: %w", err)
+	}
+	if f.etag != "" {
+		req.Header.Set("If-None-Match", f.etag)
+	}
+
+	c := f.Client
+	if c == nil {
+		c = http.DefaultClient
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("isbot.Fetcher.Fetch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		return nil, nil
+	case http.StatusOK:
+	default:
+		return nil, fmt.Errorf("isbot.Fetcher.Fetch: %s: %s", f.URL, resp.Status)
+	}
+
+	d, err := f.Verifier.Verify(io.LimitReader(resp.Body, 64<<20))
+	if err != nil {
+		return nil, fmt.Errorf("isbot.Fetcher.Fetch: %s: %w", f.URL, err)
+	}
+	f.etag = resp.Header.Get("ETag")
+	return d, nil
+}
+
+// Update fetches the bundle and uses it with SetDB() if it was modified.
+func (f *Fetcher) Update(ctx context.Context) (bool, error) {
+	d, err := f.Fetch(ctx)
+	if err != nil || d == nil {
+		return false, err
+	}
+	SetDB(d)
+	return true, nil
+}
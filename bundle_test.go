@@ -0,0 +1,111 @@
+package isbot
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBundle(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	_, other, _ := ed25519.GenerateKey(nil)
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	sign := func(key ed25519.PrivateKey, version string, gen time.Time) string {
+		t.Helper()
+		d := builtinDB.WithRanges(version, []Range{{Prefix: netip.MustParsePrefix("192.0.2.0/24"), Result: BotRangeAWS, Name: "AWS"}})
+		buf := new(bytes.Buffer)
+		if err := SignBundle(buf, d, gen, key); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+
+	v := &Verifier{Keys: []ed25519.PublicKey{pub}}
+	b := sign(priv, "one", now)
+	d, err := v.Verify(strings.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Version != "one" || !d.Generated.Equal(now) {
+		t.Errorf("%q %s", d.Version, d.Generated)
+	}
+	if r, ok := d.Lookup(netip.MustParseAddr("192.0.2.1")); !ok || r.Result != BotRangeAWS {
+		t.Errorf("%v %v", r, ok)
+	}
+	if got := d.UserAgent("Mozilla/5.0 (compatible; SlimerJS/1.0)"); got != BotKnownBot {
+		t.Errorf("%s", got)
+	}
+
+	tests := []struct {
+		name, bundle, err string
+	}{
+		{"tampered body", strings.Replace(b, "192.0.2.0/24", "192.0.3.0/24", 1), "invalid signature"},
+		{"tampered header", strings.Replace(b, "version one", "version two", 1), "invalid signature"},
+		{"unsigned", b[:strings.Index(b, "signature")] + b[strings.Index(b, "\n\n")+1:], "not signed"},
+		{"other key", sign(other, "two", now.Add(time.Hour)), "invalid signature"},
+		{"older", sign(priv, "zero", now.Add(-time.Hour)), "older than"},
+		{"no bundle", "version one\n\n", "not a bundle"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.Verify(strings.NewReader(tt.bundle))
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("wrong error: %v", err)
+			}
+		})
+	}
+
+	if _, err := v.Verify(strings.NewReader(sign(priv, "two", now.Add(time.Hour)))); err != nil {
+		t.Error(err)
+	}
+
+	// After a restart.
+	v = &Verifier{Keys: []ed25519.PublicKey{pub}, After: now.Add(time.Hour)}
+	if _, err := v.Verify(strings.NewReader(b)); err == nil || !strings.Contains(err.Error(), "older than") {
+		t.Errorf("wrong error: %v", err)
+	}
+	if _, err := v.Verify(strings.NewReader(sign(priv, "two", now.Add(time.Hour)))); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFetcher(t *testing.T) {
+	defer SetDB(nil)
+
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	buf := new(bytes.Buffer)
+	SignBundle(buf, builtinDB.WithRanges("one", nil), time.Now(), priv)
+
+	var reqs int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs++
+		if r.Header.Get("If-None-Match") == `"one"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"one"`)
+		w.Write(buf.Bytes())
+	}))
+	defer srv.Close()
+
+	f := &Fetcher{URL: srv.URL, Verifier: &Verifier{Keys: []ed25519.PublicKey{pub}}}
+	for i, want := range []bool{true, false} {
+		ok, err := f.Update(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != want {
+			t.Errorf("%d: %t", i, ok)
+		}
+	}
+	if reqs != 2 || ActiveDB().Version != "one" {
+		t.Errorf("reqs=%d version=%q", reqs, ActiveDB().Version)
+	}
+}
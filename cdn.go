@@ -0,0 +1,96 @@
+package isbot
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+)
+
+// CDN is a Resolver that gets the client address from the header a CDN sets,
+// but only if the request came from one of the CDN's edge servers.
+//
+// Requests that didn't come from the CDN use the Peer address.
+type CDN struct {
+	// Header with the client address, such as "CF-Connecting-IP".
+	Header string
+
+	// Edges are the ranges of the CDN's edge servers.
+	Edges []netip.Prefix
+
+	// Peer gets the address of the server that connected to us; the default
+	// is to use r.RemoteAddr. Set this to Proxies if there's a load balancer
+	// between the CDN and the application.
+	Peer Resolver
+}
+
+var cdnEdges = sync.OnceValue(func() map[string][]netip.Prefix {
+	m := make(map[string][]netip.Prefix)
+	for name, ranges := range cdnRanges {
+		for f := range strings.FieldsSeq(ranges) {
+			m[name] = append(m[name], netip.MustParsePrefix(f))
+		}
+	}
+	return m
+})
+
+// Cloudflare gets a CDN for Cloudflare, using the CF-Connecting-IP header.
+func Cloudflare() CDN {
+	return CDN{Header: "CF-Connecting-IP", Edges: cdnEdges()["Cloudflare"]}
+}
+
+// Fastly gets a CDN for Fastly, using the Fastly-Client-IP header.
+func Fastly() CDN {
+	return CDN{Header: "Fastly-Client-IP", Edges: cdnEdges()["Fastly"]}
+}
+
+// CloudFront gets a CDN for Amazon CloudFront, using the
+// CloudFront-Viewer-Address header. This header needs to be enabled in the
+// origin request policy.
+func CloudFront() CDN {
+	return CDN{Header: "CloudFront-Viewer-Address", Edges: cdnEdges()["CloudFront"]}
+}
+
+// Akamai gets a CDN for Akamai, using the True-Client-IP header.
+//
+// Akamai doesn't publish the ranges of its edge servers, so they need to be
+// given; they're available from Akamai's Site Shield or Origin IP ACL.
+func Akamai(edges []netip.Prefix) CDN {
+	return CDN{Header: "True-Client-IP", Edges: edges}
+}
+
+// ClientIP implements Resolver.
+func (c CDN) ClientIP(r *http.Request) netip.Addr {
+	var peer netip.Addr
+	if c.Peer == nil {
+		peer = remoteAddr(r)
+	} else {
+		peer = c.Peer.ClientIP(r)
+	}
+	if !peer.IsValid() || !c.edge(peer) {
+		return peer
+	}
+
+	h := strings.TrimSpace(r.Header.Get(c.Header))
+	if h == "" {
+		return peer
+	}
+	// CloudFront-Viewer-Address always has a port, and IPv6 addresses aren't
+	// in brackets: "2001:db8::1:443".
+	if strings.EqualFold(c.Header, "CloudFront-Viewer-Address") {
+		if i := strings.LastIndexByte(h, ':'); i > -1 {
+			h = h[:i]
+		}
+	}
+	return parseAddr(h)
+}
+
+func (c CDN) edge(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, p := range c.Edges {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
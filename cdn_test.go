@@ -0,0 +1,58 @@
+package isbot
+
+import (
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestCDN(t *testing.T) {
+	for _, c := range []CDN{Cloudflare(), Fastly(), CloudFront()} {
+		if len(c.Edges) == 0 {
+			t.Errorf("no edges for %s", c.Header)
+		}
+	}
+
+	lb := Proxies{Trusted: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}}
+	behindLB := Cloudflare()
+	behindLB.Peer = lb
+	cf := CDN{Header: "CloudFront-Viewer-Address", Edges: []netip.Prefix{netip.MustParsePrefix("3.0.0.0/8")}}
+
+	tests := []struct {
+		cdn    CDN
+		remote string
+		header map[string]string
+		want   string
+	}{
+		{Cloudflare(), "104.16.0.1:443", map[string]string{"CF-Connecting-IP": "198.51.100.1"}, "198.51.100.1"},
+		{Cloudflare(), "[2606:4700::1]:443", map[string]string{"CF-Connecting-IP": "2001:db8::1"}, "2001:db8::1"},
+		{Cloudflare(), "104.16.0.1:443", nil, "104.16.0.1"},
+		{Cloudflare(), "192.0.2.1:443", map[string]string{"CF-Connecting-IP": "198.51.100.1"}, "192.0.2.1"},
+		{Cloudflare(), "104.16.0.1:443", map[string]string{"Fastly-Client-IP": "198.51.100.1"}, "104.16.0.1"},
+		{Fastly(), "151.101.1.1:443", map[string]string{"Fastly-Client-IP": "198.51.100.1"}, "198.51.100.1"},
+		{Fastly(), "104.16.0.1:443", map[string]string{"CF-Connecting-IP": "198.51.100.1"}, "104.16.0.1"},
+		{Akamai(nil), "104.16.0.1:443", map[string]string{"True-Client-IP": "198.51.100.1"}, "104.16.0.1"},
+		{cf, "3.0.0.1:443", map[string]string{"CloudFront-Viewer-Address": "198.51.100.1:4321"}, "198.51.100.1"},
+		{cf, "3.0.0.1:443", map[string]string{"CloudFront-Viewer-Address": "2001:db8::1:4321"}, "2001:db8::1"},
+		{CloudFront(), "13.32.0.1:443", map[string]string{"CloudFront-Viewer-Address": "198.51.100.1:4321"}, "198.51.100.1"},
+		{CloudFront(), "15.158.1.1:443", map[string]string{"CloudFront-Viewer-Address": "198.51.100.1:4321"}, "198.51.100.1"},
+		{CloudFront(), "[2600:9000::1]:443", map[string]string{"CloudFront-Viewer-Address": "2001:db8::1:4321"}, "2001:db8::1"},
+		{CloudFront(), "192.0.2.1:443", map[string]string{"CloudFront-Viewer-Address": "198.51.100.1:4321"}, "192.0.2.1"},
+
+		{behindLB, "10.0.0.1:443", map[string]string{"X-Forwarded-For": "104.16.0.1", "CF-Connecting-IP": "198.51.100.1"}, "198.51.100.1"},
+		{behindLB, "10.0.0.1:443", map[string]string{"X-Forwarded-For": "192.0.2.1", "CF-Connecting-IP": "198.51.100.1"}, "192.0.2.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remote
+			for k, v := range tt.header {
+				r.Header.Set(k, v)
+			}
+			if got := tt.cdn.ClientIP(r).String(); got != tt.want {
+				t.Errorf("got %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package isbot
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"html/template"
+	"math/bits"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Challenge is a HTTP middleware that serves a proof-of-work challenge to
+// requests that look suspicious.
+//
+// The challenge page runs a small JavaScript program to find a SHA-256 hash
+// with a number of leading zero bits, and posts the answer back. If it's
+// correct a signed cookie is set and the page is reloaded. Requests with a
+// valid cookie are passed through, and Check() returns NoBotChallenge for them.
+//
+// The cookie is only valid for the same User-Agent and the same /24 (IPv4) or
+// /64 (IPv6) prefix, and every challenge can only be answered once.
+//
+// This won't stop bots running a real browser, but it does make mass scraping
+// a lot more expensive. Browsers need crypto.subtle to solve the challenge,
+// which is only available on https:// and localhost.
+type Challenge struct {
+	// Key to sign the challenge and cookie with. This must be set, and should
+	// be at least 32 random bytes; Handler() panics if it's not set.
+	Key []byte
+
+	// Detector to check requests with; a nil Detector behaves like Bot().
+	Detector *Detector
+
+	// Difficulty gets the difficulty (the number of leading zero bits) for a
+	// result; 0 means the request is passed through without a challenge. The
+	// default is 16 for bots and 0 for everything else.
+	Difficulty func(Result) int
+
+	// Path to post the answer to; the default is "/.isbot/challenge".
+	Path string
+
+	// Cookie name; the default is "isbot".
+	Cookie string
+
+	// How long the cookie is valid for; the default is 24 hours.
+	MaxAge time.Duration
+
+	// Store to keep the answered challenges in; the default is a MemoryStore
+	// with NoEvict set. Answers are refused if the Store is full.
+	Store Store
+
+	once  sync.Once
+	store Store
+}
+
+// How long a challenge is valid for.
+const challengeExpire = 5 * time.Minute
+
+// Handler returns a middleware that challenges suspicious requests before
+// calling next.
+func (c *Challenge) Handler(next http.Handler) http.Handler {
+	if len(c.Key) == 0 {
+		panic("isbot.Challenge.Handler: Key is not set")
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == c.path() && r.Method == http.MethodPost {
+			c.answer(w, r)
+			return
+		}
+		if c.passed(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		diff := c.difficulty(c.detector().Bot(r))
+		if diff <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if r.Method != http.MethodGet {
+			http.Error(w, "isbot: solve the challenge first", http.StatusForbidden)
+			return
+		}
+
+		b := make([]byte, 16)
+		_, _ = rand.Read(b)
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusForbidden)
+		_ = challengePage.Execute(w, map[string]any{
+			"Challenge": signToken(c.Key, "challenge", time.Now().Add(challengeExpire),
+				strconv.Itoa(diff)+":"+hex.EncodeToString(b)),
+			"Difficulty": diff,
+			"Path":       c.path(),
+		})
+	})
+}
+
+// Check implements Checker.
+func (c *Challenge) Check(r *http.Request, _ netip.Addr) Result {
+	if c.passed(r) {
+		return NoBotChallenge
+	}
+	return NoBotNoMatch
+}
+
+func (c *Challenge) passed(r *http.Request) bool {
+	if len(c.Key) == 0 {
+		return false
+	}
+	cookie, err := r.Cookie(c.cookie())
+	if err != nil {
+		return false
+	}
+	data, ok := verifyToken(c.Key, "cookie", cookie.Value, time.Now())
+	return ok && data == c.binding(r)
+}
+
+func (c *Challenge) binding(r *http.Request) string {
+	return clientBinding(c.detector().ClientIP(r), r.UserAgent())
+}
+
+func (c *Challenge) answer(w http.ResponseWriter, r *http.Request) {
+	var (
+		challenge = r.PostFormValue("challenge")
+		nonce     = r.PostFormValue("nonce")
+	)
+	data, ok := verifyToken(c.Key, "challenge", challenge, time.Now())
+	if !ok || len(nonce) > 20 {
+		http.Error(w, "isbot: invalid challenge", http.StatusBadRequest)
+		return
+	}
+	d, _, _ := strings.Cut(data, ":")
+	diff, _ := strconv.Atoi(d)
+	if leadingZeros(sha256.Sum256([]byte(challenge+nonce))) < diff {
+		http.Error(w, "isbot: wrong answer", http.StatusBadRequest)
+		return
+	}
+	c.once.Do(func() { c.store = spentStore(c.Store, 100_000) })
+	n, err := c.store.Incr("challenge:"+challenge, 1, challengeExpire)
+	if err != nil {
+		http.Error(w, "isbot: can't record answer", http.StatusServiceUnavailable)
+		return
+	}
+	if n != 1 {
+		http.Error(w, "isbot: challenge already answered", http.StatusBadRequest)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     c.cookie(),
+		Value:    signToken(c.Key, "cookie", time.Now().Add(c.maxAge()), c.binding(r)),
+		Path:     "/",
+		MaxAge:   int(c.maxAge().Seconds()),
+		Secure:   r.TLS != nil,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func leadingZeros(h [sha256.Size]byte) int {
+	n := 0
+	for _, b := range h {
+		if b != 0 {
+			return n + bits.LeadingZeros8(b)
+		}
+		n += 8
+	}
+	return n
+}
+
+func (c *Challenge) difficulty(r Result) int {
+	if c.Difficulty != nil {
+		return c.Difficulty(r)
+	}
+	if Is(r) {
+		return 16
+	}
+	return 0
+}
+
+func (c *Challenge) detector() *Detector {
+	if c.Detector == nil {
+		return &Detector{}
+	}
+	return c.Detector
+}
+
+func (c *Challenge) path() string {
+	if c.Path == "" {
+		return "/.isbot/challenge"
+	}
+	return c.Path
+}
+
+func (c *Challenge) cookie() string {
+	if c.Cookie == "" {
+		return "isbot"
+	}
+	return c.Cookie
+}
+
+func (c *Challenge) maxAge() time.Duration {
+	if c.MaxAge == 0 {
+		return 24 * time.Hour
+	}
+	return c.MaxAge
+}
+
+var challengePage = template.Must(template.New("").Parse(`<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8">
+	<meta name="robots" content="noindex">
+	<meta name="viewport" content="width=device-width, initial-scale=1">
+	<title>Checking your browser</title>
+</head>
+<body>
+	<p>Checking your browser; this should only take a few seconds…</p>
+	<noscript><p>This check requires JavaScript.</p></noscript>
+	<script>
+	(async function() {
+		var challenge = {{.Challenge}}, difficulty = {{.Difficulty}}, enc = new TextEncoder()
+		var zeros = function(h) {
+			for (var i = 0, n = 0; i < h.length; i++, n += 8)
+				if (h[i] !== 0)
+					return n + Math.clz32(h[i]) - 24
+			return n
+		}
+		for (var nonce = 0;; nonce++) {
+			var h = new Uint8Array(await crypto.subtle.digest('SHA-256', enc.encode(challenge + nonce)))
+			if (zeros(h) >= difficulty)
+				break
+		}
+		await fetch({{.Path}}, {method: 'POST', body: new URLSearchParams({challenge: challenge, nonce: nonce})})
+		location.reload()
+	})()
+	</script>
+</body>
+</html>
+`))
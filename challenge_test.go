@@ -0,0 +1,131 @@
+package isbot
+
+import (
+	"crypto/sha256"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChallenge(t *testing.T) {
+	c := &Challenge{
+		Key: []byte("secret"),
+		Difficulty: func(r Result) int {
+			if r == BotClientLibrary {
+				return 8
+			}
+			return 0
+		},
+	}
+	h := c.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+
+	remote := "192.0.2.1:1234"
+	do := func(method, path, ua string, body url.Values, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+		t.Helper()
+		r := httptest.NewRequest(method, path, strings.NewReader(body.Encode()))
+		r.RemoteAddr = remote
+		r.Header.Set("User-Agent", ua)
+		if body != nil {
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		for _, c := range cookies {
+			r.AddCookie(c)
+		}
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, r)
+		return rr
+	}
+
+	var (
+		browser = "Mozilla/5.0 (X11; Linux x86_64; rv:75.0) Gecko/20100101 Firefox/75.0"
+		curl    = "curl/8.0.1 (x86_64-pc-linux-gnu)"
+	)
+	if rr := do("GET", "/", browser, nil); rr.Code != 200 || rr.Body.String() != "ok" {
+		t.Fatalf("browser: %d %q", rr.Code, rr.Body)
+	}
+
+	rr := do("GET", "/", curl, nil)
+	if rr.Code != 403 {
+		t.Fatalf("curl: %d", rr.Code)
+	}
+	m := regexp.MustCompile(`challenge = "(.*?)"`).FindStringSubmatch(rr.Body.String())
+	if m == nil {
+		t.Fatalf("no challenge in page:\n%s", rr.Body)
+	}
+	challenge := m[1]
+
+	if rr := do("POST", "/.isbot/challenge", curl, url.Values{"challenge": {challenge}, "nonce": {"x"}}); rr.Code != 400 {
+		t.Fatalf("wrong nonce: %d", rr.Code)
+	}
+	if rr := do("POST", "/.isbot/challenge", curl, url.Values{"challenge": {challenge + "x"}, "nonce": {"1"}}); rr.Code != 400 {
+		t.Fatalf("wrong challenge: %d", rr.Code)
+	}
+
+	var nonce string
+	for i := 0; ; i++ {
+		nonce = strconv.Itoa(i)
+		if leadingZeros(sha256.Sum256([]byte(challenge+nonce))) >= 8 {
+			break
+		}
+	}
+	rr = do("POST", "/.isbot/challenge", curl, url.Values{"challenge": {challenge}, "nonce": {nonce}})
+	if rr.Code != 204 {
+		t.Fatalf("answer: %d %q", rr.Code, rr.Body)
+	}
+	cookies := rr.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("cookies: %v", cookies)
+	}
+
+	if rr := do("GET", "/", curl, nil, cookies...); rr.Code != 200 || rr.Body.String() != "ok" {
+		t.Fatalf("with cookie: %d %q", rr.Code, rr.Body)
+	}
+	if rr := do("POST", "/.isbot/challenge", curl, url.Values{"challenge": {challenge}, "nonce": {nonce}}); rr.Code != 400 {
+		t.Fatalf("answered twice: %d", rr.Code)
+	}
+	full := NewMemoryStore(1)
+	full.NoEvict = true
+	full.Incr("other", 1, time.Hour)
+	c.store = full
+	if rr := do("POST", "/.isbot/challenge", curl, url.Values{"challenge": {challenge}, "nonce": {nonce}}); rr.Code != 503 {
+		t.Fatalf("answer with full store: %d", rr.Code)
+	}
+	if rr := do("GET", "/", curl+"x", nil, cookies...); rr.Code != 403 {
+		t.Fatalf("cookie with other User-Agent: %d", rr.Code)
+	}
+	remote = "192.0.2.200:1234"
+	if rr := do("GET", "/", curl, nil, cookies...); rr.Code != 200 {
+		t.Fatalf("cookie from same /24: %d", rr.Code)
+	}
+	remote = "198.51.100.1:1234"
+	if rr := do("GET", "/", curl, nil, cookies...); rr.Code != 403 {
+		t.Fatalf("cookie from other /24: %d", rr.Code)
+	}
+
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("User-Agent", curl)
+	if got := c.Check(r, netip.Addr{}); got != NoBotNoMatch {
+		t.Errorf("Check without cookie: %s", got)
+	}
+	r.AddCookie(cookies[0])
+	if got := c.Check(r, netip.Addr{}); got != NoBotChallenge {
+		t.Errorf("Check with cookie: %s", got)
+	}
+}
+
+func TestChallengeNoKey(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic")
+		}
+	}()
+	(&Challenge{}).Handler(http.NotFoundHandler())
+}
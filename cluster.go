@@ -0,0 +1,94 @@
+package isbot
+
+import (
+	"cmp"
+	"hash/fnv"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cluster detects the same uncommon User-Agent and headers from many different
+// IP addresses in a short time, as is common with distributed scrapers that
+// rotate through residential IPs.
+//
+// Requests are grouped by a fingerprint of the User-Agent and Accept,
+// Accept-Language, Accept-Encoding, and Sec-CH-UA headers. Only requests for
+// which UserAgent() returns NoBotNoMatch are considered.
+//
+// The zero value is usable, and uses the defaults as listed below.
+type Cluster struct {
+	// Time window to count the number of clients in; the default is 10
+	// minutes.
+	Window time.Duration
+
+	// Minimum number of different clients in Window to be considered a bot;
+	// the default is 100.
+	MinClients int
+
+	// Time window to calculate how common a fingerprint is; the default is 24
+	// hours.
+	Baseline time.Duration
+
+	// Maximum share of requests in Baseline for a fingerprint to be considered
+	// uncommon; the default is 0.01 (1%).
+	MaxShare float64
+
+	// Maximum number of fingerprints to keep track of; the fingerprints that
+	// were seen least recently are removed if there are more. The default is
+	// 100,000. This is only used if Store is nil.
+	MaxFingerprints int
+
+	// Store to keep state in; the default is a MemoryStore.
+	Store Store
+
+	once  sync.Once
+	store Store
+	now   func() time.Time
+}
+
+// Check implements Checker.
+//
+// It returns BotCluster if the fingerprint for this request was seen from at
+// least MinClients different clients in Window, while being uncommon.
+func (c *Cluster) Check(r *http.Request, addr netip.Addr) Result {
+	c.once.Do(func() {
+		c.store = storeOr(c.Store, 3*cmp.Or(c.MaxFingerprints, 100_000))
+		if c.now == nil {
+			c.now = time.Now
+		}
+	})
+	if !addr.IsValid() || UserAgent(r.UserAgent()) != NoBotNoMatch {
+		return NoBotNoMatch
+	}
+
+	var (
+		fp       = "cluster:" + fingerprint(r) + ":"
+		baseline = cmp.Or(c.Baseline, 24*time.Hour)
+		cur      = c.now().UnixNano() / int64(baseline)
+		k        = ":" + strconv.FormatInt(cur, 36)
+		kprev    = ":" + strconv.FormatInt(cur-1, 36)
+	)
+	total := incr(c.store, "cluster:total"+k, 1, 2*baseline) + incr(c.store, "cluster:total"+kprev, 0, 2*baseline)
+	n := incr(c.store, fp+"n"+k, 1, 2*baseline) + incr(c.store, fp+"n"+kprev, 0, 2*baseline)
+	clients := add(c.store, fp+"clients", clientKey(addr), cmp.Or(c.Window, 10*time.Minute))
+
+	if clients >= cmp.Or(c.MinClients, 100) && float64(n)/float64(total) <= cmp.Or(c.MaxShare, 0.01) {
+		return BotCluster
+	}
+	return NoBotNoMatch
+}
+
+// fingerprint gets a fingerprint for the User-Agent and some headers that
+// browsers always send, but which are often different for bots.
+func fingerprint(r *http.Request) string {
+	h := fnv.New64a()
+	for _, k := range []string{"User-Agent", "Accept", "Accept-Language", "Accept-Encoding", "Sec-CH-UA"} {
+		h.Write([]byte(strings.Join(strings.Fields(r.Header.Get(k)), " ")))
+		h.Write([]byte{0})
+	}
+	return strconv.FormatUint(h.Sum64(), 36)
+}
@@ -0,0 +1,70 @@
+package isbot
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"testing"
+)
+
+func TestCluster(t *testing.T) {
+	req := func(ua string) *http.Request {
+		r := &http.Request{Header: make(http.Header)}
+		r.Header.Set("User-Agent", ua)
+		r.Header.Set("Accept", "text/html")
+		return r
+	}
+	var (
+		common = "Mozilla/5.0 (X11; Linux x86_64; rv:75.0) Gecko/20100101 Firefox/75.0"
+		exotic = "Mozilla/5.0 (X11; Linux x86_64; rv:75.0) Gecko/20100101 Firefox/75.0 Exotic/1.0"
+	)
+
+	t.Run("uncommon", func(t *testing.T) {
+		c := &Cluster{}
+		for i := range 20_000 {
+			c.Check(req(common), netip.MustParseAddr(fmt.Sprintf("10.0.%d.%d", i/256%256, i%256)))
+		}
+		var got Result
+		for i := range 150 {
+			got = c.Check(req(exotic), netip.MustParseAddr(fmt.Sprintf("10.1.0.%d", i)))
+			if i < 99 && got != NoBotNoMatch {
+				t.Fatalf("%d: %s", i, got)
+			}
+		}
+		if got != BotCluster {
+			t.Errorf("got %s", got)
+		}
+
+		// Different header.
+		r := req(exotic)
+		r.Header.Set("Accept", "*/*")
+		if got := c.Check(r, netip.MustParseAddr("10.1.1.1")); got != NoBotNoMatch {
+			t.Errorf("got %s", got)
+		}
+	})
+
+	t.Run("common", func(t *testing.T) {
+		c := &Cluster{}
+		var got Result
+		for i := range 150 {
+			got = c.Check(req(exotic), netip.MustParseAddr(fmt.Sprintf("10.1.0.%d", i)))
+		}
+		if got != NoBotNoMatch {
+			t.Errorf("got %s", got)
+		}
+	})
+
+	t.Run("same client", func(t *testing.T) {
+		c := &Cluster{}
+		for i := range 20_000 {
+			c.Check(req(common), netip.MustParseAddr(fmt.Sprintf("10.0.%d.%d", i/256%256, i%256)))
+		}
+		var got Result
+		for range 150 {
+			got = c.Check(req(exotic), netip.MustParseAddr("10.1.0.1"))
+		}
+		if got != NoBotNoMatch {
+			t.Errorf("got %s", got)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+// Command bundle signs a DB as a bundle, which can be loaded with
+// isbot.Fetcher.
+//
+// The ranges are read from a file written by "iprange -db", and the
+// User-Agent lists are the ones built in to the isbot package:
+//
+//	go run ./cmd/iprange -db ranges.db
+//	go run ./cmd/bundle -key key.txt ranges.db >isbot.bundle
+//
+// Use -genkey to generate a new key pair.
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"zgo.at/isbot"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "bundle: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var (
+		keyFile = flag.String("key", "", "File with the base64-encoded Ed25519 private key to sign the bundle with.")
+		genkey  = flag.Bool("genkey", false, "Generate a new key pair for signing bundles, and exit.")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s -key file ranges.db >bundle\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *genkey {
+		pub, priv, err := ed25519.GenerateKey(nil)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("private %s\npublic  %s\n",
+			base64.StdEncoding.EncodeToString(priv.Seed()), base64.StdEncoding.EncodeToString(pub))
+		return nil
+	}
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *keyFile == "" {
+		return errors.New("-key is required")
+	}
+	data, err := os.ReadFile(*keyFile)
+	if err != nil {
+		return err
+	}
+	seed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return fmt.Errorf("invalid key in %s", *keyFile)
+	}
+
+	fp, err := os.Open(flag.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+	out := new(bytes.Buffer)
+	if err := bundle(out, fp, ed25519.NewKeyFromSeed(seed), time.Now()); err != nil {
+		return fmt.Errorf("%s: %w", flag.Arg(0), err)
+	}
+	_, err = os.Stdout.Write(out.Bytes())
+	return err
+}
+
+// bundle writes the built-in User-Agent lists and the ranges as a signed
+// bundle. The version is taken from the ranges.
+func bundle(w io.Writer, ranges io.Reader, key ed25519.PrivateKey, now time.Time) error {
+	ua := new(bytes.Buffer)
+	if _, err := isbot.ActiveDB().WithRanges("", nil).WriteTo(ua); err != nil {
+		return err
+	}
+	d, err := isbot.ReadDB(io.MultiReader(ua, ranges))
+	if err != nil {
+		return err
+	}
+	return isbot.SignBundle(w, d, now, key)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"zgo.at/isbot"
+)
+
+func TestBundle(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := new(bytes.Buffer)
+	err = bundle(out, strings.NewReader("version 2024-06-01\nrange 192.0.2.0/24 8 AWS us-east-1\n"), priv, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := (&isbot.Verifier{Keys: []ed25519.PublicKey{pub}}).Verify(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Version != "2024-06-01" {
+		t.Errorf("version: %q", d.Version)
+	}
+	if r, ok := d.Lookup(netip.MustParseAddr("192.0.2.1")); !ok || r.Name != "AWS" || r.Region != "us-east-1" {
+		t.Errorf("Lookup: %v %v", r, ok)
+	}
+
+	defer isbot.SetDB(isbot.SetDB(d))
+	if got := isbot.UserAgent("curl/7.0"); !isbot.Is(got) {
+		t.Errorf("no User-Agent lists: %s", got)
+	}
+}
+
+func TestBundleInvalid(t *testing.T) {
+	_, priv, _ := ed25519.GenerateKey(nil)
+	if err := bundle(new(bytes.Buffer), strings.NewReader("range xxx\n"), priv, time.Now()); err == nil {
+		t.Error("no error")
+	}
+	if err := bundle(new(bytes.Buffer), strings.NewReader("range 192.0.2.0/24 8 AWS\n"), priv, time.Now()); err == nil {
+		t.Error("no error without version")
+	}
+}
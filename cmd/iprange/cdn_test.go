@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGenCDN(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("ips-v4", "104.16.0.0/13\n")
+	write("ips-v6", "2606:4700::/32\n")
+	write("public-ip-list", `{"addresses": ["151.101.0.0/16"], "ipv6_addresses": []}`)
+	write("ip-ranges.json", `{
+		"prefixes": [
+			{"ip_prefix": "3.2.34.0/26", "service": "EC2"},
+			{"ip_prefix": "13.32.0.0/15", "service": "CLOUDFRONT"},
+			{"ip_prefix": "13.32.1.0/24", "service": "CLOUDFRONT_ORIGIN_FACING"},
+			{"ip_prefix": "15.158.0.0/16", "service": "CLOUDFRONT_ORIGIN_FACING"}
+		],
+		"ipv6_prefixes": [{"ipv6_prefix": "2600:9000:ffff::/48", "service": "CLOUDFRONT"}]
+	}`)
+
+	out, err := genCDN(fetcher{dir: dir, offline: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cf := string(out)
+	cf = cf[strings.Index(cf, `"CloudFront"`):strings.Index(cf, `"Cloudflare"`)]
+	prefixes := strings.Fields(cf)
+	for _, want := range []string{"13.32.0.0/15", "15.158.0.0/16", "18.68.0.0/16", "54.230.0.0/17", "2600:9000::/28"} {
+		if !slices.Contains(prefixes, want) {
+			t.Errorf("no %s in:\n%s", want, cf)
+		}
+	}
+	for _, notWant := range []string{"3.2.34.0/26"} {
+		if slices.Contains(prefixes, notWant) {
+			t.Errorf("%s in:\n%s", notWant, cf)
+		}
+	}
+
+	write("public-ip-list", `{"addresses": [], "ipv6_addresses": []}`)
+	if _, err := genCDN(fetcher{dir: dir, offline: true}); err == nil || err.Error() != "no ranges for Fastly" {
+		t.Errorf("wrong error: %v", err)
+	}
+}
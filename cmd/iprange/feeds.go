@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+// entry is a prefix from a feed, with the region and service if the feed has
+// them.
+type entry struct {
+	prefix  netip.Prefix
+	region  string
+	service string
+}
+
+// feed is a machine-readable list of prefixes published by a provider.
+type feed struct {
+	Format string // Key in parsers.
+	URL    string
+
+	// Link is set if URL is a HTML page that links to the feed, rather than
+	// the feed itself; the first link that matches is used.
+	Link *regexp.Regexp
+}
+
+var parsers = map[string]func([]byte) ([]entry, error){
+	"text":    parseTextFeed,
+	"aws":     parseAWS,
+	"google":  parseGoogle,
+	"azure":   parseAzure,
+	"oracle":  parseOracle,
+	"geofeed": parseGeofeed,
+}
+
+// get the feed and parse it.
+func (f feed) get(ft fetcher) ([]entry, error) {
+	url := f.URL
+	if f.Link != nil {
+		page, err := ft.fetch(url)
+		if err != nil {
+			return nil, err
+		}
+		l := f.Link.Find(page)
+		if l == nil {
+			return nil, fmt.Errorf("%s: no link matching %s", url, f.Link)
+		}
+		url = string(l)
+	}
+
+	data, err := ft.fetch(url)
+	if err != nil {
+		return nil, err
+	}
+	e, err := parsers[f.Format](data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", url, err)
+	}
+	if len(e) == 0 {
+		return nil, fmt.Errorf("%s: no prefixes", url)
+	}
+	return e, nil
+}
+
+// parseTextFeed parses a file with one prefix per line.
+func parseTextFeed(data []byte) ([]entry, error) {
+	var e []entry
+	for line := range strings.SplitSeq(string(data), "\n") {
+		if line = strings.TrimSpace(line); line == "" || line[0] == '#' {
+			continue
+		}
+		p, err := netip.ParsePrefix(line)
+		if err != nil {
+			return nil, err
+		}
+		e = append(e, entry{prefix: p})
+	}
+	return e, nil
+}
+
+// parseAWS parses https://ip-ranges.amazonaws.com/ip-ranges.json
+//
+// Every prefix is listed for the AMAZON service, and again for more specific
+// services such as EC2 or CLOUDFRONT; the more specific service is used.
+func parseAWS(data []byte) ([]entry, error) {
+	type prefix struct {
+		IPv4    netip.Prefix `json:"ip_prefix"`
+		IPv6    netip.Prefix `json:"ipv6_prefix"`
+		Region  string       `json:"region"`
+		Service string       `json:"service"`
+	}
+	var list struct {
+		Prefixes     []prefix `json:"prefixes"`
+		IPv6Prefixes []prefix `json:"ipv6_prefixes"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	var (
+		e   []entry
+		idx = make(map[netip.Prefix]int)
+	)
+	for _, p := range append(list.Prefixes, list.IPv6Prefixes...) {
+		pp := p.IPv4
+		if !pp.IsValid() {
+			pp = p.IPv6
+		}
+		if !pp.IsValid() {
+			return nil, errors.New("prefix without ip_prefix or ipv6_prefix")
+		}
+		if i, ok := idx[pp]; ok {
+			if e[i].service == "AMAZON" {
+				e[i].service = p.Service
+			}
+			continue
+		}
+		idx[pp] = len(e)
+		e = append(e, entry{prefix: pp, region: p.Region, service: p.Service})
+	}
+	return e, nil
+}
+
+// parseGoogle parses https://www.gstatic.com/ipranges/cloud.json
+//
+// The service is always "Google Cloud", so it's not used.
+func parseGoogle(data []byte) ([]entry, error) {
+	var list struct {
+		Prefixes []struct {
+			IPv4  netip.Prefix `json:"ipv4Prefix"`
+			IPv6  netip.Prefix `json:"ipv6Prefix"`
+			Scope string       `json:"scope"`
+		} `json:"prefixes"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	e := make([]entry, 0, len(list.Prefixes))
+	for _, p := range list.Prefixes {
+		pp := p.IPv4
+		if !pp.IsValid() {
+			pp = p.IPv6
+		}
+		if !pp.IsValid() {
+			return nil, errors.New("prefix without ipv4Prefix or ipv6Prefix")
+		}
+		e = append(e, entry{prefix: pp, region: p.Scope})
+	}
+	return e, nil
+}
+
+// parseAzure parses the Azure IP Ranges and Service Tags JSON file.
+//
+// All prefixes are in the regional AzureCloud.[region] tags; the service is
+// taken from the other tags, such as AzureFrontDoor.Frontend.
+func parseAzure(data []byte) ([]entry, error) {
+	var list struct {
+		Values []struct {
+			Name       string `json:"name"`
+			Properties struct {
+				Region          string         `json:"region"`
+				SystemService   string         `json:"systemService"`
+				AddressPrefixes []netip.Prefix `json:"addressPrefixes"`
+			} `json:"properties"`
+		} `json:"values"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	var (
+		e   []entry
+		idx = make(map[netip.Prefix]int)
+	)
+	for _, v := range list.Values {
+		if !strings.HasPrefix(v.Name, "AzureCloud.") {
+			continue
+		}
+		for _, p := range v.Properties.AddressPrefixes {
+			if _, ok := idx[p]; !ok {
+				idx[p] = len(e)
+				e = append(e, entry{prefix: p, region: v.Properties.Region})
+			}
+		}
+	}
+	for _, v := range list.Values {
+		if v.Properties.SystemService == "" || strings.HasPrefix(v.Name, "AzureCloud") {
+			continue
+		}
+		for _, p := range v.Properties.AddressPrefixes {
+			if i, ok := idx[p]; ok && e[i].service == "" {
+				e[i].service = v.Properties.SystemService
+			}
+		}
+	}
+	return e, nil
+}
+
+// parseOracle parses https://docs.oracle.com/en-us/iaas/tools/public_ip_ranges.json
+func parseOracle(data []byte) ([]entry, error) {
+	var list struct {
+		Regions []struct {
+			Region string `json:"region"`
+			CIDRs  []struct {
+				CIDR netip.Prefix `json:"cidr"`
+				Tags []string     `json:"tags"`
+			} `json:"cidrs"`
+		} `json:"regions"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, err
+	}
+
+	var e []entry
+	for _, r := range list.Regions {
+		for _, c := range r.CIDRs {
+			var service string
+			if len(c.Tags) > 0 {
+				service = c.Tags[0]
+			}
+			e = append(e, entry{prefix: c.CIDR, region: r.Region, service: service})
+		}
+	}
+	return e, nil
+}
+
+// parseGeofeed parses a RFC 8805 geofeed: prefix,country,region,city,postal.
+// The region is the ISO 3166-2 region if it's set, or the country if it's not.
+func parseGeofeed(data []byte) ([]entry, error) {
+	var (
+		e    []entry
+		scan = bufio.NewScanner(bytes.NewReader(data))
+	)
+	for i := 1; scan.Scan(); i++ {
+		line := strings.TrimSpace(scan.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		rec, err := csv.NewReader(strings.NewReader(line)).Read()
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("line %d: %w", i, err)
+		}
+		p, err := netip.ParsePrefix(strings.TrimSpace(rec[0]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i, err)
+		}
+		ent := entry{prefix: p}
+		if len(rec) > 2 && strings.TrimSpace(rec[2]) != "" {
+			ent.region = strings.TrimSpace(rec[2])
+		} else if len(rec) > 1 {
+			ent.region = strings.TrimSpace(rec[1])
+		}
+		e = append(e, ent)
+	}
+	return e, scan.Err()
+}
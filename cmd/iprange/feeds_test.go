@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParsers(t *testing.T) {
+	tests := []struct {
+		format, file string
+		want         []string
+	}{
+		{"aws", "aws.json", []string{
+			"3.2.34.0/26 af-south-1 EC2",
+			"13.32.0.0/15 GLOBAL CLOUDFRONT",
+			"52.94.76.0/22 us-west-2 AMAZON",
+			"2600:1f14::/35 us-west-2 EC2",
+		}},
+		{"google", "google.json", []string{
+			"34.35.0.0/16 africa-south1 ",
+			"34.80.0.0/15 asia-east1 ",
+			"2600:1900:8000::/44 africa-south1 ",
+		}},
+		{"azure", "azure.json", []string{
+			"13.69.0.0/17 westeurope ",
+			"20.50.0.0/18 westeurope AzureFrontDoor",
+			"2603:1020:200::/46 westeurope ",
+			"20.42.0.0/17 eastus ",
+		}},
+		{"oracle", "oracle.json", []string{
+			"130.61.0.0/16 eu-frankfurt-1 OCI",
+			"134.70.32.0/22 eu-frankfurt-1 OSN",
+			"129.213.0.0/16 us-ashburn-1 OCI",
+		}},
+		{"geofeed", "geofeed.csv", []string{
+			"5.101.96.0/21 NL-NH ",
+			"45.55.32.0/19 US-NY ",
+			"139.59.128.0/18 IN ",
+			"2604:a880:400::/48 US-NY ",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			data, err := os.ReadFile("testdata/" + tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			entries, err := parsers[tt.format](data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]string, 0, len(entries))
+			for _, e := range entries {
+				got = append(got, fmt.Sprintf("%s %s %s", e.prefix, e.region, e.service))
+			}
+			if g, w := strings.Join(got, "\n"), strings.Join(tt.want, "\n"); g != w {
+				t.Errorf("\ngot:\n%s\nwant:\n%s", g, w)
+			}
+		})
+	}
+
+	for format, in := range map[string]string{
+		"text":    "192.0.2.0/24\nxxx\n",
+		"aws":     `{"prefixes": [{"ip_prefix": "xxx"}]}`,
+		"google":  `{"prefixes": [{"service": "Google Cloud"}]}`,
+		"azure":   `{"values": [`,
+		"oracle":  `{"regions": [{"cidrs": [{"cidr": "192.0.2.0"}]}]}`,
+		"geofeed": "192.0.2.0/24,NL\nxxx,NL\n",
+	} {
+		if _, err := parsers[format]([]byte(in)); err == nil {
+			t.Errorf("no error for %s: %q", format, in)
+		}
+	}
+}
@@ -2,119 +2,248 @@ package main
 
 import (
 	"bytes"
-
-	"go/format"
-	"slices"
-
+	"cmp"
 	"errors"
+	"flag"
 	"fmt"
+	"go/format"
 	"io"
 	"io/fs"
 	"net/http"
 	"net/netip"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
+	"unicode"
 )
 
 func main() {
-	ranges := [][2]string{
-		{"AWS", "https://raw.githubusercontent.com/rezmoss/cloud-provider-ip-addresses/refs/heads/main/aws/aws_ips_merged.txt"},
-		{"Azure", "https://raw.githubusercontent.com/rezmoss/cloud-provider-ip-addresses/refs/heads/main/azure/azure_ips_merged.txt"},
-		{"DigitalOcean", "https://raw.githubusercontent.com/rezmoss/cloud-provider-ip-addresses/refs/heads/main/digitalocean/digitalocean_ips_merged.txt"},
-		{"GoogleCloud", "https://raw.githubusercontent.com/rezmoss/cloud-provider-ip-addresses/refs/heads/main/googlecloud/googlecloud_ips_merged.txt"},
-		{"Hetzner", "https://raw.githubusercontent.com/rezmoss/cloud-provider-ip-addresses/refs/heads/main/hetzner/hetzner_ips_merged.txt"},
-		{"Linode", "https://raw.githubusercontent.com/rezmoss/cloud-provider-ip-addresses/refs/heads/main/linode/linode_ips_merged.txt"},
-		{"Alibaba", "https://raw.githubusercontent.com/rezmoss/cloud-provider-ip-addresses/refs/heads/main/alibaba/alibaba_ips_merged.txt"},
-		{"Oracle", "https://raw.githubusercontent.com/rezmoss/cloud-provider-ip-addresses/refs/heads/main/oracle/oracle_ips_merged.txt"},
-		{"OVH", "https://raw.githubusercontent.com/rezmoss/cloud-provider-ip-addresses/refs/heads/main/ovhcloud/ovhcloud_ips_merged.txt"},
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "iprange: %s\n", err)
+		os.Exit(1)
 	}
+}
 
-	if err := os.MkdirAll(".cache", 0o755); err != nil {
-		panic(err)
+func run() error {
+	var (
+		input   = flag.String("input", "", "Read all sources from this directory and never use the network; the files are named as in .cache.")
+		cache   = flag.String("cache", ".cache", "Directory to cache downloaded sources in.")
+		outDir  = flag.String("out", ".", "Directory to write ip_ranges.go, ip_providers.go, and cdn_ranges.go to.")
+		dryRun  = flag.Bool("n", false, "Dry run: print the prefixes that would be added and removed for every provider, but don't write anything.")
+		minSize = flag.Float64("min", 0.5, "Refuse to write if a provider has fewer than this fraction of the prefixes in the current ip_ranges.go; 0 to disable.")
+		dbFile  = flag.String("db", "", "Write the ranges in the DB format to this file, instead of generating ip_ranges.go; use cmd/bundle to sign it.")
+		version = flag.String("version", time.Now().UTC().Format("2006-01-02"), "Version to write with -db.")
+	)
+	flag.Parse()
+
+	f := fetcher{dir: *cache}
+	if *input != "" {
+		f = fetcher{dir: *input, offline: true}
+	} else if err := os.MkdirAll(*cache, 0o755); err != nil {
+		return err
 	}
 
-	type ipRange struct {
-		bot    string
-		prefix netip.Prefix
+	ranges, err := collect(os.Stderr, f, providers)
+	if err != nil {
+		return err
 	}
 
-	var (
-		ranges4 = make([]ipRange, 0, 8192)
-		ranges6 = make([]ipRange, 0, 8192)
-	)
-	for _, r := range ranges {
-		cache := ".cache/" + filepath.Base(r[1])
-		data, err := os.ReadFile(cache)
-		if err != nil {
-			if !errors.Is(err, fs.ErrNotExist) {
-				panic(err)
-			}
-			resp, err := http.Get(r[1])
-			if err != nil {
-				panic(err)
-			}
-			defer resp.Body.Close()
-			data, err = io.ReadAll(resp.Body)
-			if err != nil {
-				panic(err)
-			}
-			err = os.WriteFile(cache, data, 0o644)
-			if err != nil {
-				panic(err)
-			}
+	old, err := readRanges(filepath.Join(*outDir, "ip_ranges.go"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if *dryRun {
+		diff(os.Stdout, old, ranges)
+		summary(os.Stdout, old, ranges)
+		if err := checkSize(old, ranges, *minSize); err != nil {
+			fmt.Printf("would refuse to write: %s\n", err)
 		}
+		return nil
+	}
+	if old != nil {
+		summary(os.Stderr, old, ranges)
+	}
+	if err := checkSize(old, ranges, *minSize); err != nil {
+		return err
+	}
 
-		for line := range strings.SplitSeq(string(data), "\n") {
-			if len(line) == 0 {
-				continue
-			}
-
-			prefix := netip.MustParsePrefix(line)
-			addr := prefix.Addr()
-			if addr.Is4() {
-				ranges4 = append(ranges4, ipRange{bot: r[0], prefix: prefix})
-			} else {
-				ranges6 = append(ranges6, ipRange{bot: r[0], prefix: prefix})
-			}
-		}
+	if *dbFile != "" {
+		out := new(bytes.Buffer)
+		writeDB(out, *version, ranges)
+		return os.WriteFile(*dbFile, out.Bytes(), 0o644)
 	}
 
 	out := new(bytes.Buffer)
 	out.WriteString("// Code generated by cmd/iprange command; DO NOT EDIT.\n\npackage isbot\n\n")
+	writeRanges(out, "ranges4", slices.DeleteFunc(slices.Clone(ranges), func(r ipRange) bool { return !r.prefix.Addr().Is4() }))
+	writeRanges(out, "ranges6", slices.DeleteFunc(slices.Clone(ranges), func(r ipRange) bool { return r.prefix.Addr().Is4() }))
+	cdn, err := genCDN(f)
+	if err != nil {
+		return err
+	}
+
+	// Format everything first, so nothing is written if there's an error.
+	files := []struct {
+		name string
+		src  []byte
+	}{
+		{"ip_ranges.go", out.Bytes()},
+		{"ip_providers.go", genProviders()},
+		{"cdn_ranges.go", cdn},
+	}
+	for i := range files {
+		files[i].src, err = format.Source(files[i].src)
+		if err != nil {
+			return fmt.Errorf("%s: %w", files[i].name, err)
+		}
+	}
+	for _, file := range files {
+		path := filepath.Join(*outDir, file.name)
+		if err := os.WriteFile(path+".new", file.src, 0o644); err != nil {
+			return err
+		}
+		if err := os.Rename(path+".new", path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	out.WriteString("var ranges4 = `\n\t")
-	slices.SortFunc(ranges4, func(a, b ipRange) int { return a.prefix.Addr().Compare(b.prefix.Addr()) })
-	for i, r := range ranges4 {
-		if i > 0 && i%6 == 0 {
-			out.WriteString("\n\t")
+// collect the ranges for all providers, and clean them up. Problems are
+// reported to w.
+func collect(w io.Writer, f fetcher, provs []provider) ([]ipRange, error) {
+	var ranges []ipRange
+	for _, p := range provs {
+		entries, err := p.entries(w, f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p.Name, err)
+		}
+		for _, e := range entries {
+			ranges = append(ranges, ipRange{bot: p.Name, prefix: e.prefix, region: tag(e.region), service: tag(e.service)})
 		}
-		fmt.Fprintf(out, "%s,%s ", r.prefix, r.bot)
 	}
-	out.WriteString("\n`\n\n")
+	ranges = rejectBogons(w, ranges)
+	ranges = aggregate(ranges)
+	ranges = resolveOverlaps(w, ranges)
+	return ranges, nil
+}
 
-	out.WriteString("var ranges6 = `\n\t")
-	slices.SortFunc(ranges6, func(a, b ipRange) int { return a.prefix.Addr().Compare(b.prefix.Addr()) })
-	for i, r := range ranges6 {
-		if i > 0 && i%6 == 0 {
-			out.WriteString("\n\t")
+// writeRanges writes the ranges as a raw string constant, six per line.
+// Adjacent ranges are merged in to intervals.
+func writeRanges(out *bytes.Buffer, name string, ranges []ipRange) {
+	fmt.Fprintf(out, "var %s = `\n", name)
+	for line := range slices.Chunk(mergeIntervals(ranges), 6) {
+		s := make([]string, 0, len(line))
+		for _, r := range line {
+			s = append(s, r.String())
 		}
-		fmt.Fprintf(out, "%s,%s ", r.prefix, r.bot)
+		fmt.Fprintf(out, "\t%s\n", strings.Join(s, " "))
 	}
-	out.WriteString("\n`\n\n")
+	out.WriteString("`\n\n")
+}
 
-	out2, err := format.Source(out.Bytes())
-	if err != nil {
-		fmt.Println(err)
-		fmt.Print(out)
-		os.Exit(1)
+// fetcher gets sources from the network, and caches them in dir. If offline is
+// set it only reads them from dir.
+type fetcher struct {
+	dir     string
+	offline bool
+}
+
+// fetch the URL, or read it from dir if it was fetched before.
+func (f fetcher) fetch(url string) ([]byte, error) {
+	path := filepath.Join(f.dir, strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '.' || r == '-' {
+			return r
+		}
+		return '_'
+	}, filepath.Base(url)))
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return data, nil
+	}
+	if f.offline || !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", url, err)
 	}
-	err = os.WriteFile("ip_ranges.new.go", out2, 0o644)
+
+	resp, err := http.Get(url)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
 	}
-	err = os.Rename("ip_ranges.new.go", "ip_ranges.go")
+	data, err = io.ReadAll(resp.Body)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("%s: %w", url, err)
+	}
+	return data, os.WriteFile(path, data, 0o644)
+}
+
+type ipRange struct {
+	bot     string
+	prefix  netip.Prefix
+	last    netip.Addr // If set, this is the interval from prefix.Addr() to last.
+	region  string
+	service string
+}
+
+// end gets the last address in the range.
+func (r ipRange) end() netip.Addr {
+	if r.last.IsValid() {
+		return r.last
+	}
+	return lastAddr(r.prefix)
+}
+
+// String formats the range as prefix,bot[,region[,service]], or as
+// first-last,bot[,region[,service]] for intervals.
+func (r ipRange) String() string {
+	s := r.prefix.String()
+	if r.last.IsValid() {
+		s = r.prefix.Addr().String() + "-" + r.last.String()
+	}
+	s += "," + r.bot
+	if r.region != "" || r.service != "" {
+		s += "," + r.region
+	}
+	if r.service != "" {
+		s += "," + r.service
+	}
+	return s
+}
+
+// tag replaces characters that can't be used in ip_ranges.go or the DB
+// format in a region or service.
+func tag(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ',' || r == '`' || unicode.IsSpace(r) {
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// writeDB writes the ranges in the format that isbot.ReadDB() reads.
+//
+// This doesn't use isbot.DB.WriteTo(), as importing isbot would make it
+// impossible to run this if ip_ranges.go is broken.
+func writeDB(out *bytes.Buffer, version string, ranges []ipRange) {
+	results := make(map[string]int)
+	for _, p := range providers {
+		results[p.Name] = p.Result
+	}
+	fmt.Fprintf(out, "version %s\n", version)
+	for _, r := range ranges {
+		fmt.Fprintf(out, "range %s %d %s", r.prefix, results[r.bot], r.bot)
+		if r.region != "" || r.service != "" {
+			out.WriteString(" " + cmp.Or(r.region, "-"))
+		}
+		if r.service != "" {
+			out.WriteString(" " + r.service)
+		}
+		out.WriteString("\n")
 	}
 }
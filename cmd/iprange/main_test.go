@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFetcher(t *testing.T) {
+	var n int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n++
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("192.0.2.0/24\n"))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	f := fetcher{dir: dir}
+	for range 2 {
+		data, err := f.fetch(srv.URL + "/ips?v=4")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "192.0.2.0/24\n" {
+			t.Errorf("%q", data)
+		}
+	}
+	if n != 1 {
+		t.Errorf("fetched %d times", n)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "ips_v_4")); err != nil {
+		t.Error(err)
+	}
+	if _, err := f.fetch(srv.URL + "/missing"); err == nil {
+		t.Error("no error for 404")
+	}
+
+	n = 0
+	f = fetcher{dir: dir, offline: true}
+	if _, err := f.fetch("http://example.com/ips?v=4"); err != nil {
+		t.Error(err)
+	}
+	if _, err := f.fetch(srv.URL + "/other"); err == nil {
+		t.Error("no error for missing file")
+	}
+	if n != 0 {
+		t.Errorf("fetched %d times when offline", n)
+	}
+}
+
+func TestWriteDB(t *testing.T) {
+	out := new(bytes.Buffer)
+	writeDB(out, "2024-06-01", ranges("3.0.0.0/15,AWS", "3.2.34.0/26,AWS,af-south-1,EC2", "3.5.0.0/24,AWS,,S3", "4.0.0.0/16,Azure,eastus"))
+	want := "version 2024-06-01\n" +
+		"range 3.0.0.0/15 8 AWS\n" +
+		"range 3.2.34.0/26 8 AWS af-south-1 EC2\n" +
+		"range 3.5.0.0/24 8 AWS - S3\n" +
+		"range 4.0.0.0/16 " + strconv.Itoa(providers[providerIndex("Azure")].Result) + " Azure eastus\n"
+	if out.String() != want {
+		t.Errorf("\ngot:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	dir := t.TempDir()
+	aws, err := os.ReadFile("testdata/aws.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.WriteFile(filepath.Join(dir, "ip-ranges.json"), aws, 0o644)
+	f := fetcher{dir: dir, offline: true}
+	provs := []provider{providers[providerIndex("AWS")]}
+
+	ranges, err := collect(io.Discard, f, provs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := new(bytes.Buffer)
+	writeRanges(out, "ranges", ranges)
+	want := "var ranges = `\n" +
+		"\t3.2.34.0/26,AWS,af-south-1,EC2 13.32.0.0/15,AWS,GLOBAL,CLOUDFRONT 52.94.76.0/22,AWS,us-west-2,AMAZON 2600:1f14::/35,AWS,us-west-2,EC2\n" +
+		"`\n\n"
+	if out.String() != want {
+		t.Errorf("\ngot:\n%s\nwant:\n%s", out, want)
+	}
+
+	// Feed fails: use the fallback, which has no region or service.
+	os.Remove(filepath.Join(dir, "ip-ranges.json"))
+	os.WriteFile(filepath.Join(dir, "aws_ips_merged.txt"), []byte("3.2.34.0/26\n"), 0o644)
+	out.Reset()
+	ranges, err = collect(out, f, provs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := join(ranges); g != "3.2.34.0/26,AWS" {
+		t.Errorf("got %q", g)
+	}
+	if !strings.Contains(out.String(), "AWS: https://ip-ranges.amazonaws.com/ip-ranges.json: ") ||
+		!strings.HasSuffix(out.String(), "; using fallback\n") {
+		t.Errorf("wrong output: %q", out)
+	}
+}
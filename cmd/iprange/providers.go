@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/netip"
+	"regexp"
+	"strconv"
+)
+
+// provider is a cloud provider. To add a new provider add it here and run go
+// generate; this will add the BotRange[Name] constant.
+//
+// The Result must never change once it's added, as people may have stored
+// it.
+//
+// Providers that are only listed by ASN get the announced prefixes from
+// RIPEstat; the Prefixes for these are their largest and longest-standing
+// announcements, so there's something if RIPEstat has nothing. Hosting
+// providers without a feed or a clear set of prefixes are better added to
+// hostingASN, which is used with an ASNDB.
+type provider struct {
+	Name     string   // Name of the constant, without BotRange.
+	Result   int      // Result code.
+	Comment  string   // Comment for the constant.
+	Feeds    []feed   // Official feeds from the provider.
+	URLs     []string // Text files with one prefix per line; used if there are no Feeds or if they fail.
+	ASNs     []int    // Autonomous systems; the announced prefixes are fetched from RIPEstat.
+	Prefixes []string // Static prefixes.
+}
+
+const (
+	rezmoss = "https://raw.githubusercontent.com/rezmoss/cloud-provider-ip-addresses/refs/heads/main/"
+	azure   = "https://www.microsoft.com/en-us/download/details.aspx?id=56519"
+)
+
+var azureLink = regexp.MustCompile(`https://download\.microsoft\.com/download/[^"]*/ServiceTags_Public_\d+\.json`)
+
+var providers = []provider{
+	{Name: "AWS", Result: 8, Comment: "AWS cloud",
+		Feeds: []feed{{Format: "aws", URL: "https://ip-ranges.amazonaws.com/ip-ranges.json"}},
+		URLs:  []string{rezmoss + "aws/aws_ips_merged.txt"}},
+	{Name: "DigitalOcean", Result: 9, Comment: "Digital Ocean",
+		Feeds: []feed{{Format: "geofeed", URL: "https://digitalocean.com/geo/google.csv"}},
+		URLs:  []string{rezmoss + "digitalocean/digitalocean_ips_merged.txt"}},
+	{Name: "ServersCom", Result: 10, Comment: "servers.com",
+		ASNs: []int{7979}},
+	{Name: "GoogleCloud", Result: 11, Comment: "Google Cloud",
+		Feeds: []feed{{Format: "google", URL: "https://www.gstatic.com/ipranges/cloud.json"}},
+		URLs:  []string{rezmoss + "googlecloud/googlecloud_ips_merged.txt"}},
+	{Name: "Hetzner", Result: 12, Comment: "hetzner.de",
+		URLs: []string{rezmoss + "hetzner/hetzner_ips_merged.txt"}},
+	{Name: "Azure", Result: 13, Comment: "Azure Cloud",
+		Feeds: []feed{{Format: "azure", URL: azure, Link: azureLink}},
+		URLs:  []string{rezmoss + "azure/azure_ips_merged.txt"}},
+	{Name: "Alibaba", Result: 14, Comment: "Alibaba cloud",
+		URLs: []string{rezmoss + "alibaba/alibaba_ips_merged.txt"}},
+	{Name: "Linode", Result: 15, Comment: "Linode",
+		Feeds: []feed{{Format: "geofeed", URL: "https://geoip.linode.com/"}},
+		URLs:  []string{rezmoss + "linode/linode_ips_merged.txt"}},
+	{Name: "Oracle", Result: 16, Comment: "Oracle cloud",
+		Feeds: []feed{{Format: "oracle", URL: "https://docs.oracle.com/en-us/iaas/tools/public_ip_ranges.json"}},
+		URLs:  []string{rezmoss + "oracle/oracle_ips_merged.txt"}},
+	{Name: "OVH", Result: 17, Comment: "OVH Cloud",
+		URLs: []string{rezmoss + "ovhcloud/ovhcloud_ips_merged.txt"}},
+	{Name: "Vultr", Result: 18, Comment: "Vultr",
+		ASNs: []int{20473},
+		Prefixes: []string{"45.32.0.0/16", "45.63.0.0/17", "45.76.0.0/15", "108.61.0.0/16",
+			"144.202.0.0/16", "149.28.0.0/16", "2001:19f0::/32"}},
+	{Name: "Scaleway", Result: 19, Comment: "Scaleway",
+		ASNs: []int{12876},
+		Prefixes: []string{"51.15.0.0/16", "51.158.0.0/15", "62.210.0.0/16", "163.172.0.0/16",
+			"195.154.0.0/16", "212.47.224.0/19", "2001:bc8::/32"}},
+	{Name: "TencentCloud", Result: 20, Comment: "Tencent Cloud",
+		ASNs: []int{132203},
+		Prefixes: []string{"49.51.0.0/16", "119.28.0.0/16", "129.226.0.0/16", "150.109.0.0/16",
+			"170.106.0.0/16"}},
+	{Name: "HuaweiCloud", Result: 21, Comment: "Huawei Cloud",
+		ASNs:     []int{136907, 55990},
+		Prefixes: []string{"119.8.0.0/16", "159.138.0.0/16"}},
+	{Name: "Contabo", Result: 22, Comment: "Contabo",
+		ASNs: []int{51167},
+		Prefixes: []string{"144.91.64.0/18", "161.97.64.0/18", "167.86.64.0/18", "173.249.0.0/18",
+			"207.180.192.0/18", "2a02:c207::/32"}},
+	{Name: "IBMCloud", Result: 23, Comment: "IBM Cloud (SoftLayer)",
+		ASNs: []int{36351},
+		Prefixes: []string{"50.22.0.0/15", "169.44.0.0/14", "169.60.0.0/14", "173.192.0.0/15",
+			"174.36.0.0/15", "184.172.0.0/15", "2607:f0d0::/32"}},
+	{Name: "UpCloud", Result: 24, Comment: "UpCloud",
+		ASNs:     []int{202053},
+		Prefixes: []string{"94.237.0.0/16"}},
+	{Name: "Kamatera", Result: 25, Comment: "Kamatera",
+		ASNs: []int{36007}},
+	{Name: "CloudflareWorkers", Result: 26, Comment: "Cloudflare Workers egress",
+		Prefixes: []string{"2a06:98c0:3600::/40"}},
+	{Name: "FlyIO", Result: 27, Comment: "Fly.io",
+		ASNs:     []int{40509},
+		Prefixes: []string{"137.66.0.0/17", "2a09:8280::/32"}},
+}
+
+// entries gets all prefixes for this provider. Problems are reported to w.
+func (p provider) entries(w io.Writer, f fetcher) ([]entry, error) {
+	all, err := p.feeds(w, f)
+	if err != nil {
+		return nil, err
+	}
+	for _, asn := range p.ASNs {
+		var resp struct {
+			Data struct {
+				Prefixes []struct {
+					Prefix netip.Prefix `json:"prefix"`
+				} `json:"prefixes"`
+			} `json:"data"`
+		}
+		data, err := f.fetch("https://stat.ripe.net/data/announced-prefixes/data.json?resource=AS" + strconv.Itoa(asn))
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &resp); err != nil {
+			return nil, fmt.Errorf("AS%d: %w", asn, err)
+		}
+		for _, pp := range resp.Data.Prefixes {
+			all = append(all, entry{prefix: pp.Prefix})
+		}
+	}
+	for _, pp := range p.Prefixes {
+		prefix, err := netip.ParsePrefix(pp)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, entry{prefix: prefix})
+	}
+	return all, nil
+}
+
+// feeds gets the prefixes from the feeds, falling back to URLs if any of the
+// feeds fail; the errors for the feeds are reported to w.
+func (p provider) feeds(w io.Writer, f fetcher) ([]entry, error) {
+	var all []entry
+	for _, fd := range p.Feeds {
+		e, err := fd.get(f)
+		if err != nil {
+			fmt.Fprintf(w, "%s: %s; using fallback\n", p.Name, err)
+			all = nil
+			break
+		}
+		all = append(all, e...)
+	}
+	if all != nil {
+		return all, nil
+	}
+
+	for _, u := range p.URLs {
+		data, err := f.fetch(u)
+		if err != nil {
+			return nil, err
+		}
+		e, err := parseTextFeed(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", u, err)
+		}
+		all = append(all, e...)
+	}
+	return all, nil
+}
+
+// genProviders generates ip_providers.go with the constants for all providers.
+func genProviders() []byte {
+	out := new(bytes.Buffer)
+	out.WriteString("// Code generated by cmd/iprange command; DO NOT EDIT.\n\npackage isbot\n\n")
+
+	out.WriteString("// Bots identified by IP.\nconst (\n")
+	for _, p := range providers {
+		fmt.Fprintf(out, "BotRange%s = %d // %s\n", p.Name, p.Result, p.Comment)
+	}
+	out.WriteString(")\n\n")
+
+	out.WriteString("// providers are the names of the providers in ip_ranges.go.\n")
+	out.WriteString("var providers = map[Result]string{\n")
+	for _, p := range providers {
+		fmt.Fprintf(out, "BotRange%s: %q,\n", p.Name, p.Name)
+	}
+	out.WriteString("}\n")
+
+	return out.Bytes()
+}
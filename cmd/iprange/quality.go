@@ -0,0 +1,368 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// bogons are prefixes that should never be in a provider's list.
+var bogons = func() []netip.Prefix {
+	var p []netip.Prefix
+	for _, s := range []string{
+		"0.0.0.0/8",       // "This" network.
+		"10.0.0.0/8",      // Private.
+		"100.64.0.0/10",   // CGNAT.
+		"127.0.0.0/8",     // Loopback.
+		"169.254.0.0/16",  // Link-local.
+		"172.16.0.0/12",   // Private.
+		"192.0.0.0/24",    // IETF protocol assignments.
+		"192.0.2.0/24",    // Documentation.
+		"192.88.99.0/24",  // 6to4 relay anycast.
+		"192.168.0.0/16",  // Private.
+		"198.18.0.0/15",   // Benchmarking.
+		"198.51.100.0/24", // Documentation.
+		"203.0.113.0/24",  // Documentation.
+		"224.0.0.0/4",     // Multicast.
+		"240.0.0.0/4",     // Reserved, broadcast.
+		"::/8",            // Unspecified, loopback, IPv4-mapped.
+		"64:ff9b::/96",    // NAT64.
+		"100::/64",        // Discard.
+		"2001::/32",       // Teredo.
+		"2001:db8::/32",   // Documentation.
+		"2002::/16",       // 6to4.
+		"3fff::/20",       // Documentation.
+		"fc00::/7",        // Unique local.
+		"fe80::/10",       // Link-local.
+		"fec0::/10",       // Site-local.
+		"ff00::/8",        // Multicast.
+	} {
+		p = append(p, netip.MustParsePrefix(s))
+	}
+	return p
+}()
+
+// bogon reports the bogon prefix that overlaps with p, if any.
+func bogon(p netip.Prefix) (netip.Prefix, bool) {
+	for _, b := range bogons {
+		if b.Overlaps(p) {
+			return b, true
+		}
+	}
+	return netip.Prefix{}, false
+}
+
+// rejectBogons removes all ranges that overlap with a bogon.
+func rejectBogons(w io.Writer, ranges []ipRange) []ipRange {
+	return slices.DeleteFunc(ranges, func(r ipRange) bool {
+		b, ok := bogon(r.prefix)
+		if ok {
+			fmt.Fprintf(w, "bogon: %s from %s overlaps with %s; rejected\n", r.prefix, r.bot, b)
+		}
+		return ok
+	})
+}
+
+// aggregate merges adjacent prefixes and removes prefixes that are contained in
+// another prefix. Only ranges with the same provider, region, and service are
+// merged.
+func aggregate(ranges []ipRange) []ipRange {
+	type key struct{ bot, region, service string }
+	groups := make(map[key][]netip.Prefix)
+	for _, r := range ranges {
+		k := key{r.bot, r.region, r.service}
+		groups[k] = append(groups[k], r.prefix.Masked())
+	}
+
+	all := make([]ipRange, 0, len(ranges))
+	for k, prefixes := range groups {
+		for _, p := range aggregatePrefixes(prefixes) {
+			all = append(all, ipRange{bot: k.bot, prefix: p, region: k.region, service: k.service})
+		}
+	}
+	sortRanges(all)
+	return all
+}
+
+func aggregatePrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	slices.SortFunc(prefixes, comparePrefix)
+
+	stack := make([]netip.Prefix, 0, len(prefixes))
+	for _, p := range prefixes {
+		if n := len(stack); n > 0 && stack[n-1].Overlaps(p) { // Sorted, so p is in stack[n-1].
+			continue
+		}
+		stack = append(stack, p)
+		for n := len(stack); n >= 2; n = len(stack) {
+			a, b := stack[n-2], stack[n-1]
+			if a.Bits() != b.Bits() || a.Bits() == 0 {
+				break
+			}
+			parent := netip.PrefixFrom(a.Addr(), a.Bits()-1).Masked()
+			if parent.Addr() != a.Addr() || !parent.Contains(b.Addr()) {
+				break
+			}
+			stack = append(stack[:n-2], parent)
+		}
+	}
+	return stack
+}
+
+// comparePrefix sorts by address, with the shortest prefix first.
+func comparePrefix(a, b netip.Prefix) int {
+	if c := a.Addr().Compare(b.Addr()); c != 0 {
+		return c
+	}
+	return a.Bits() - b.Bits()
+}
+
+// sortRanges sorts the ranges by the last address in the prefix, with the
+// longest prefix first.
+//
+// Ranges are checked in order, so this ensures that a prefix is always checked
+// before any prefix that contains it. For ranges that don't overlap it's the
+// same as sorting by the first address.
+func sortRanges(ranges []ipRange) {
+	slices.SortFunc(ranges, func(a, b ipRange) int {
+		if c := a.end().Compare(b.end()); c != 0 {
+			return c
+		}
+		if c := b.prefix.Bits() - a.prefix.Bits(); c != 0 {
+			return c
+		}
+		return providerIndex(a.bot) - providerIndex(b.bot)
+	})
+}
+
+// mergeIntervals merges runs of adjacent ranges with the same provider, region,
+// and service in to one interval. Many feeds list ranges such as
+// 3.4.12.1-3.4.12.6 as several prefixes, which can't be aggregated any
+// further.
+//
+// The ranges must be sorted with sortRanges(). Intervals never cross the
+// buckets of the rangeTable in isbot: the first byte for IPv4, or the first
+// two bytes for IPv6.
+func mergeIntervals(ranges []ipRange) []ipRange {
+	merged := make([]ipRange, 0, len(ranges))
+	for _, r := range ranges {
+		if n := len(merged); n > 0 {
+			prev := &merged[n-1]
+			if prev.bot == r.bot && prev.region == r.region && prev.service == r.service &&
+				prev.end().Next() == r.prefix.Addr() && bucket(prev.prefix.Addr()) == bucket(r.end()) {
+				prev.last = r.end()
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+func bucket(a netip.Addr) [2]byte {
+	if a.Is4() {
+		return [2]byte{a.As4()[0], 0}
+	}
+	as := a.As16()
+	return [2]byte{as[0], as[1]}
+}
+
+// intervalPrefixes gets the smallest set of prefixes that cover all addresses
+// from first to last.
+func intervalPrefixes(first, last netip.Addr) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for !last.Less(first) {
+		// The largest prefix that starts at first and doesn't go past last.
+		p := netip.PrefixFrom(first, first.BitLen())
+		for bits := first.BitLen() - 1; bits >= 0; bits-- {
+			pp, _ := first.Prefix(bits)
+			if pp.Addr() != first || last.Less(lastAddr(pp)) {
+				break
+			}
+			p = pp
+		}
+		prefixes = append(prefixes, p)
+		next := lastAddr(p).Next()
+		if !next.IsValid() {
+			break
+		}
+		first = next
+	}
+	return prefixes
+}
+
+func lastAddr(p netip.Prefix) netip.Addr {
+	a := p.Masked().Addr().AsSlice()
+	for i := p.Bits(); i < len(a)*8; i++ {
+		a[i/8] |= 1 << (7 - i%8)
+	}
+	addr, _ := netip.AddrFromSlice(a)
+	return addr
+}
+
+func providerIndex(name string) int {
+	return slices.IndexFunc(providers, func(p provider) bool { return p.Name == name })
+}
+
+// resolveOverlaps reports prefixes that overlap between providers.
+//
+// The longest prefix wins, as with routing: an address in 3.0.0.0/15 from one
+// provider and 3.0.1.0/24 from another is reported as the second provider.
+// This is already ensured by sortRanges(), so these are kept.
+//
+// If the same prefix is listed by more than one provider the one that's listed
+// first in providers is kept, and the others are removed.
+func resolveOverlaps(w io.Writer, ranges []ipRange) []ipRange {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, func(a, b ipRange) int {
+		if c := comparePrefix(a.prefix, b.prefix); c != 0 {
+			return c
+		}
+		return providerIndex(a.bot) - providerIndex(b.bot)
+	})
+
+	type pair struct{ a, b string }
+	var (
+		open    []ipRange
+		dupe    = make(map[ipRange]bool)
+		overlap = make(map[pair]int)
+	)
+	for _, r := range sorted {
+		for len(open) > 0 && !open[len(open)-1].prefix.Contains(r.prefix.Addr()) {
+			open = open[:len(open)-1]
+		}
+		for _, o := range slices.Backward(open) {
+			if o.bot == r.bot {
+				continue
+			}
+			if o.prefix == r.prefix {
+				fmt.Fprintf(w, "overlap: %s is in %s and %s; using %[2]s\n", r.prefix, o.bot, r.bot)
+				dupe[r] = true
+			} else {
+				overlap[pair{o.bot, r.bot}]++
+			}
+			break
+		}
+		if !dupe[r] {
+			open = append(open, r)
+		}
+	}
+
+	pairs := make([]pair, 0, len(overlap))
+	for p := range overlap {
+		pairs = append(pairs, p)
+	}
+	slices.SortFunc(pairs, func(a, b pair) int { return strings.Compare(a.a+" "+a.b, b.a+" "+b.b) })
+	for _, p := range pairs {
+		fmt.Fprintf(w, "overlap: %d prefixes from %s are in a larger prefix from %s; using %[2]s\n",
+			overlap[p], p.b, p.a)
+	}
+
+	return slices.DeleteFunc(ranges, func(r ipRange) bool { return dupe[r] })
+}
+
+var reRange = regexp.MustCompile(`([0-9a-f.:]+(?:/\d+|-[0-9a-f.:]+)),(\w+)`)
+
+// readRanges reads the prefixes from an ip_ranges.go file, grouped by
+// provider. Intervals are split in to prefixes.
+func readRanges(path string) (map[string][]netip.Prefix, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string][]netip.Prefix)
+	for _, f := range reRange.FindAllSubmatch(data, -1) {
+		if first, last, ok := strings.Cut(string(f[1]), "-"); ok {
+			a, err1 := netip.ParseAddr(first)
+			b, err2 := netip.ParseAddr(last)
+			if err := errors.Join(err1, err2); err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			m[string(f[2])] = append(m[string(f[2])], intervalPrefixes(a, b)...)
+			continue
+		}
+		p, err := netip.ParsePrefix(string(f[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		m[string(f[2])] = append(m[string(f[2])], p)
+	}
+	return m, nil
+}
+
+// summary prints the number of prefixes for every provider, and how many were
+// added and removed compared to old.
+func summary(w io.Writer, old map[string][]netip.Prefix, ranges []ipRange) {
+	cur := make(map[string][]netip.Prefix)
+	for _, r := range ranges {
+		cur[r.bot] = append(cur[r.bot], r.prefix)
+	}
+	for _, p := range providers {
+		added, removed := diffPrefixes(old[p.Name], cur[p.Name])
+		fmt.Fprintf(w, "%-18s %5d -> %5d prefixes (+%d -%d)\n",
+			p.Name, len(old[p.Name]), len(cur[p.Name]), len(added), len(removed))
+	}
+}
+
+// diff prints the prefixes that were added and removed for every provider,
+// compared to old.
+func diff(w io.Writer, old map[string][]netip.Prefix, ranges []ipRange) {
+	cur := make(map[string][]netip.Prefix)
+	for _, r := range ranges {
+		cur[r.bot] = append(cur[r.bot], r.prefix)
+	}
+	for _, p := range providers {
+		added, removed := diffPrefixes(old[p.Name], cur[p.Name])
+		for _, a := range added {
+			fmt.Fprintf(w, "%s +%s\n", p.Name, a)
+		}
+		for _, r := range removed {
+			fmt.Fprintf(w, "%s -%s\n", p.Name, r)
+		}
+	}
+}
+
+// checkSize returns an error if any provider has fewer than min times the
+// number of prefixes in old. This usually means that a feed was truncated or
+// changed format.
+func checkSize(old map[string][]netip.Prefix, ranges []ipRange, min float64) error {
+	cur := make(map[string]int)
+	for _, r := range ranges {
+		cur[r.bot]++
+	}
+	var errs []error
+	for _, p := range providers {
+		if n := len(old[p.Name]); float64(cur[p.Name]) < float64(n)*min {
+			errs = append(errs, fmt.Errorf("%s has %d prefixes, down from %d", p.Name, cur[p.Name], n))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// diffPrefixes gets the prefixes that are in cur but not in old, and the
+// prefixes that are in old but not in cur.
+func diffPrefixes(old, cur []netip.Prefix) (added, removed []netip.Prefix) {
+	o := make(map[netip.Prefix]bool, len(old))
+	for _, p := range old {
+		o[p] = true
+	}
+	c := make(map[netip.Prefix]bool, len(cur))
+	for _, p := range cur {
+		c[p] = true
+		if !o[p] {
+			added = append(added, p)
+		}
+	}
+	for _, p := range old {
+		if !c[p] {
+			removed = append(removed, p)
+		}
+	}
+	slices.SortFunc(added, comparePrefix)
+	slices.SortFunc(removed, comparePrefix)
+	return added, removed
+}
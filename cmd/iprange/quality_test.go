@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func ranges(s ...string) []ipRange {
+	r := make([]ipRange, 0, len(s))
+	for _, ss := range s {
+		f := strings.Split(ss, ",")
+		f = append(f, "", "")
+		r = append(r, ipRange{prefix: netip.MustParsePrefix(f[0]), bot: f[1], region: f[2], service: f[3]})
+	}
+	return r
+}
+
+func join(r []ipRange) string {
+	s := make([]string, 0, len(r))
+	for _, rr := range r {
+		s = append(s, rr.String())
+	}
+	return strings.Join(s, " ")
+}
+
+func TestAggregate(t *testing.T) {
+	tests := []struct {
+		in   []ipRange
+		want string
+	}{
+		{ranges("3.4.12.0/32,AWS", "3.4.12.1/32,AWS", "3.4.12.2/31,AWS", "3.4.12.4/31,AWS"),
+			"3.4.12.0/30,AWS 3.4.12.4/31,AWS"},
+		{ranges("3.4.12.1/32,AWS", "3.4.12.2/31,AWS", "3.4.12.4/31,AWS"),
+			"3.4.12.1/32,AWS 3.4.12.2/31,AWS 3.4.12.4/31,AWS"},
+		{ranges("10.0.1.0/24,AWS", "10.0.0.0/16,AWS", "10.0.0.0/16,AWS", "10.0.2.5/24,AWS"),
+			"10.0.0.0/16,AWS"},
+		{ranges("10.0.0.0/25,AWS", "10.0.0.128/25,Azure"),
+			"10.0.0.0/25,AWS 10.0.0.128/25,Azure"},
+		{ranges("10.0.0.0/25,AWS,us-east-1", "10.0.0.128/25,AWS,us-west-2", "10.0.1.0/24,AWS,us-east-1"),
+			"10.0.0.0/25,AWS,us-east-1 10.0.0.128/25,AWS,us-west-2 10.0.1.0/24,AWS,us-east-1"},
+		{ranges("2600:1f14::/36,AWS", "2600:1f14:1000::/36,AWS", "2600:1f14:2000::/35,AWS"),
+			"2600:1f14::/34,AWS"},
+		{ranges("3.0.0.0/15,AWS", "3.0.1.0/24,Azure"),
+			"3.0.1.0/24,Azure 3.0.0.0/15,AWS"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := join(aggregate(tt.in)); got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRejectBogons(t *testing.T) {
+	buf := new(bytes.Buffer)
+	got := join(rejectBogons(buf, ranges(
+		"3.0.0.0/15,AWS", "10.1.0.0/16,AWS", "0.0.0.0/0,OVH", "192.0.2.0/25,Azure", "2600:1f14::/35,AWS", "fd00::/8,AWS", "2002::/24,AWS",
+	)))
+	if want := "3.0.0.0/15,AWS 2600:1f14::/35,AWS"; got != want {
+		t.Errorf("\ngot:  %s\nwant: %s", got, want)
+	}
+	if n := strings.Count(buf.String(), "\n"); n != 5 {
+		t.Errorf("%d lines:\n%s", n, buf)
+	}
+}
+
+func TestResolveOverlaps(t *testing.T) {
+	buf := new(bytes.Buffer)
+	got := join(resolveOverlaps(buf, aggregate(ranges(
+		"3.0.0.0/15,AWS", "3.0.1.0/24,Azure", "3.0.2.0/24,Azure", "3.0.3.0/24,AWS",
+		"5.0.0.0/24,Hetzner", "5.0.0.0/24,AWS", "5.0.0.0/24,OVH",
+	))))
+	if want := "3.0.1.0/24,Azure 3.0.2.0/24,Azure 3.0.0.0/15,AWS 5.0.0.0/24,AWS"; got != want {
+		t.Errorf("\ngot:  %s\nwant: %s", got, want)
+	}
+	want := strings.Join([]string{
+		"overlap: 5.0.0.0/24 is in AWS and Hetzner; using AWS",
+		"overlap: 5.0.0.0/24 is in AWS and OVH; using AWS",
+		"overlap: 2 prefixes from Azure are in a larger prefix from AWS; using Azure",
+		"",
+	}, "\n")
+	if buf.String() != want {
+		t.Errorf("\ngot:\n%s\nwant:\n%s", buf, want)
+	}
+}
+
+func TestLastAddr(t *testing.T) {
+	for in, want := range map[string]string{
+		"3.0.0.0/15":     "3.1.255.255",
+		"3.4.12.1/32":    "3.4.12.1",
+		"2600:1f14::/35": "2600:1f14:1fff:ffff:ffff:ffff:ffff:ffff",
+		"0.0.0.0/0":      "255.255.255.255",
+	} {
+		if got := lastAddr(netip.MustParsePrefix(in)).String(); got != want {
+			t.Errorf("%s: %s", in, got)
+		}
+	}
+}
+
+func TestSummary(t *testing.T) {
+	old := map[string][]netip.Prefix{
+		"AWS":   {netip.MustParsePrefix("3.0.0.0/16"), netip.MustParsePrefix("3.1.0.0/16")},
+		"Azure": {netip.MustParsePrefix("4.0.0.0/16")},
+	}
+	buf := new(bytes.Buffer)
+	summary(buf, old, ranges("3.0.0.0/15,AWS", "4.0.0.0/16,Azure"))
+	for _, want := range []string{
+		fmt.Sprintf("%-18s %5d -> %5d prefixes (+1 -2)", "AWS", 2, 1),
+		fmt.Sprintf("%-18s %5d -> %5d prefixes (+0 -0)", "Azure", 1, 1),
+	} {
+		if !strings.Contains(buf.String(), want+"\n") {
+			t.Errorf("no %q in:\n%s", want, buf)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := map[string][]netip.Prefix{
+		"AWS":   {netip.MustParsePrefix("3.0.0.0/16"), netip.MustParsePrefix("3.1.0.0/16")},
+		"Azure": {netip.MustParsePrefix("4.0.0.0/16")},
+	}
+	buf := new(bytes.Buffer)
+	diff(buf, old, ranges("3.0.0.0/15,AWS", "4.0.0.0/16,Azure", "5.0.0.0/24,Hetzner"))
+	want := "AWS +3.0.0.0/15\nAWS -3.0.0.0/16\nAWS -3.1.0.0/16\nHetzner +5.0.0.0/24\n"
+	if buf.String() != want {
+		t.Errorf("\ngot:\n%s\nwant:\n%s", buf, want)
+	}
+}
+
+func TestCheckSize(t *testing.T) {
+	old := map[string][]netip.Prefix{
+		"AWS":   {netip.MustParsePrefix("3.0.0.0/16"), netip.MustParsePrefix("3.1.0.0/16"), netip.MustParsePrefix("3.2.0.0/16")},
+		"Azure": {netip.MustParsePrefix("4.0.0.0/16")},
+	}
+	cur := ranges("3.0.0.0/15,AWS", "4.0.0.0/16,Azure")
+	if err := checkSize(old, cur, 0.3); err != nil {
+		t.Error(err)
+	}
+	if err := checkSize(old, cur, 0.9); err == nil || err.Error() != "AWS has 1 prefixes, down from 3" {
+		t.Errorf("wrong error: %v", err)
+	}
+	if err := checkSize(old, nil, 0); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMergeIntervals(t *testing.T) {
+	tests := []struct {
+		in   []ipRange
+		want string
+	}{
+		{ranges("3.4.12.1/32,AWS", "3.4.12.2/31,AWS", "3.4.12.4/31,AWS", "3.4.12.6/32,AWS", "3.4.12.11/32,AWS"),
+			"3.4.12.1-3.4.12.6,AWS 3.4.12.11/32,AWS"},
+		{ranges("3.4.12.1/32,AWS", "3.4.12.2/31,Azure", "3.4.12.4/31,AWS"),
+			"3.4.12.1/32,AWS 3.4.12.2/31,Azure 3.4.12.4/31,AWS"},
+		{ranges("3.4.12.1/32,AWS,us-east-1", "3.4.12.2/31,AWS,us-west-2"),
+			"3.4.12.1/32,AWS,us-east-1 3.4.12.2/31,AWS,us-west-2"},
+		{ranges("3.255.255.255/32,AWS", "4.0.0.0/31,AWS"),
+			"3.255.255.255/32,AWS 4.0.0.0/31,AWS"},
+		{ranges("2600:1f14::/35,AWS,us-west-2,EC2", "2600:1f14:2000::/36,AWS,us-west-2,EC2"),
+			"2600:1f14::-2600:1f14:2fff:ffff:ffff:ffff:ffff:ffff,AWS,us-west-2,EC2"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := join(mergeIntervals(tt.in)); got != tt.want {
+				t.Errorf("\ngot:  %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntervalPrefixes(t *testing.T) {
+	tests := []struct {
+		first, last, want string
+	}{
+		{"3.4.12.1", "3.4.12.6", "[3.4.12.1/32 3.4.12.2/31 3.4.12.4/31 3.4.12.6/32]"},
+		{"3.4.12.0", "3.4.12.255", "[3.4.12.0/24]"},
+		{"255.255.255.254", "255.255.255.255", "[255.255.255.254/31]"},
+		{"2600:1f14::", "2600:1f14:2fff:ffff:ffff:ffff:ffff:ffff", "[2600:1f14::/35 2600:1f14:2000::/36]"},
+	}
+	for _, tt := range tests {
+		got := fmt.Sprint(intervalPrefixes(netip.MustParseAddr(tt.first), netip.MustParseAddr(tt.last)))
+		if got != tt.want {
+			t.Errorf("%s-%s: %s", tt.first, tt.last, got)
+		}
+	}
+}
+
+func TestReadRanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ip_ranges.go")
+	os.WriteFile(path, []byte("var ranges4 = `\n\t3.4.12.1-3.4.12.6,AWS 3.4.13.0/24,Azure,eastus\n`\n"), 0o644)
+	got, err := readRanges(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g := fmt.Sprint(got); g != "map[AWS:[3.4.12.1/32 3.4.12.2/31 3.4.12.4/31 3.4.12.6/32] Azure:[3.4.13.0/24]]" {
+		t.Errorf("%s", g)
+	}
+}
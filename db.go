@@ -0,0 +1,267 @@
+package isbot
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// DB is a database of User-Agent lists and IP ranges.
+//
+// A DB can't be modified after it's loaded. The DB used by UserAgent(),
+// IPRange(), and Bot() can be replaced with SetDB(), which is safe to do while
+// requests are being handled.
+//
+// DB implements Ranges.
+type DB struct {
+	// Version of the data; this can be anything, but is usually a date.
+	Version string
+
+	// When the data was generated; this is only set for bundles loaded with
+	// Verifier.Verify().
+	Generated time.Time
+
+	browsers, clients, bots []string
+	ranges                  *rangeTable
+}
+
+var (
+	builtinDB = &DB{
+		Version:  "builtin",
+		browsers: knownBrowsers,
+		clients:  clientLibraries,
+		bots:     knownBots,
+		ranges:   ipRanges,
+	}
+	activeDB atomic.Pointer[DB]
+)
+
+// ActiveDB gets the DB that's currently used.
+func ActiveDB() *DB {
+	if d := activeDB.Load(); d != nil {
+		return d
+	}
+	return builtinDB
+}
+
+// WithRanges creates a new DB with the User-Agent lists from d and the given
+// ranges.
+func (d *DB) WithRanges(version string, ranges []Range) *DB {
+	n := &DB{Version: version, browsers: d.browsers, clients: d.clients, bots: d.bots, ranges: new(rangeTable)}
+	for _, r := range ranges {
+		r.Prefix = r.Prefix.Masked()
+		n.ranges.add(r)
+	}
+	return n
+}
+
+// SetDB sets the DB to use, and returns the previous one. The built-in DB is
+// used if d is nil.
+func SetDB(d *DB) *DB {
+	prev := activeDB.Swap(d)
+	if prev == nil {
+		prev = builtinDB
+	}
+	return prev
+}
+
+// LoadDB loads a DB from a file; see ReadDB for the format.
+func LoadDB(path string) (*DB, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("isbot.LoadDB: %w", err)
+	}
+	defer fp.Close()
+
+	d, err := ReadDB(fp)
+	if err != nil {
+		return nil, fmt.Errorf("isbot.LoadDB: %s: %w", path, err)
+	}
+	return d, nil
+}
+
+// ReadDB reads a DB.
+//
+// This doesn't verify anything; use Verifier to load signed bundles from
+// untrusted locations.
+//
+// The format is line-based, with one entry per line:
+//
+//	# Comment
+//	version 2024-06-01
+//	browser "CUBOT_"
+//	client  "curl/"
+//	bot     "Googlebot/"
+//	range   3.0.0.0/15 8 AWS
+//	range   3.2.34.0/26 8 AWS af-south-1 EC2
+//
+// The browser, client, and bot entries are quoted Go strings which are
+// matched against the User-Agent; the built-in lists are used if there are none
+// of these. A range is a prefix, the Result for that
+// prefix, a name, and optionally the region and service; use "-" for an empty
+// region if there is a service.
+func ReadDB(r io.Reader) (*DB, error) {
+	var (
+		d    = &DB{ranges: new(rangeTable)}
+		scan = bufio.NewScanner(r)
+	)
+	for i := 1; scan.Scan(); i++ {
+		line := strings.TrimSpace(scan.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		if err := d.parseLine(line); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i, err)
+		}
+	}
+	if err := scan.Err(); err != nil {
+		return nil, err
+	}
+	if d.browsers == nil && d.clients == nil && d.bots == nil {
+		d.browsers, d.clients, d.bots = builtinDB.browsers, builtinDB.clients, builtinDB.bots
+	}
+	return d, nil
+}
+
+func (d *DB) parseLine(line string) error {
+	kind, rest, _ := strings.Cut(line, " ")
+	rest = strings.TrimSpace(rest)
+	switch kind {
+	case "version":
+		d.Version = rest
+	case "browser", "client", "bot":
+		s, err := strconv.Unquote(rest)
+		if err != nil {
+			return fmt.Errorf("%s: %q: %w", kind, rest, err)
+		}
+		switch kind {
+		case "browser":
+			d.browsers = append(d.browsers, s)
+		case "client":
+			d.clients = append(d.clients, s)
+		default:
+			d.bots = append(d.bots, s)
+		}
+	case "range":
+		f := strings.Fields(rest)
+		if len(f) < 3 || len(f) > 5 {
+			return fmt.Errorf("range: need 3 to 5 fields: %q", rest)
+		}
+		p, err := netip.ParsePrefix(f[0])
+		if err != nil {
+			return fmt.Errorf("range: %w", err)
+		}
+		res, err := strconv.ParseUint(f[1], 10, 8)
+		if err != nil {
+			return fmt.Errorf("range: %w", err)
+		}
+		rng := Range{Prefix: p.Masked(), Result: Result(res), Name: f[2]}
+		if len(f) > 3 && f[3] != "-" {
+			rng.Region = f[3]
+		}
+		if len(f) > 4 {
+			rng.Service = f[4]
+		}
+		d.ranges.add(rng)
+	default:
+		return fmt.Errorf("unknown entry %q", kind)
+	}
+	return nil
+}
+
+// WriteTo writes the DB in the format that ReadDB reads.
+func (d *DB) WriteTo(w io.Writer) (int64, error) {
+	var (
+		b = bufio.NewWriter(w)
+		n int64
+	)
+	p := func(format string, a ...any) {
+		m, _ := fmt.Fprintf(b, format, a...)
+		n += int64(m)
+	}
+	if d.Version != "" {
+		p("version %s\n", d.Version)
+	}
+	for _, s := range d.browsers {
+		p("browser %q\n", s)
+	}
+	for _, s := range d.clients {
+		p("client %q\n", s)
+	}
+	for _, s := range d.bots {
+		p("bot %q\n", s)
+	}
+	if d.ranges != nil {
+		for _, r := range d.ranges.all() {
+			switch {
+			case r.Service != "":
+				p("range %s %d %s %s %s\n", r.Prefix, r.Result, r.Name, cmp.Or(r.Region, "-"), r.Service)
+			case r.Region != "":
+				p("range %s %d %s %s\n", r.Prefix, r.Result, r.Name, r.Region)
+			default:
+				p("range %s %d %s\n", r.Prefix, r.Result, r.Name)
+			}
+		}
+	}
+	return n, b.Flush()
+}
+
+// Lookup implements Ranges.
+func (d *DB) Lookup(addr netip.Addr) (Range, bool) {
+	if !addr.IsValid() || d.ranges == nil {
+		return Range{}, false
+	}
+	return d.ranges.lookup(addr)
+}
+
+// WatchDB checks the modification time of the file at path every interval,
+// and loads it with LoadDB() and SetDB() if it changed. The file is loaded
+// once at the start if it exists.
+//
+// Errors are sent to onErr, which may be nil. This runs until the context is
+// cancelled. It returns an error right away if interval isn't positive.
+func WatchDB(ctx context.Context, path string, interval time.Duration, onErr func(error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("isbot.WatchDB: invalid interval %s", interval)
+	}
+
+	var last time.Time
+	check := func() {
+		st, err := os.Stat(path)
+		if err == nil && st.ModTime().Equal(last) {
+			return
+		}
+		var d *DB
+		if err == nil {
+			d, err = LoadDB(path)
+		}
+		if err != nil {
+			if onErr != nil {
+				onErr(err)
+			}
+			return
+		}
+		last = st.ModTime()
+		SetDB(d)
+	}
+
+	check()
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			check()
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package isbot
+
+import (
+	"bytes"
+	"context"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDB(t *testing.T) {
+	defer SetDB(nil)
+
+	if v := ActiveDB().Version; v != "builtin" {
+		t.Errorf("version: %q", v)
+	}
+
+	// Round-trip the built-in DB.
+	buf := new(bytes.Buffer)
+	if _, err := builtinDB.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	d, err := ReadDB(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.ranges.all()) != len(builtinDB.ranges.all()) || len(d.bots) != len(knownBots) || len(d.browsers) != len(knownBrowsers) {
+		t.Errorf("different DB after round-trip")
+	}
+	buf2 := new(bytes.Buffer)
+	d.WriteTo(buf2)
+	if !bytes.Equal(buf.Bytes(), buf2.Bytes()) {
+		t.Errorf("different output after round-trip")
+	}
+
+	d, err = ReadDB(strings.NewReader(`
+		# Comment
+		version 2024-06-01
+		bot "Mozilla/5.0 (X11; Linux x86_64; rv:126.0) Gecko/20100101 Firefox/126.0"
+		range 192.0.2.0/24 10 Example
+		range 198.51.100.0/24 8 AWS ap-southeast-1
+		range 203.0.113.0/24 8 AWS - CLOUDFRONT
+		range 2001:db8::/32 8 AWS us-west-2 EC2
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ff := "Mozilla/5.0 (X11; Linux x86_64; rv:126.0) Gecko/20100101 Firefox/126.0"
+	if got := UserAgent(ff); got != NoBotNoMatch {
+		t.Errorf("before SetDB: %s", got)
+	}
+	if prev := SetDB(d); prev != builtinDB {
+		t.Errorf("prev: %v", prev.Version)
+	}
+	if v := ActiveDB().Version; v != "2024-06-01" {
+		t.Errorf("version: %q", v)
+	}
+	if got := UserAgent(ff); got != BotKnownBot {
+		t.Errorf("after SetDB: %s", got)
+	}
+	if got := IPRange("192.0.2.1"); got != BotRangeServersCom {
+		t.Errorf("after SetDB: %s", got)
+	}
+	if got := IPRange("3.0.0.1"); got != NoBotNoMatch {
+		t.Errorf("after SetDB: %s", got)
+	}
+	for addr, want := range map[string][2]string{
+		"192.0.2.1":    {"", ""},
+		"198.51.100.1": {"ap-southeast-1", ""},
+		"203.0.113.1":  {"", "CLOUDFRONT"},
+		"2001:db8::1":  {"us-west-2", "EC2"},
+	} {
+		r, ok := LookupIP(netip.MustParseAddr(addr))
+		if !ok || r.Region != want[0] || r.Service != want[1] {
+			t.Errorf("%s: %v %v", addr, r, ok)
+		}
+	}
+	buf.Reset()
+	d.WriteTo(buf)
+	if !strings.Contains(buf.String(), "range 203.0.113.0/24 8 AWS - CLOUDFRONT\n") {
+		t.Errorf("WriteTo:\n%s", buf)
+	}
+
+	// Uses the built-in User-Agent lists if there are none.
+	d, err = ReadDB(strings.NewReader("version ranges\nrange 192.0.2.0/24 10 Example\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetDB(d)
+	if got := UserAgent("curl/8.4.0 (x86_64-pc-linux-gnu) libcurl/8.4.0"); got != BotClientLibrary {
+		t.Errorf("ranges only: %s", got)
+	}
+	if got := IPRange("192.0.2.1"); got != BotRangeServersCom {
+		t.Errorf("ranges only: %s", got)
+	}
+
+	for _, in := range []string{"bot Googlebot", "range 192.0.2.0/24 X", "range 192.0.2.0/24 999 X", "range 192.0.2.0/24 8 X a b c", "xxx"} {
+		if _, err := ReadDB(strings.NewReader(in)); err == nil {
+			t.Errorf("no error for %q", in)
+		}
+	}
+}
+
+func TestWatchDB(t *testing.T) {
+	defer SetDB(nil)
+
+	path := filepath.Join(t.TempDir(), "db")
+	os.WriteFile(path, []byte("version one\n"), 0o644)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errs := make(chan error, 10)
+	go WatchDB(ctx, path, 10*time.Millisecond, func(err error) {
+		select {
+		case errs <- err:
+		default:
+		}
+	})
+
+	wait := func(want string) {
+		t.Helper()
+		for range 200 {
+			if ActiveDB().Version == want {
+				return
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		t.Fatalf("version %q; want %q", ActiveDB().Version, want)
+	}
+	wait("one")
+
+	os.WriteFile(path, []byte("xxx\n"), 0o644)
+	os.Chtimes(path, time.Now(), time.Now().Add(time.Second))
+	if err := <-errs; err == nil || !strings.Contains(err.Error(), "unknown entry") {
+		t.Errorf("wrong error: %v", err)
+	}
+
+	os.WriteFile(path, []byte("version two\n"), 0o644)
+	os.Chtimes(path, time.Now(), time.Now().Add(2*time.Second))
+	wait("two")
+
+	if err := WatchDB(ctx, path, 0, nil); err == nil || err.Error() != "isbot.WatchDB: invalid interval 0s" {
+		t.Errorf("wrong error: %v", err)
+	}
+}
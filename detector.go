@@ -0,0 +1,95 @@
+package isbot
+
+import (
+	"net/http"
+	"net/netip"
+)
+
+// Checker is an additional check for a Detector.
+type Checker interface {
+	// Check the request. The addr is the client address, which may be invalid
+	// if it can't be determined.
+	//
+	// This should return NoBotNoMatch if the check has no opinion about this
+	// request.
+	Check(r *http.Request, addr netip.Addr) Result
+}
+
+// CheckerFunc is an adapter to use an ordinary function as a Checker.
+type CheckerFunc func(*http.Request, netip.Addr) Result
+
+// Check calls f(r, addr).
+func (f CheckerFunc) Check(r *http.Request, addr netip.Addr) Result { return f(r, addr) }
+
+// Detector checks requests with Bot() and a list of additional checks.
+//
+// The zero value is usable, and behaves like Bot().
+type Detector struct {
+	// Checkers are run in order before the regular checks from Bot(); the
+	// result of the first Checker that returns anything other than NoBotNoMatch
+	// is used.
+	Checkers []Checker
+
+	// Resolver to get the client address; the default is to use
+	// r.RemoteAddr. Use Proxies if requests go through a load balancer or
+	// reverse proxy.
+	Resolver Resolver
+
+	// Ranges are checked after the IP ranges from IPRange(), such as a List
+	// loaded with LoadList().
+	Ranges []Ranges
+}
+
+// Bot checks if this HTTP request looks like a bot.
+func (d *Detector) Bot(r *http.Request) Result {
+	addr := d.ClientIP(r)
+	for _, c := range d.Checkers {
+		if res := c.Check(r, addr); res != NoBotNoMatch {
+			return res
+		}
+	}
+
+	if Prefetch(r.Header) {
+		return BotPrefetch
+	}
+	bot := UserAgent(r.UserAgent())
+	if Is(bot) {
+		return bot
+	}
+	return d.IPRange(addr)
+}
+
+// ClientIP gets the client address with the Resolver.
+func (d *Detector) ClientIP(r *http.Request) netip.Addr {
+	if d.Resolver == nil {
+		return remoteAddr(r)
+	}
+	return d.Resolver.ClientIP(r)
+}
+
+// IPRange checks if this IP address is in any of the ranges from IPRange() or
+// d.Ranges. Addresses that aren't publicly routable are still checked against
+// d.Ranges, and return one of the Unknown* constants if they don't match.
+//
+// Unlike IPRangeAddr() this returns NoBotNoMatch for an invalid address, as
+// it's not known to not be a bot.
+func (d *Detector) IPRange(addr netip.Addr) Result {
+	if !addr.IsValid() {
+		return NoBotNoMatch
+	}
+	addr = normalizeAddr(addr)
+	res := IPRangeAddr(addr)
+	if res != NoBotNoMatch && !IsUnknown(res) {
+		return res
+	}
+	for _, r := range d.Ranges {
+		if rng, ok := r.Lookup(addr); ok {
+			return rng.Result
+		}
+	}
+	return res
+}
+
+// remoteAddr gets the address from r.RemoteAddr, which is usually in the
+// host:port form.
+func remoteAddr(r *http.Request) netip.Addr { return parseAddr(r.RemoteAddr) }
@@ -0,0 +1,106 @@
+package isbot
+
+import (
+	"cmp"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// Edge is a Checker for the bot verdicts that CDNs add to requests, such as
+// Cloudflare's bot score and verified bot flag.
+//
+// These are usually added as headers with a Transform Rule, Worker, or
+// similar. Headers are only used if the request came from one of the Trusted
+// ranges, as anyone can set them otherwise.
+//
+// Trusted must be set, as no headers are used otherwise.
+type Edge struct {
+	// Trusted ranges to accept headers from; this is usually the Edges of a
+	// CDN.
+	Trusted []netip.Prefix
+
+	// Peer gets the address of the server that connected to us; the default
+	// is to use r.RemoteAddr.
+	Peer Resolver
+
+	// Headers with a verified bot flag; any value other than "", "0", "false",
+	// or "no" means it's a verified bot. The default is CF-Verified-Bot.
+	Verified []string
+
+	// Headers with a bot score from 1 to 99, where lower is more likely to be
+	// a bot. The default is CF-Bot-Score.
+	Score []string
+
+	// Maximum score to be considered a bot; the default is 29, which is what
+	// Cloudflare considers "likely automated".
+	MaxScore int
+}
+
+// Check implements Checker.
+//
+// It returns BotEdgeVerified if the CDN marked the request as a verified bot,
+// and BotEdgeScore if the score is MaxScore or lower.
+func (e *Edge) Check(r *http.Request, _ netip.Addr) Result {
+	if !e.trusted(r) {
+		return NoBotNoMatch
+	}
+
+	verified := e.Verified
+	if verified == nil {
+		verified = []string{"CF-Verified-Bot"}
+	}
+	for _, h := range verified {
+		switch strings.ToLower(strings.TrimSpace(r.Header.Get(h))) {
+		case "", "0", "false", "no":
+		default:
+			return BotEdgeVerified
+		}
+	}
+
+	if s, ok := e.score(r); ok && s <= cmp.Or(e.MaxScore, 29) {
+		return BotEdgeScore
+	}
+	return NoBotNoMatch
+}
+
+// BotScore gets the bot score the CDN assigned to this request. It returns
+// false if there is no score, or if the request didn't come from a Trusted
+// range.
+func (e *Edge) BotScore(r *http.Request) (int, bool) {
+	if !e.trusted(r) {
+		return 0, false
+	}
+	return e.score(r)
+}
+
+func (e *Edge) score(r *http.Request) (int, bool) {
+	score := e.Score
+	if score == nil {
+		score = []string{"CF-Bot-Score"}
+	}
+	for _, h := range score {
+		s, err := strconv.Atoi(strings.TrimSpace(r.Header.Get(h)))
+		if err == nil && s > 0 {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+func (e *Edge) trusted(r *http.Request) bool {
+	var peer netip.Addr
+	if e.Peer == nil {
+		peer = remoteAddr(r)
+	} else {
+		peer = e.Peer.ClientIP(r)
+	}
+	peer = peer.Unmap()
+	for _, p := range e.Trusted {
+		if p.Contains(peer) {
+			return true
+		}
+	}
+	return false
+}
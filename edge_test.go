@@ -0,0 +1,59 @@
+package isbot
+
+import (
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestEdge(t *testing.T) {
+	cf := Cloudflare()
+	tests := []struct {
+		edge   *Edge
+		remote string
+		header map[string]string
+		want   Result
+		score  int
+	}{
+		{&Edge{Trusted: cf.Edges}, "104.16.0.1:443", nil, NoBotNoMatch, 0},
+		{&Edge{Trusted: cf.Edges}, "104.16.0.1:443", map[string]string{"CF-Bot-Score": "1"}, BotEdgeScore, 1},
+		{&Edge{Trusted: cf.Edges}, "104.16.0.1:443", map[string]string{"CF-Bot-Score": "29"}, BotEdgeScore, 29},
+		{&Edge{Trusted: cf.Edges}, "104.16.0.1:443", map[string]string{"CF-Bot-Score": "30"}, NoBotNoMatch, 30},
+		{&Edge{Trusted: cf.Edges}, "104.16.0.1:443", map[string]string{"CF-Bot-Score": "0"}, NoBotNoMatch, 0},
+		{&Edge{Trusted: cf.Edges}, "104.16.0.1:443", map[string]string{"CF-Verified-Bot": "true", "CF-Bot-Score": "90"}, BotEdgeVerified, 90},
+		{&Edge{Trusted: cf.Edges}, "104.16.0.1:443", map[string]string{"CF-Verified-Bot": "false"}, NoBotNoMatch, 0},
+		{&Edge{Trusted: cf.Edges, MaxScore: 50}, "104.16.0.1:443", map[string]string{"CF-Bot-Score": "40"}, BotEdgeScore, 40},
+
+		// Not trusted.
+		{&Edge{Trusted: cf.Edges}, "192.0.2.1:443", map[string]string{"CF-Bot-Score": "1", "CF-Verified-Bot": "1"}, NoBotNoMatch, 0},
+		{&Edge{}, "104.16.0.1:443", map[string]string{"CF-Bot-Score": "1"}, NoBotNoMatch, 0},
+
+		// Custom headers.
+		{&Edge{Trusted: cf.Edges, Verified: []string{"Akamai-Bot"}, Score: []string{"X-Score"}},
+			"104.16.0.1:443", map[string]string{"Akamai-Bot": "Search Engine"}, BotEdgeVerified, 0},
+		{&Edge{Trusted: cf.Edges, Verified: []string{"Akamai-Bot"}, Score: []string{"X-Score"}},
+			"104.16.0.1:443", map[string]string{"CF-Bot-Score": "1", "X-Score": "5"}, BotEdgeScore, 5},
+		{&Edge{Trusted: cf.Edges, Verified: []string{"Akamai-Bot"}, Score: []string{"X-Score"}},
+			"104.16.0.1:443", map[string]string{"CF-Verified-Bot": "1", "CF-Bot-Score": "1"}, NoBotNoMatch, 0},
+
+		// Behind a load balancer.
+		{&Edge{Trusted: cf.Edges, Peer: Proxies{Trusted: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}}},
+			"10.0.0.1:443", map[string]string{"X-Forwarded-For": "104.16.0.1", "CF-Bot-Score": "1"}, BotEdgeScore, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remote
+			for k, v := range tt.header {
+				r.Header.Set(k, v)
+			}
+			if got := tt.edge.Check(r, netip.Addr{}); got != tt.want {
+				t.Errorf("got %s; want %s", got, tt.want)
+			}
+			if got, _ := tt.edge.BotScore(r); got != tt.score {
+				t.Errorf("score %d; want %d", got, tt.score)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package isbot
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileStore is a Store that keeps everything in memory, and appends all
+// changes to a file so that the state survives restarts.
+//
+// Writes are buffered, and flushed at most a second later; changes in the last
+// second may be lost if the process crashes. The file is compacted when it's
+// opened, and automatically when it grows to several times the number of
+// entries; use Compact() to compact it manually.
+//
+// Only one process can use the file at a time; opening the same file from
+// several processes will lose data.
+type FileStore struct {
+	mem  *MemoryStore
+	path string
+
+	mu        sync.Mutex
+	fp        *os.File
+	w         *bufio.Writer
+	flush     *time.Timer
+	lines     int // Lines in the file.
+	compacted int // Lines in the file after the last compaction.
+}
+
+// Compact the file when it's grown to compactFactor times the number of lines
+// after the last compaction, but only if it has at least compactMin lines.
+const (
+	compactFactor = 4
+	compactMin    = 10_000
+)
+
+// OpenFileStore opens the FileStore at path, creating it if it doesn't exist.
+//
+// It keeps at most max keys, as with NewMemoryStore().
+func OpenFileStore(path string, max int) (*FileStore, error) {
+	s := &FileStore{mem: NewMemoryStore(max), path: path}
+
+	fp, err := os.Open(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("isbot.OpenFileStore: %w", err)
+	}
+	if err == nil {
+		err = s.replay(fp)
+		fp.Close()
+		if err != nil {
+			return nil, fmt.Errorf("isbot.OpenFileStore: %s: %w", path, err)
+		}
+	}
+
+	if err := s.Compact(); err != nil {
+		return nil, fmt.Errorf("isbot.OpenFileStore: %w", err)
+	}
+	return s, nil
+}
+
+// replay the changes in the log. An incomplete last line is ignored, as that
+// happens if the process stopped while writing.
+func (s *FileStore) replay(r io.Reader) error {
+	var (
+		br  = bufio.NewReader(r)
+		now = s.mem.now()
+	)
+	for i := 1; ; i++ {
+		line, err := br.ReadString('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var (
+			exp       int64
+			n         int64
+			key, memb string
+		)
+		switch line = strings.TrimSuffix(line, "\n"); {
+		case strings.HasPrefix(line, "+ "):
+			_, err = fmt.Sscanf(line, "+ %d %d %q", &exp, &n, &key)
+		case strings.HasPrefix(line, "a "):
+			_, err = fmt.Sscanf(line, "a %d %q %q", &exp, &key, &memb)
+		default:
+			err = errors.New("unknown operation")
+		}
+		if err != nil {
+			return fmt.Errorf("line %d: %w", i, err)
+		}
+
+		e := time.Unix(0, exp)
+		switch {
+		case now.After(e):
+		case line[0] == '+':
+			s.mem.incr(key, n, e)
+		default:
+			s.mem.add(key, memb, e)
+		}
+	}
+}
+
+// Compact the file, so that it only contains the entries that haven't expired
+// yet.
+func (s *FileStore) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.compact()
+}
+
+func (s *FileStore) compact() error {
+	entries, _ := s.mem.Snapshot()
+	tmp := s.path + ".tmp"
+	fp, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	var (
+		w     = bufio.NewWriter(fp)
+		lines int
+	)
+	for _, e := range entries {
+		if e.Members == nil {
+			fmt.Fprintf(w, "+ %d %d %s\n", e.Expires.UnixNano(), e.Count, strconv.Quote(e.Key))
+			lines++
+			continue
+		}
+		for m, exp := range e.Members {
+			fmt.Fprintf(w, "a %d %s %s\n", exp.UnixNano(), strconv.Quote(e.Key), strconv.Quote(m))
+			lines++
+		}
+	}
+	err = w.Flush()
+	if err == nil {
+		err = fp.Sync()
+	}
+	if err2 := fp.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	// Open files can't be replaced on Windows, so close it first. Anything
+	// that's still buffered is already in the new file.
+	if s.fp != nil {
+		s.w.Reset(io.Discard)
+		s.fp.Close()
+		s.fp = nil
+	}
+	err = os.Rename(tmp, s.path)
+	if err != nil {
+		os.Remove(tmp)
+	} else {
+		s.lines, s.compacted = lines, lines
+	}
+
+	fp, err2 := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err2 != nil {
+		return errors.Join(err, err2)
+	}
+	s.fp = fp
+	if s.w == nil {
+		s.w = bufio.NewWriter(fp)
+	} else {
+		s.w.Reset(fp)
+	}
+	return err
+}
+
+// Flush all buffered writes to the file.
+func (s *FileStore) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.flushLocked()
+}
+
+func (s *FileStore) flushLocked() error {
+	if s.flush != nil {
+		s.flush.Stop()
+		s.flush = nil
+	}
+	if s.fp == nil {
+		return nil
+	}
+	return s.w.Flush()
+}
+
+// Close the file, after flushing all buffered writes.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fp == nil {
+		return nil
+	}
+	err := s.flushLocked()
+	if err2 := s.fp.Close(); err == nil {
+		err = err2
+	}
+	s.fp = nil
+	return err
+}
+
+func (s *FileStore) write(line string) error {
+	if s.fp == nil {
+		return errors.New("isbot.FileStore: closed")
+	}
+	if _, err := s.w.WriteString(line); err != nil {
+		return err
+	}
+	s.lines++
+	if s.lines >= compactMin && s.lines >= compactFactor*s.compacted {
+		err := s.compact()
+		if err != nil {
+			s.compacted = s.lines // Don't retry on every write.
+		}
+		return err
+	}
+	if s.flush == nil {
+		s.flush = time.AfterFunc(time.Second, func() { s.Flush() })
+	}
+	return nil
+}
+
+// Incr implements Store.
+func (s *FileStore) Incr(key string, n int64, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, exp, err := s.mem.incr(key, n, s.mem.now().Add(ttl))
+	if n == 0 || err != nil {
+		return v, err
+	}
+	return v, s.write(fmt.Sprintf("+ %d %d %s\n", exp.UnixNano(), n, strconv.Quote(key)))
+}
+
+// Add implements Store.
+func (s *FileStore) Add(set, member string, ttl time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	exp := s.mem.now().Add(ttl)
+	v, err := s.mem.add(set, member, exp)
+	if err != nil {
+		return v, err
+	}
+	return v, s.write(fmt.Sprintf("a %d %s %s\n", exp.UnixNano(), strconv.Quote(set), strconv.Quote(member)))
+}
+
+// Has implements Store.
+func (s *FileStore) Has(set, member string) (bool, error) { return s.mem.Has(set, member) }
+
+// Snapshot implements Store.
+func (s *FileStore) Snapshot() ([]Entry, error) { return s.mem.Snapshot() }
@@ -0,0 +1,100 @@
+package isbot
+
+import (
+	"html/template"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Honeypot adds clients that request trap URLs to a blocklist.
+//
+// The trap URLs are hidden from people with Link() and disallowed in
+// robots.txt with Robots(), so the only clients that request them are
+// misbehaving bots.
+//
+// Either add the Honeypot to Detector.Checkers, or register it as a handler
+// for all the Paths and add it to Detector.Checkers or Detector.Ranges.
+//
+// The zero value is usable, and uses the defaults as listed below.
+type Honeypot struct {
+	// Paths to use as traps; the default is "/.isbot/trap".
+	Paths []string
+
+	// Blocklist to add clients to; the default is a new blocklist with
+	// BotHoneypot as the result.
+	Blocklist *Blocklist
+
+	// Resolver to get the client address in ServeHTTP; the default is to use
+	// r.RemoteAddr.
+	Resolver Resolver
+
+	once sync.Once
+}
+
+func (h *Honeypot) init() {
+	h.once.Do(func() {
+		if len(h.Paths) == 0 {
+			h.Paths = []string{"/.isbot/trap"}
+		}
+		if h.Blocklist == nil {
+			h.Blocklist = &Blocklist{Name: "Honeypot", Result: BotHoneypot}
+		}
+	})
+}
+
+// ServeHTTP adds the client to the blocklist and sends a 404.
+func (h *Honeypot) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.init()
+	if h.Resolver != nil {
+		h.Blocklist.Add(h.Resolver.ClientIP(r))
+	} else {
+		h.Blocklist.Add(remoteAddr(r))
+	}
+	http.NotFound(w, r)
+}
+
+// Check implements Checker.
+//
+// This adds the client to the blocklist if it requests one of the Paths, and
+// returns BotHoneypot if it's on the blocklist.
+func (h *Honeypot) Check(r *http.Request, addr netip.Addr) Result {
+	h.init()
+	if slices.Contains(h.Paths, r.URL.Path) {
+		h.Blocklist.Add(addr)
+	}
+	return h.Blocklist.Check(r, addr)
+}
+
+// Lookup implements Ranges.
+func (h *Honeypot) Lookup(addr netip.Addr) (Range, bool) {
+	h.init()
+	return h.Blocklist.Lookup(addr)
+}
+
+// Link gets HTML links to all the Paths, which are hidden from people.
+func (h *Honeypot) Link() template.HTML {
+	h.init()
+	var b strings.Builder
+	for _, p := range h.Paths {
+		b.WriteString(`<a href="`)
+		b.WriteString(template.HTMLEscapeString(p))
+		b.WriteString(`" rel="nofollow" style="display:none" tabindex="-1" aria-hidden="true">More</a>`)
+	}
+	return template.HTML(b.String())
+}
+
+// Robots gets the robots.txt Disallow lines for all the Paths. This should be
+// added to the "User-agent: *" group.
+func (h *Honeypot) Robots() string {
+	h.init()
+	var b strings.Builder
+	for _, p := range h.Paths {
+		b.WriteString("Disallow: ")
+		b.WriteString(p)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
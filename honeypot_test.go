@@ -0,0 +1,57 @@
+package isbot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestHoneypot(t *testing.T) {
+	h := &Honeypot{Paths: []string{"/trap", "/secret"}}
+
+	if got := string(h.Link()); got != `<a href="/trap" rel="nofollow" style="display:none" tabindex="-1" aria-hidden="true">More</a>`+
+		`<a href="/secret" rel="nofollow" style="display:none" tabindex="-1" aria-hidden="true">More</a>` {
+		t.Errorf("Link:\n%s", got)
+	}
+	if got := h.Robots(); got != "Disallow: /trap\nDisallow: /secret\n" {
+		t.Errorf("Robots:\n%s", got)
+	}
+
+	var (
+		addr  = netip.MustParseAddr("192.0.2.1")
+		other = netip.MustParseAddr("192.0.2.2")
+		page  = httptest.NewRequest("GET", "/page", nil)
+	)
+	if got := h.Check(page, addr); got != NoBotNoMatch {
+		t.Fatalf("got %s", got)
+	}
+	if got := h.Check(httptest.NewRequest("GET", "/trap", nil), addr); got != BotHoneypot {
+		t.Fatalf("got %s", got)
+	}
+	if got := h.Check(page, addr); got != BotHoneypot {
+		t.Fatalf("got %s", got)
+	}
+	if got := h.Check(page, other); got != NoBotNoMatch {
+		t.Fatalf("got %s", got)
+	}
+
+	r := httptest.NewRequest("GET", "/secret", nil)
+	r.RemoteAddr = "[2001:db8::1]:1234"
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, r)
+	if rr.Code != 404 {
+		t.Errorf("status %d", rr.Code)
+	}
+	rng, ok := h.Lookup(netip.MustParseAddr("2001:db8::42"))
+	if !ok || rng.Result != BotHoneypot || rng.Prefix != netip.MustParsePrefix("2001:db8::/64") {
+		t.Errorf("Lookup: %v %v", rng, ok)
+	}
+
+	d := &Detector{Ranges: []Ranges{h}}
+	r = &http.Request{Header: make(http.Header), RemoteAddr: "[2001:db8::2]:1234"}
+	r.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:75.0) Gecko/20100101 Firefox/75.0")
+	if got := d.Bot(r); got != BotHoneypot {
+		t.Errorf("Detector: %s", got)
+	}
+}
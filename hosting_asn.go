@@ -0,0 +1,66 @@
+package isbot
+
+// hostingASN are autonomous systems of hosting providers.
+//
+// This only lists ASNs that are used (almost) exclusively for servers; large
+// providers that also have offices or consumer networks, such as Microsoft and
+// Google, are not included. Neither are providers that mostly host VPN exits,
+// such as M247, as those are used by people.
+var hostingASN = map[uint32]string{
+	2635:   "Automattic",
+	7203:   "LeaseWeb",
+	7979:   "ServersCom",
+	8100:   "QuadraNet",
+	8560:   "IONOS",
+	9370:   "Sakura Internet",
+	12876:  "Scaleway",
+	14061:  "DigitalOcean",
+	14618:  "AWS",
+	15003:  "Nobis",
+	16265:  "LeaseWeb",
+	16276:  "OVH",
+	16509:  "AWS",
+	19318:  "Interserver",
+	20473:  "Vultr",
+	20773:  "Host Europe",
+	21859:  "Zenlayer",
+	22612:  "Namecheap",
+	24940:  "Hetzner",
+	25820:  "IT7 Networks",
+	26347:  "DreamHost",
+	28753:  "LeaseWeb",
+	29802:  "Hivelocity",
+	30633:  "LeaseWeb",
+	31898:  "Oracle",
+	32613:  "iWeb",
+	35916:  "Multacom",
+	36007:  "Kamatera",
+	36351:  "IBM Cloud",
+	36352:  "ColoCrossing",
+	37963:  "Alibaba",
+	40509:  "Fly.io",
+	40676:  "Psychz",
+	43350:  "NForce",
+	45090:  "Tencent",
+	45102:  "Alibaba",
+	46606:  "Unified Layer",
+	47583:  "Hostinger",
+	49981:  "WorldStream",
+	51167:  "Contabo",
+	53667:  "FranTech",
+	55286:  "ServerMania",
+	55990:  "Huawei Cloud",
+	57043:  "Hostkey",
+	59253:  "LeaseWeb",
+	60781:  "LeaseWeb",
+	62240:  "Clouvider",
+	63949:  "Linode",
+	132203: "Tencent",
+	136907: "Huawei Cloud",
+	197540: "netcup",
+	199524: "G-Core Labs",
+	202053: "UpCloud",
+	212317: "Hetzner",
+	213230: "Hetzner",
+	396982: "Google Cloud",
+}
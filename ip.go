@@ -3,93 +3,373 @@
 package isbot
 
 import (
+	"bytes"
+	"fmt"
+	"net"
 	"net/netip"
+	"slices"
 	"strings"
 )
 
-type ipRange struct {
-	bot    Result
-	prefix netip.Prefix
+// Range is an IP range.
+type Range struct {
+	Prefix  netip.Prefix
+	Result  Result
+	Name    string // Name of the provider or list.
+	Region  string // Region of the cloud provider, such as "us-east-1"; may be empty.
+	Service string // Service of the cloud provider, such as "EC2" or "CLOUDFRONT"; may be empty.
+	ASN     uint32 // Autonomous system number; only set by ASNDB.
+	Org     string // Organisation of the autonomous system; only set by ASNDB.
 }
 
-func botname(n string) Result {
-	switch n {
-	case "AWS":
-		return BotRangeAWS
-	case "DigitalOcean":
-		return BotRangeDigitalOcean
-	case "ServersCom":
-		return BotRangeServersCom
-	case "GoogleCloud":
-		return BotRangeGoogleCloud
-	case "Hetzner":
-		return BotRangeHetzner
-	case "Azure":
-		return BotRangeAzure
-	case "Alibaba":
-		return BotRangeAlibaba
-	case "Linode":
-		return BotRangeLinode
-	case "Oracle":
-		return BotRangeOracle
-	case "OVH":
-		return BotRangeOVH
-	}
-	panic(n)
-}
-
-var ipRanges4 = func() map[byte][]ipRange {
-	m := make(map[byte][]ipRange)
-	for f := range strings.FieldsSeq(ranges4) {
-		ip, name, ok := strings.Cut(f, ",")
-		if !ok {
-			panic(f)
+// Ranges is a set of IP ranges.
+type Ranges interface {
+	// Lookup finds the range this address is in.
+	Lookup(addr netip.Addr) (Range, bool)
+}
+
+// rangeTable is a set of IP ranges, bucketed by the first byte (IPv4) or first
+// two bytes (IPv6) of the address.
+type rangeTable struct {
+	v4   map[byte][]rangeEntry
+	v6   map[[2]byte][]rangeEntry
+	wide []rangeEntry // Ranges that don't fit in one bucket.
+}
+
+// rangeEntry is a range in a rangeTable. If last is set this is the interval
+// from Prefix.Addr() to last, rather than just the Prefix.
+type rangeEntry struct {
+	Range
+	last netip.Addr
+}
+
+func (t *rangeTable) add(r Range) {
+	t.insert(rangeEntry{Range: r}, lastAddr(r.Prefix))
+}
+
+// addInterval adds all addresses from first to last.
+func (t *rangeTable) addInterval(r Range, first, last netip.Addr) {
+	r.Prefix = netip.PrefixFrom(first, first.BitLen())
+	t.insert(rangeEntry{Range: r, last: last}, last)
+}
+
+func (t *rangeTable) insert(e rangeEntry, last netip.Addr) {
+	first := e.Prefix.Addr()
+	switch {
+	case first.Is4() && first.As4()[0] == last.As4()[0]:
+		if t.v4 == nil {
+			t.v4 = make(map[byte][]rangeEntry)
+		}
+		k := first.As4()[0]
+		t.v4[k] = append(t.v4[k], e)
+	case first.Is6() && first.As16()[0] == last.As16()[0] && first.As16()[1] == last.As16()[1]:
+		if t.v6 == nil {
+			t.v6 = make(map[[2]byte][]rangeEntry)
+		}
+		as := first.As16()
+		k := [2]byte{as[0], as[1]}
+		t.v6[k] = append(t.v6[k], e)
+	default:
+		t.wide = append(t.wide, e)
+	}
+}
+
+func (t *rangeTable) lookup(addr netip.Addr) (Range, bool) {
+	addr = addr.Unmap()
+	var entries []rangeEntry
+	if addr.Is4() {
+		entries = t.v4[addr.As4()[0]]
+	} else {
+		as := addr.As16()
+		entries = t.v6[[2]byte{as[0], as[1]}]
+	}
+	for _, e := range entries {
+		if r, ok := e.match(addr); ok {
+			return r, true
+		}
+	}
+	for _, e := range t.wide {
+		if r, ok := e.match(addr); ok {
+			return r, true
 		}
-		prefix := netip.MustParsePrefix(ip)
-		k := prefix.Addr().As4()[0]
-		m[k] = append(m[k], ipRange{prefix: prefix, bot: botname(name)})
+	}
+	return Range{}, false
+}
+
+// match reports if addr is in this range. For intervals the Prefix is set to
+// the largest prefix in the interval that contains addr.
+func (e rangeEntry) match(addr netip.Addr) (Range, bool) {
+	if !e.last.IsValid() {
+		return e.Range, e.Prefix.Contains(addr)
+	}
+	first := e.Prefix.Addr()
+	if first.BitLen() != addr.BitLen() || addr.Less(first) || e.last.Less(addr) {
+		return Range{}, false
+	}
+	r := e.Range
+	for bits := 0; bits <= addr.BitLen(); bits++ {
+		p, _ := addr.Prefix(bits)
+		if !p.Addr().Less(first) && !e.last.Less(lastAddr(p)) {
+			r.Prefix = p
+			break
+		}
+	}
+	return r, true
+}
+
+// all gets all ranges, in the order they're checked. Intervals are split into
+// prefixes.
+func (t *rangeTable) all() []Range {
+	entries := make([]rangeEntry, 0, t.len())
+	for k := range 256 {
+		entries = append(entries, t.v4[byte(k)]...)
+	}
+	keys := make([][2]byte, 0, len(t.v6))
+	for k := range t.v6 {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(a, b [2]byte) int { return bytes.Compare(a[:], b[:]) })
+	for _, k := range keys {
+		entries = append(entries, t.v6[k]...)
+	}
+	entries = append(entries, t.wide...)
+
+	all := make([]Range, 0, len(entries))
+	for _, e := range entries {
+		if !e.last.IsValid() {
+			all = append(all, e.Range)
+			continue
+		}
+		for _, p := range intervalPrefixes(e.Prefix.Addr(), e.last) {
+			r := e.Range
+			r.Prefix = p
+			all = append(all, r)
+		}
+	}
+	return all
+}
+
+// intervalPrefixes gets the smallest set of prefixes that cover all addresses
+// from first to last.
+func intervalPrefixes(first, last netip.Addr) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for !last.Less(first) {
+		// The largest prefix that starts at first and doesn't go past last.
+		p := netip.PrefixFrom(first, first.BitLen())
+		for bits := first.BitLen() - 1; bits >= 0; bits-- {
+			pp, _ := first.Prefix(bits)
+			if pp.Addr() != first || last.Less(lastAddr(pp)) {
+				break
+			}
+			p = pp
+		}
+		prefixes = append(prefixes, p)
+		next := lastAddr(p).Next()
+		if !next.IsValid() {
+			break
+		}
+		first = next
+	}
+	return prefixes
+}
+
+// lastAddr gets the last address in the prefix.
+func lastAddr(p netip.Prefix) netip.Addr {
+	switch {
+	case !p.IsValid():
+		return netip.Addr{}
+	case p.Addr().Is4():
+		a := p.Masked().Addr().As4()
+		for i := p.Bits(); i < 32; i++ {
+			a[i/8] |= 1 << (7 - i%8)
+		}
+		return netip.AddrFrom4(a)
+	default:
+		a := p.Masked().Addr().As16()
+		for i := p.Bits(); i < 128; i++ {
+			a[i/8] |= 1 << (7 - i%8)
+		}
+		return netip.AddrFrom16(a)
+	}
+}
+
+func (t *rangeTable) len() int {
+	n := len(t.wide)
+	for _, r := range t.v4 {
+		n += len(r)
+	}
+	for _, r := range t.v6 {
+		n += len(r)
+	}
+	return n
+}
+
+// providerResults maps the provider names in ip_ranges.go to the Result.
+var providerResults = func() map[string]Result {
+	m := make(map[string]Result, len(providers))
+	for r, n := range providers {
+		m[n] = r
 	}
 	return m
 }()
 
-var ipRanges6 = func() map[[2]byte][]ipRange {
-	m := make(map[[2]byte][]ipRange)
-	for f := range strings.FieldsSeq(ranges6) {
-		ip, name, ok := strings.Cut(f, ",")
-		if !ok {
+func botname(n string) Result {
+	r, ok := providerResults[n]
+	if !ok {
+		panic(n)
+	}
+	return r
+}
+
+var ipRanges = parseRanges(ranges4 + "\n" + ranges6)
+
+// parseRanges parses the ranges from ip_ranges.go, which are whitespace
+// separated as prefix,name[,region[,service]]. Instead of a prefix it can also
+// be an interval as first-last.
+func parseRanges(s string) *rangeTable {
+	t := new(rangeTable)
+	for _, f := range strings.Fields(s) {
+		x := strings.SplitN(f, ",", 4)
+		if len(x) < 2 {
 			panic(f)
 		}
-		prefix := netip.MustParsePrefix(ip)
-		as := prefix.Addr().As16()
-		k := [2]byte{as[0], as[1]}
-		m[k] = append(m[k], ipRange{prefix: prefix, bot: botname(name)})
+		x = append(x, "", "")
+		r := Range{Result: botname(x[1]), Name: x[1], Region: x[2], Service: x[3]}
+		if first, last, ok := strings.Cut(x[0], "-"); ok {
+			t.addInterval(r, netip.MustParseAddr(first), netip.MustParseAddr(last))
+			continue
+		}
+		r.Prefix = netip.MustParsePrefix(x[0])
+		t.add(r)
 	}
-	return m
-}()
+	return t
+}
 
 // IPRange checks if this IP address is from a range that should normally never
 // send browser requests, such as AWS and other cloud providers.
+//
+// The address is parsed with ParseAddr(), so it may include a port. This
+// returns NoBotKnown if the address can't be parsed.
+//
+// This only checks the address it's given; behind a proxy or load balancer get
+// the client address with Proxies.ClientIP() first, or use a Detector with a
+// Resolver.
 func IPRange(addr string) Result {
-	if addr == "" {
+	ip, err := ParseAddr(addr)
+	if err != nil {
 		return NoBotKnown
 	}
-	ip, err := netip.ParseAddr(addr)
-	if err != nil {
+	return IPRangeAddr(ip)
+}
+
+// IPRangeAddr is like IPRange(), but takes a netip.Addr. It returns NoBotKnown
+// if the address is invalid.
+func IPRangeAddr(ip netip.Addr) Result {
+	if !ip.IsValid() {
 		return NoBotKnown
 	}
+	ip = normalizeAddr(ip)
+	if r, ok := LookupIP(ip); ok {
+		return r.Result
+	}
+	return unroutable(ip)
+}
 
-	var ranges []ipRange
-	if ip.Is4() {
-		ranges = ipRanges4[ip.As4()[0]]
-	} else {
-		as := ip.As16()
-		ranges = ipRanges6[[2]byte{as[0], as[1]}]
+var (
+	cgnat    = netip.MustParsePrefix("100.64.0.0/10")
+	reserved = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/8"),       // "This network"
+		netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+		netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+		netip.MustParsePrefix("198.18.0.0/15"),   // Benchmarking
+		netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+		netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+		netip.MustParsePrefix("240.0.0.0/4"),     // Reserved, and broadcast
+		netip.MustParsePrefix("100::/64"),        // Discard
+		netip.MustParsePrefix("2001:db8::/32"),   // Documentation
+		netip.MustParsePrefix("3fff::/20"),       // Documentation
 	}
+)
 
-	for _, r := range ranges {
-		if r.prefix.Contains(ip) {
-			return r.bot
+// unroutable gets one of the Unknown* constants if this address isn't
+// publicly routable, or NoBotNoMatch if it is.
+func unroutable(ip netip.Addr) Result {
+	switch {
+	case ip.IsLoopback():
+		return UnknownLoopback
+	case ip.IsPrivate():
+		return UnknownPrivate
+	case cgnat.Contains(ip):
+		return UnknownCGNAT
+	case ip.IsLinkLocalUnicast():
+		return UnknownLinkLocal
+	case ip.IsMulticast():
+		return UnknownMulticast
+	case ip.IsUnspecified():
+		return UnknownReserved
+	}
+	for _, p := range reserved {
+		if p.Contains(ip) {
+			return UnknownReserved
 		}
 	}
 	return NoBotNoMatch
 }
+
+// ParseAddr parses an IP address, as found in r.RemoteAddr and various
+// headers.
+//
+// The address may have a port ("192.0.2.1:80", "[2001:db8::1]:80"), IPv6
+// addresses may be in brackets, and zones are removed. Addresses with an
+// embedded IPv4 address are converted to that IPv4 address:
+//
+//   - IPv4-mapped addresses (::ffff:0:0/96)
+//   - NAT64 (64:ff9b::/96)
+//   - 6to4 (2002::/16)
+//   - Teredo (2001::/32)
+func ParseAddr(s string) (netip.Addr, error) {
+	s = strings.TrimSpace(s)
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		s = host
+	} else if len(s) > 1 && s[0] == '[' && s[len(s)-1] == ']' {
+		s = s[1 : len(s)-1]
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("isbot.ParseAddr: %w", err)
+	}
+	return normalizeAddr(addr), nil
+}
+
+// parseAddr is like ParseAddr, but returns an invalid address on errors.
+func parseAddr(s string) netip.Addr {
+	addr, _ := ParseAddr(s)
+	return addr
+}
+
+var (
+	nat64  = netip.MustParsePrefix("64:ff9b::/96")
+	sixTo4 = netip.MustParsePrefix("2002::/16")
+	teredo = netip.MustParsePrefix("2001::/32")
+)
+
+// normalizeAddr converts addresses with an embedded IPv4 address to that
+// address, and removes the IPv6 zone.
+func normalizeAddr(addr netip.Addr) netip.Addr {
+	addr = addr.Unmap().WithZone("")
+	if !addr.Is6() {
+		return addr
+	}
+	a := addr.As16()
+	switch {
+	case nat64.Contains(addr):
+		return netip.AddrFrom4([4]byte(a[12:]))
+	case sixTo4.Contains(addr):
+		return netip.AddrFrom4([4]byte(a[2:6]))
+	case teredo.Contains(addr):
+		// The client address is the last 32 bits, inverted.
+		return netip.AddrFrom4([4]byte{^a[12], ^a[13], ^a[14], ^a[15]})
+	}
+	return addr
+}
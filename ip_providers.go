@@ -0,0 +1,51 @@
+// Code generated by cmd/iprange command; DO NOT EDIT.
+
+package isbot
+
+// Bots identified by IP.
+const (
+	BotRangeAWS               = 8  // AWS cloud
+	BotRangeDigitalOcean      = 9  // Digital Ocean
+	BotRangeServersCom        = 10 // servers.com
+	BotRangeGoogleCloud       = 11 // Google Cloud
+	BotRangeHetzner           = 12 // hetzner.de
+	BotRangeAzure             = 13 // Azure Cloud
+	BotRangeAlibaba           = 14 // Alibaba cloud
+	BotRangeLinode            = 15 // Linode
+	BotRangeOracle            = 16 // Oracle cloud
+	BotRangeOVH               = 17 // OVH Cloud
+	BotRangeVultr             = 18 // Vultr
+	BotRangeScaleway          = 19 // Scaleway
+	BotRangeTencentCloud      = 20 // Tencent Cloud
+	BotRangeHuaweiCloud       = 21 // Huawei Cloud
+	BotRangeContabo           = 22 // Contabo
+	BotRangeIBMCloud          = 23 // IBM Cloud (SoftLayer)
+	BotRangeUpCloud           = 24 // UpCloud
+	BotRangeKamatera          = 25 // Kamatera
+	BotRangeCloudflareWorkers = 26 // Cloudflare Workers egress
+	BotRangeFlyIO             = 27 // Fly.io
+)
+
+// providers are the names of the providers in ip_ranges.go.
+var providers = map[Result]string{
+	BotRangeAWS:               "AWS",
+	BotRangeDigitalOcean:      "DigitalOcean",
+	BotRangeServersCom:        "ServersCom",
+	BotRangeGoogleCloud:       "GoogleCloud",
+	BotRangeHetzner:           "Hetzner",
+	BotRangeAzure:             "Azure",
+	BotRangeAlibaba:           "Alibaba",
+	BotRangeLinode:            "Linode",
+	BotRangeOracle:            "Oracle",
+	BotRangeOVH:               "OVH",
+	BotRangeVultr:             "Vultr",
+	BotRangeScaleway:          "Scaleway",
+	BotRangeTencentCloud:      "TencentCloud",
+	BotRangeHuaweiCloud:       "HuaweiCloud",
+	BotRangeContabo:           "Contabo",
+	BotRangeIBMCloud:          "IBMCloud",
+	BotRangeUpCloud:           "UpCloud",
+	BotRangeKamatera:          "Kamatera",
+	BotRangeCloudflareWorkers: "CloudflareWorkers",
+	BotRangeFlyIO:             "FlyIO",
+}
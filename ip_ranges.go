@@ -3,1101 +3,553 @@
 package isbot
 
 var ranges4 = `
-	1.178.1.0/24,AWS 1.178.4.0/22,AWS 1.178.8.0/22,AWS 1.178.16.0/20,AWS 1.178.64.0/23,AWS 1.178.72.0/21,AWS
-	1.178.88.0/21,AWS 1.178.100.0/22,AWS 1.178.172.0/23,AWS 1.178.174.0/24,AWS 1.179.2.0/23,AWS 1.179.14.0/23,AWS
-	1.179.52.0/22,AWS 1.179.56.0/22,AWS 1.179.60.0/23,AWS 1.179.101.0/24,AWS 1.179.102.0/23,AWS 2.26.132.0/24,OVH
-	2.26.153.0/24,OVH 2.26.166.0/24,OVH 2.27.97.0/24,OVH 2.27.164.0/24,OVH 2.28.0.0/15,Hetzner 2.58.172.0/24,OVH
-	3.0.0.0/15,AWS 3.2.0.0/22,AWS 3.2.4.0/23,AWS 3.2.8.0/21,AWS 3.2.48.0/21,AWS 3.2.56.0/22,AWS
-	3.2.60.0/23,AWS 3.2.64.0/19,AWS 3.2.96.0/22,AWS 3.2.100.0/23,AWS 3.2.102.0/24,AWS 3.3.0.0/23,AWS
-	3.3.2.0/24,AWS 3.3.5.0/24,AWS 3.3.6.0/23,AWS 3.3.8.0/21,AWS 3.3.16.0/20,AWS 3.3.32.0/23,AWS
-	3.4.0.0/22,AWS 3.4.4.0/24,AWS 3.4.6.0/24,AWS 3.4.8.0/23,AWS 3.4.10.0/24,AWS 3.4.12.1/32,AWS
-	3.4.12.2/31,AWS 3.4.12.4/31,AWS 3.4.12.6/32,AWS 3.4.12.11/32,AWS 3.4.12.12/32,AWS 3.4.12.15/32,AWS
-	3.4.12.16/28,AWS 3.4.12.32/29,AWS 3.4.12.40/30,AWS 3.4.12.44/31,AWS 3.4.12.46/32,AWS 3.4.12.49/32,AWS
-	3.4.12.50/31,AWS 3.4.12.52/30,AWS 3.4.12.56/29,AWS 3.4.12.64/28,AWS 3.4.12.80/30,AWS 3.4.12.84/32,AWS
-	3.4.13.0/26,AWS 3.4.13.64/27,AWS 3.4.14.0/27,AWS 3.4.14.32/29,AWS 3.4.15.0/25,AWS 3.4.15.128/28,AWS
-	3.4.15.152/29,AWS 3.4.15.160/27,AWS 3.4.15.192/26,AWS 3.4.16.0/20,AWS 3.4.32.0/20,AWS 3.5.0.0/18,AWS
-	3.5.64.0/19,AWS 3.5.96.0/20,AWS 3.5.120.0/22,AWS 3.5.126.0/23,AWS 3.5.128.0/19,AWS 3.5.160.0/21,AWS
-	3.5.168.0/23,AWS 3.5.172.0/22,AWS 3.5.180.0/22,AWS 3.5.184.0/21,AWS 3.5.192.0/21,AWS 3.5.202.0/23,AWS
-	3.5.204.0/22,AWS 3.5.208.0/20,AWS 3.5.224.0/19,AWS 3.6.0.0/15,AWS 3.8.0.0/13,AWS 3.16.0.0/12,AWS
-	3.32.0.0/16,AWS 3.33.44.0/22,AWS 3.33.128.0/17,AWS 3.34.0.0/15,AWS 3.36.0.0/14,AWS 3.40.0.0/14,AWS
-	3.44.0.0/16,AWS 3.45.0.0/17,AWS 3.64.0.0/11,AWS 3.96.0.0/14,AWS 3.101.0.0/16,AWS 3.102.0.0/15,AWS
-	3.104.0.0/13,AWS 3.112.0.0/14,AWS 3.120.0.0/13,AWS 3.128.0.0/12,AWS 3.144.0.0/13,AWS 3.160.0.0/13,AWS
-	3.168.0.0/14,AWS 3.172.0.0/17,AWS 3.173.0.0/16,AWS 3.174.0.0/15,AWS 3.208.0.0/12,AWS 3.224.0.0/12,AWS
-	3.248.0.0/13,AWS 4.144.0.0/12,Azure 4.160.0.0/12,Azure 4.176.0.0/13,Azure 4.184.0.0/20,Azure 4.184.56.0/21,Azure
-	4.184.64.0/18,Azure 4.184.128.0/17,Azure 4.185.0.0/16,Azure 4.186.0.0/15,Azure 4.188.0.0/14,Azure 4.192.0.0/13,Azure
-	4.200.0.0/15,Azure 4.202.0.0/16,Azure 4.203.0.0/17,Azure 4.203.128.0/19,Azure 4.204.0.0/14,Azure 4.208.0.0/12,Azure
-	4.224.0.0/13,Azure 4.232.0.0/17,Azure 4.232.128.0/18,Azure 4.232.192.0/21,Azure 4.232.208.0/20,Azure 4.232.224.0/19,Azure
-	4.233.0.0/16,Azure 4.234.0.0/15,Azure 4.236.0.0/14,Azure 4.240.0.0/12,Azure 5.9.0.0/16,Hetzner 5.39.0.0/17,OVH
-	5.60.0.0/20,AWS 5.60.16.0/24,AWS 5.60.32.0/19,AWS 5.60.64.0/18,AWS 5.60.128.0/17,AWS 5.75.128.0/17,Hetzner
-	5.78.0.0/16,Hetzner 5.101.96.0/21,DigitalOcean 5.101.104.0/22,DigitalOcean 5.135.0.0/16,OVH 5.161.0.0/16,Hetzner 5.172.36.0/24,OVH
-	5.174.0.0/16,AWS 5.175.162.0/24,OVH 5.175.168.0/24,OVH 5.175.195.0/24,OVH 5.175.211.0/24,OVH 5.178.106.0/24,OVH
-	5.178.110.0/24,OVH 5.179.96.0/20,AWS 5.181.224.0/23,Alibaba 5.182.19.0/24,OVH 5.196.0.0/16,OVH 5.231.207.0/24,OVH
-	8.7.244.0/24,OVH 8.18.128.0/24,OVH 8.18.172.0/24,OVH 8.20.110.0/24,OVH 8.21.41.0/24,OVH 8.24.8.0/21,OVH
-	8.26.94.0/24,OVH 8.29.224.0/24,OVH 8.30.208.0/21,OVH 8.33.96.0/21,OVH 8.33.128.0/21,OVH 8.33.136.0/23,OVH
-	8.34.208.0/20,GoogleCloud 8.35.192.0/21,GoogleCloud 8.128.4.0/22,Alibaba 8.128.32.0/19,Alibaba 8.128.64.0/19,Alibaba 8.128.96.0/20,Alibaba
-	8.129.0.0/16,Alibaba 8.130.0.0/15,Alibaba 8.132.0.0/14,Alibaba 8.136.0.0/13,Alibaba 8.144.0.0/14,Alibaba 8.148.0.0/19,Alibaba
-	8.148.36.0/22,Alibaba 8.148.41.0/24,Alibaba 8.148.43.0/24,Alibaba 8.148.64.0/18,Alibaba 8.148.128.0/17,Alibaba 8.149.0.0/16,Alibaba
-	8.150.0.0/20,Alibaba 8.150.16.0/21,Alibaba 8.150.64.0/23,Alibaba 8.152.0.0/13,Alibaba 8.160.0.0/15,Alibaba 8.162.0.0/17,Alibaba
-	8.163.0.0/16,Alibaba 8.164.0.0/14,Alibaba 8.168.0.0/15,Alibaba 8.176.0.0/17,Alibaba 8.176.128.0/19,Alibaba 8.208.0.0/16,Alibaba
-	8.209.0.0/19,Alibaba 8.209.36.0/22,Alibaba 8.209.40.0/21,Alibaba 8.209.48.0/20,Alibaba 8.209.64.0/18,Alibaba 8.209.128.0/17,Alibaba
-	8.210.0.0/15,Alibaba 8.212.0.0/14,Alibaba 8.216.0.0/13,Alibaba 8.228.0.0/15,GoogleCloud 8.230.0.0/17,GoogleCloud 8.231.32.0/19,GoogleCloud
-	8.231.64.0/18,GoogleCloud 8.231.128.0/17,GoogleCloud 8.232.0.0/15,GoogleCloud 8.234.2.0/23,GoogleCloud 8.234.4.0/22,GoogleCloud 8.234.8.0/21,GoogleCloud
-	8.234.16.0/23,GoogleCloud 8.234.20.0/22,GoogleCloud 8.234.24.0/21,GoogleCloud 8.234.32.0/19,GoogleCloud 8.234.64.0/18,GoogleCloud 8.234.128.0/17,GoogleCloud
-	8.235.0.0/16,GoogleCloud 9.129.0.0/18,Azure 9.129.64.0/19,Azure 9.129.96.0/20,Azure 9.129.112.0/21,Azure 9.129.120.0/25,Azure
-	9.129.120.128/27,Azure 9.141.0.0/17,Azure 9.141.128.0/18,Azure 9.160.0.0/17,Azure 9.160.128.0/18,Azure 9.160.192.0/19,Azure
-	9.163.0.0/16,Azure 9.169.0.0/16,Azure 9.205.0.0/16,Azure 9.223.0.0/16,Azure 9.234.0.0/15,Azure 13.32.0.0/15,AWS
-	13.35.0.0/16,AWS 13.36.0.0/14,AWS 13.40.0.0/14,AWS 13.48.0.0/12,AWS 13.64.0.0/13,Azure 13.72.64.0/18,Azure
-	13.72.192.0/18,Azure 13.73.0.0/18,Azure 13.73.96.0/19,Azure 13.73.128.0/18,Azure 13.73.192.0/20,Azure 13.73.224.0/19,Azure
-	13.74.0.0/15,Azure 13.76.0.0/16,Azure 13.77.0.0/17,Azure 13.77.128.0/18,Azure 13.77.192.0/19,Azure 13.78.0.0/15,Azure
-	13.80.0.0/14,Azure 13.84.0.0/15,Azure 13.86.0.0/16,Azure 13.87.32.0/23,Azure 13.87.64.0/19,Azure 13.87.96.0/20,Azure
-	13.87.128.0/17,Azure 13.88.0.0/17,Azure 13.88.128.0/18,Azure 13.88.200.0/21,Azure 13.88.208.0/20,Azure 13.88.224.0/19,Azure
-	13.89.0.0/16,Azure 13.90.0.0/15,Azure 13.92.0.0/14,Azure 13.104.128.0/23,Azure 13.104.144.0/20,Azure 13.104.163.0/24,Azure
-	13.104.169.0/24,Azure 13.104.173.0/25,Azure 13.104.173.128/27,Azure 13.104.192.0/21,Azure 13.104.207.0/24,Azure 13.104.208.0/20,Azure
-	13.104.248.32/27,Azure 13.104.248.64/26,Azure 13.104.252.208/28,Azure 13.104.252.224/28,Azure 13.104.253.48/28,Azure 13.104.254.128/28,Azure
-	13.105.14.0/24,Azure 13.105.16.0/20,Azure 13.105.36.0/23,Azure 13.105.46.0/23,Azure 13.105.52.0/23,Azure 13.105.60.0/23,Azure
-	13.105.66.0/23,Azure 13.105.74.0/23,Azure 13.105.96.0/20,Azure 13.105.114.88/29,Azure 13.105.114.96/27,Azure 13.105.114.128/26,Azure
-	13.105.114.192/28,Azure 13.105.114.208/29,Azure 13.105.116.0/25,Azure 13.105.221.0/24,Azure 13.105.233.0/24,Azure 13.106.2.0/23,Azure
-	13.106.38.142/32,Azure 13.106.38.148/32,Azure 13.106.54.3/32,Azure 13.106.54.19/32,Azure 13.106.57.181/32,Azure 13.106.57.196/31,Azure
-	13.107.3.0/24,Azure 13.107.4.0/22,Azure 13.107.9.0/24,Azure 13.107.12.0/23,Azure 13.107.15.0/24,Azure 13.107.16.0/24,Azure
-	13.107.18.0/23,Azure 13.107.21.0/24,Azure 13.107.22.0/24,Azure 13.107.37.0/24,Azure 13.107.38.0/23,Azure 13.107.40.0/24,Azure
-	13.107.42.0/23,Azure 13.107.48.0/24,Azure 13.107.50.0/24,Azure 13.107.52.0/24,Azure 13.107.54.0/24,Azure 13.107.56.0/24,Azure
-	13.107.64.0/18,Azure 13.107.128.0/19,Azure 13.107.197.16/28,Azure 13.107.208.0/24,Azure 13.107.213.0/24,Azure 13.107.219.0/24,Azure
-	13.107.224.0/24,Azure 13.107.226.0/23,Azure 13.107.228.0/23,Azure 13.107.231.0/24,Azure 13.107.234.0/23,Azure 13.107.237.0/24,Azure
-	13.107.238.0/23,Azure 13.107.245.0/24,Azure 13.107.246.0/24,Azure 13.107.253.0/24,Azure 13.107.254.0/23,Azure 13.112.0.0/14,AWS
-	13.124.0.0/14,AWS 13.128.0.0/16,AWS 13.130.0.0/16,AWS 13.134.0.0/15,AWS 13.144.0.0/16,AWS 13.146.0.0/16,AWS
-	13.150.0.0/15,AWS 13.152.0.0/16,AWS 13.154.0.0/16,AWS 13.158.0.0/15,AWS 13.160.0.0/16,AWS 13.162.0.0/16,AWS
-	13.166.0.0/15,AWS 13.184.0.0/13,AWS 13.192.0.0/11,AWS 13.224.0.0/12,AWS 13.244.0.0/14,AWS 13.248.0.0/18,AWS
-	13.248.64.0/21,AWS 13.248.72.0/23,AWS 13.248.75.0/24,AWS 13.248.76.0/22,AWS 13.248.80.0/23,AWS 13.248.82.0/24,AWS
-	13.248.96.0/19,AWS 13.248.128.0/17,AWS 13.249.0.0/16,AWS 13.250.0.0/15,AWS 14.1.112.0/22,Alibaba 15.103.0.0/16,AWS
-	15.128.0.0/16,AWS 15.129.0.0/18,AWS 15.129.64.0/20,AWS 15.129.80.0/22,AWS 15.129.84.0/23,AWS 15.129.96.0/22,AWS
-	15.134.0.0/15,AWS 15.143.0.0/16,AWS 15.145.0.0/22,AWS 15.145.4.0/23,AWS 15.145.8.0/21,AWS 15.145.16.0/21,AWS
-	15.145.24.0/23,AWS 15.148.0.0/16,AWS 15.152.0.0/16,AWS 15.156.0.0/14,AWS 15.160.0.0/15,AWS 15.164.0.0/15,AWS
-	15.168.0.0/16,AWS 15.175.0.0/16,AWS 15.177.0.0/18,AWS 15.177.64.0/19,AWS 15.177.96.0/22,AWS 15.177.100.0/24,AWS
-	15.177.102.0/23,AWS 15.177.104.0/22,AWS 15.177.108.0/24,AWS 15.178.0.0/16,AWS 15.181.0.0/17,AWS 15.181.128.0/18,AWS
-	15.181.192.0/19,AWS 15.181.224.0/20,AWS 15.181.240.0/21,AWS 15.181.248.0/22,AWS 15.181.252.0/23,AWS 15.181.254.0/24,AWS
-	15.184.0.0/15,AWS 15.188.0.0/16,AWS 15.190.0.0/21,AWS 15.190.8.0/22,AWS 15.190.16.0/20,AWS 15.190.32.0/19,AWS
-	15.190.80.0/20,AWS 15.190.96.0/19,AWS 15.190.128.0/18,AWS 15.190.224.0/22,AWS 15.190.232.0/21,AWS 15.190.240.0/20,AWS
-	15.193.0.0/18,AWS 15.193.128.0/19,AWS 15.193.160.0/20,AWS 15.193.176.0/22,AWS 15.197.0.0/19,AWS 15.197.32.0/21,AWS
-	15.197.64.0/19,AWS 15.197.128.0/17,AWS 15.200.0.0/16,AWS 15.201.1.0/24,AWS 15.204.0.0/16,OVH 15.205.0.0/16,AWS
-	15.206.0.0/15,AWS 15.216.0.0/15,AWS 15.220.0.0/19,AWS 15.220.32.0/21,AWS 15.220.40.0/22,AWS 15.220.48.0/20,AWS
-	15.220.64.0/19,AWS 15.220.112.0/20,AWS 15.220.128.0/18,AWS 15.220.192.0/20,AWS 15.220.208.128/26,AWS 15.220.216.0/21,AWS
-	15.220.224.0/19,AWS 15.221.0.0/19,AWS 15.221.32.0/20,AWS 15.221.48.0/22,AWS 15.221.52.0/23,AWS 15.221.128.0/21,AWS
-	15.221.144.0/21,AWS 15.221.152.0/23,AWS 15.221.160.0/21,AWS 15.221.176.0/20,AWS 15.222.0.0/15,AWS 15.224.0.0/15,AWS
-	15.227.0.0/16,AWS 15.228.0.0/15,AWS 15.230.0.4/30,AWS 15.230.0.8/31,AWS 15.230.0.12/31,AWS 15.230.0.14/32,AWS
-	15.230.1.0/24,AWS 15.230.3.0/24,AWS 15.230.4.17/32,AWS 15.230.4.19/32,AWS 15.230.4.128/30,AWS 15.230.4.148/30,AWS
-	15.230.4.152/29,AWS 15.230.4.160/29,AWS 15.230.4.176/28,AWS 15.230.5.0/24,AWS 15.230.6.0/24,AWS 15.230.9.10/31,AWS
-	15.230.9.12/30,AWS 15.230.9.44/30,AWS 15.230.9.248/32,AWS 15.230.9.252/31,AWS 15.230.10.0/24,AWS 15.230.14.0/24,AWS
-	15.230.15.0/32,AWS 15.230.15.3/32,AWS 15.230.15.4/30,AWS 15.230.15.8/30,AWS 15.230.15.13/32,AWS 15.230.15.14/31,AWS
-	15.230.15.16/32,AWS 15.230.15.24/29,AWS 15.230.15.32/27,AWS 15.230.15.64/26,AWS 15.230.15.128/26,AWS 15.230.15.192/30,AWS
-	15.230.15.200/29,AWS 15.230.15.208/29,AWS 15.230.15.216/30,AWS 15.230.15.254/31,AWS 15.230.16.0/24,AWS 15.230.18.0/23,AWS
-	15.230.21.0/24,AWS 15.230.22.0/23,AWS 15.230.24.0/21,AWS 15.230.32.0/24,AWS 15.230.35.0/24,AWS 15.230.36.0/22,AWS
-	15.230.40.0/22,AWS 15.230.48.0/21,AWS 15.230.56.0/22,AWS 15.230.60.0/23,AWS 15.230.62.0/24,AWS 15.230.63.0/30,AWS
-	15.230.63.4/31,AWS 15.230.63.6/32,AWS 15.230.63.8/30,AWS 15.230.64.0/21,AWS 15.230.72.0/22,AWS 15.230.76.0/23,AWS
-	15.230.78.0/24,AWS 15.230.79.0/25,AWS 15.230.79.128/26,AWS 15.230.80.0/20,AWS 15.230.96.0/22,AWS 15.230.100.0/31,AWS
-	15.230.100.2/32,AWS 15.230.101.0/24,AWS 15.230.102.0/23,AWS 15.230.104.0/23,AWS 15.230.106.0/24,AWS 15.230.107.0/32,AWS
-	15.230.107.2/31,AWS 15.230.108.0/22,AWS 15.230.112.0/22,AWS 15.230.116.0/24,AWS 15.230.117.0/31,AWS 15.230.118.0/24,AWS
-	15.230.119.0/31,AWS 15.230.120.0/31,AWS 15.230.121.0/29,AWS 15.230.121.8/31,AWS 15.230.122.0/29,AWS 15.230.123.0/24,AWS
-	15.230.124.0/24,AWS 15.230.129.0/24,AWS 15.230.130.0/23,AWS 15.230.132.0/22,AWS 15.230.136.0/23,AWS 15.230.138.0/24,AWS
-	15.230.140.0/22,AWS 15.230.144.0/23,AWS 15.230.147.0/24,AWS 15.230.148.0/24,AWS 15.230.149.0/30,AWS 15.230.149.4/31,AWS
-	15.230.149.8/30,AWS 15.230.150.0/23,AWS 15.230.152.0/21,AWS 15.230.160.0/21,AWS 15.230.168.0/24,AWS 15.230.169.0/29,AWS
-	15.230.170.0/23,AWS 15.230.173.0/24,AWS 15.230.174.0/24,AWS 15.230.176.0/23,AWS 15.230.178.0/24,AWS 15.230.179.0/28,AWS
-	15.230.179.16/29,AWS 15.230.180.0/22,AWS 15.230.184.0/22,AWS 15.230.188.0/23,AWS 15.230.190.0/24,AWS 15.230.192.0/22,AWS
-	15.230.196.0/23,AWS 15.230.198.0/24,AWS 15.230.199.0/28,AWS 15.230.200.0/23,AWS 15.230.202.0/30,AWS 15.230.203.0/24,AWS
-	15.230.204.0/25,AWS 15.230.205.0/24,AWS 15.230.206.0/23,AWS 15.230.208.0/21,AWS 15.230.216.0/29,AWS 15.230.216.8/30,AWS
-	15.230.216.12/31,AWS 15.230.217.0/24,AWS 15.230.218.0/23,AWS 15.230.220.0/23,AWS 15.230.222.0/24,AWS 15.230.223.0/28,AWS
-	15.230.240.0/21,AWS 15.230.248.0/23,AWS 15.230.250.0/24,AWS 15.230.251.0/29,AWS 15.230.252.0/23,AWS 15.230.254.0/30,AWS
-	15.230.254.4/32,AWS 15.230.255.0/24,AWS 15.232.0.0/15,AWS 15.235.0.0/16,OVH 15.236.0.0/15,AWS 15.240.0.0/15,AWS
-	15.248.8.0/22,AWS 15.248.16.0/20,AWS 15.248.32.0/21,AWS 15.248.40.0/22,AWS 15.248.48.0/20,AWS 15.248.64.0/21,AWS
-	15.248.80.0/20,AWS 15.248.104.0/24,AWS 15.248.106.0/23,AWS 15.248.112.0/20,AWS 15.248.128.0/19,AWS 15.248.160.0/23,AWS
-	15.248.162.0/24,AWS 15.248.164.0/23,AWS 15.248.167.0/28,AWS 15.248.167.16/29,AWS 15.248.168.0/21,AWS 15.248.176.0/20,AWS
-	15.249.0.0/16,AWS 15.251.0.0/28,AWS 15.251.0.20/30,AWS 15.251.0.24/30,AWS 15.251.0.28/31,AWS 15.251.0.33/32,AWS
-	15.251.0.34/32,AWS 15.251.0.47/32,AWS 15.251.0.48/32,AWS 15.252.0.0/15,AWS 15.254.0.0/16,AWS 16.4.0.0/16,AWS
-	16.5.198.0/24,OVH 16.6.0.0/16,AWS 16.11.0.0/16,AWS 16.12.0.0/20,AWS 16.12.16.0/22,AWS 16.12.20.0/23,AWS
-	16.12.24.0/21,AWS 16.12.32.0/21,AWS 16.12.40.0/22,AWS 16.12.44.0/24,AWS 16.12.48.0/20,AWS 16.12.64.0/22,AWS
-	16.12.71.0/24,AWS 16.12.72.0/21,AWS 16.12.80.0/22,AWS 16.12.84.0/23,AWS 16.12.86.0/24,AWS 16.12.88.0/21,AWS
-	16.12.96.0/20,AWS 16.12.112.0/21,AWS 16.12.120.0/22,AWS 16.15.0.0/20,AWS 16.15.16.0/21,AWS 16.15.24.0/22,AWS
-	16.15.32.0/20,AWS 16.15.48.0/21,AWS 16.15.56.0/22,AWS 16.15.156.0/22,AWS 16.15.160.0/19,AWS 16.15.192.0/18,AWS
-	16.16.0.0/16,AWS 16.18.0.0/15,AWS 16.22.0.0/15,AWS 16.24.0.0/13,AWS 16.48.0.0/13,AWS 16.56.0.0/16,AWS
-	16.57.0.0/18,AWS 16.58.0.0/15,AWS 16.60.0.0/14,AWS 16.64.0.0/17,AWS 16.65.0.0/16,AWS 16.66.0.0/15,AWS
-	16.71.0.0/17,AWS 16.76.0.0/14,AWS 16.106.0.0/15,AWS 16.112.0.0/15,AWS 16.140.0.0/15,AWS 16.144.0.0/12,AWS
-	16.162.0.0/15,AWS 16.164.0.0/15,AWS 16.168.0.0/14,AWS 16.174.0.0/15,AWS 16.176.0.0/14,AWS 16.180.0.0/15,AWS
-	16.182.0.0/16,AWS 16.184.0.0/14,AWS 16.188.0.0/15,AWS 16.192.0.0/13,AWS 16.206.0.0/15,AWS 16.208.0.0/15,AWS
-	16.214.0.0/21,AWS 16.214.8.0/22,AWS 16.214.16.0/20,AWS 16.214.32.0/20,AWS 16.214.48.0/21,AWS 16.214.56.0/22,AWS
+	1.178.1.0/24,AWS 1.178.4.0-1.178.11.255,AWS 1.178.16.0/20,AWS 1.178.64.0/23,AWS 1.178.72.0/21,AWS 1.178.88.0/21,AWS
+	1.178.100.0/22,AWS 1.178.172.0-1.178.174.255,AWS 1.179.2.0/23,AWS 1.179.14.0/23,AWS 1.179.52.0-1.179.61.255,AWS 1.179.101.0-1.179.103.255,AWS
+	2.26.132.0/24,OVH 2.26.153.0/24,OVH 2.26.166.0/24,OVH 2.27.97.0/24,OVH 2.27.164.0/24,OVH 2.28.0.0/15,Hetzner
+	2.58.172.0/24,OVH 3.0.0.0-3.2.5.255,AWS 3.2.8.0/21,AWS 3.2.48.0-3.2.61.255,AWS 3.2.64.0-3.2.102.255,AWS 3.3.0.0-3.3.2.255,AWS
+	3.3.5.0-3.3.33.255,AWS 3.4.0.0-3.4.4.255,AWS 3.4.6.0/24,AWS 3.4.8.0-3.4.10.255,AWS 3.4.12.1-3.4.12.6,AWS 3.4.12.11-3.4.12.12,AWS
+	3.4.12.15-3.4.12.46,AWS 3.4.12.49-3.4.12.84,AWS 3.4.13.0-3.4.13.95,AWS 3.4.14.0-3.4.14.39,AWS 3.4.15.0-3.4.15.143,AWS 3.4.15.152-3.4.47.255,AWS
+	3.5.0.0-3.5.111.255,AWS 3.5.120.0/22,AWS 3.5.126.0-3.5.169.255,AWS 3.5.172.0/22,AWS 3.5.180.0-3.5.199.255,AWS 3.5.202.0-3.32.255.255,AWS
+	3.33.44.0/22,AWS 3.33.128.0-3.45.127.255,AWS 3.64.0.0-3.99.255.255,AWS 3.101.0.0-3.115.255.255,AWS 3.120.0.0-3.151.255.255,AWS 3.160.0.0-3.172.127.255,AWS
+	3.173.0.0-3.175.255.255,AWS 3.208.0.0-3.239.255.255,AWS 3.248.0.0/13,AWS 4.144.0.0-4.184.15.255,Azure 4.184.56.0-4.203.159.255,Azure 4.204.0.0-4.232.199.255,Azure
+	4.232.208.0-4.255.255.255,Azure 5.9.0.0/16,Hetzner 5.39.0.0/17,OVH 5.60.0.0-5.60.16.255,AWS 5.60.32.0-5.60.255.255,AWS 5.75.128.0/17,Hetzner
+	5.78.0.0/16,Hetzner 5.101.96.0-5.101.107.255,DigitalOcean 5.135.0.0/16,OVH 5.161.0.0/16,Hetzner 5.172.36.0/24,OVH 5.174.0.0/16,AWS
+	5.175.162.0/24,OVH 5.175.168.0/24,OVH 5.175.195.0/24,OVH 5.175.211.0/24,OVH 5.178.106.0/24,OVH 5.178.110.0/24,OVH
+	5.179.96.0/20,AWS 5.181.224.0/23,Alibaba 5.182.19.0/24,OVH 5.196.0.0/16,OVH 5.231.207.0/24,OVH 8.7.244.0/24,OVH
+	8.18.128.0/24,OVH 8.18.172.0/24,OVH 8.20.110.0/24,OVH 8.21.41.0/24,OVH 8.24.8.0/21,OVH 8.26.94.0/24,OVH
+	8.29.224.0/24,OVH 8.30.208.0/21,OVH 8.33.96.0/21,OVH 8.33.128.0-8.33.137.255,OVH 8.34.208.0/20,GoogleCloud 8.35.192.0/21,GoogleCloud
+	8.128.4.0/22,Alibaba 8.128.32.0-8.128.111.255,Alibaba 8.129.0.0-8.148.31.255,Alibaba 8.148.36.0/22,Alibaba 8.148.41.0/24,Alibaba 8.148.43.0/24,Alibaba
+	8.148.64.0-8.150.23.255,Alibaba 8.150.64.0/23,Alibaba 8.152.0.0-8.162.127.255,Alibaba 8.163.0.0-8.169.255.255,Alibaba 8.176.0.0-8.176.159.255,Alibaba 8.208.0.0-8.209.31.255,Alibaba
+	8.209.36.0-8.223.255.255,Alibaba 8.228.0.0-8.230.127.255,GoogleCloud 8.231.32.0-8.233.255.255,GoogleCloud 8.234.2.0-8.234.17.255,GoogleCloud 8.234.20.0-8.235.255.255,GoogleCloud 9.129.0.0-9.129.120.159,Azure
+	9.141.0.0-9.141.191.255,Azure 9.160.0.0-9.160.223.255,Azure 9.163.0.0/16,Azure 9.169.0.0/16,Azure 9.205.0.0/16,Azure 9.223.0.0/16,Azure
+	9.234.0.0/15,Azure 13.32.0.0/15,AWS 13.35.0.0-13.43.255.255,AWS 13.48.0.0/12,AWS 13.64.0.0/13,Azure 13.72.64.0/18,Azure
+	13.72.192.0-13.73.63.255,Azure 13.73.96.0-13.73.207.255,Azure 13.73.224.0-13.77.223.255,Azure 13.78.0.0-13.86.255.255,Azure 13.87.32.0/23,Azure 13.87.64.0-13.87.111.255,Azure
+	13.87.128.0-13.88.191.255,Azure 13.88.200.0-13.95.255.255,Azure 13.104.128.0/23,Azure 13.104.144.0/20,Azure 13.104.163.0/24,Azure 13.104.169.0/24,Azure
+	13.104.173.0-13.104.173.159,Azure 13.104.192.0/21,Azure 13.104.207.0-13.104.223.255,Azure 13.104.248.32-13.104.248.127,Azure 13.104.252.208-13.104.252.239,Azure 13.104.253.48/28,Azure
+	13.104.254.128/28,Azure 13.105.14.0/24,Azure 13.105.16.0/20,Azure 13.105.36.0/23,Azure 13.105.46.0/23,Azure 13.105.52.0/23,Azure
+	13.105.60.0/23,Azure 13.105.66.0/23,Azure 13.105.74.0/23,Azure 13.105.96.0/20,Azure 13.105.114.88-13.105.114.215,Azure 13.105.116.0/25,Azure
+	13.105.221.0/24,Azure 13.105.233.0/24,Azure 13.106.2.0/23,Azure 13.106.38.142/32,Azure 13.106.38.148/32,Azure 13.106.54.3/32,Azure
+	13.106.54.19/32,Azure 13.106.57.181/32,Azure 13.106.57.196/31,Azure 13.107.3.0-13.107.7.255,Azure 13.107.9.0/24,Azure 13.107.12.0/23,Azure
+	13.107.15.0-13.107.16.255,Azure 13.107.18.0/23,Azure 13.107.21.0-13.107.22.255,Azure 13.107.37.0-13.107.40.255,Azure 13.107.42.0/23,Azure 13.107.48.0/24,Azure
+	13.107.50.0/24,Azure 13.107.52.0/24,Azure 13.107.54.0/24,Azure 13.107.56.0/24,Azure 13.107.64.0-13.107.159.255,Azure 13.107.197.16/28,Azure
+	13.107.208.0/24,Azure 13.107.213.0/24,Azure 13.107.219.0/24,Azure 13.107.224.0/24,Azure 13.107.226.0-13.107.229.255,Azure 13.107.231.0/24,Azure
+	13.107.234.0/23,Azure 13.107.237.0-13.107.239.255,Azure 13.107.245.0-13.107.246.255,Azure 13.107.253.0-13.107.255.255,Azure 13.112.0.0/14,AWS 13.124.0.0-13.128.255.255,AWS
+	13.130.0.0/16,AWS 13.134.0.0/15,AWS 13.144.0.0/16,AWS 13.146.0.0/16,AWS 13.150.0.0-13.152.255.255,AWS 13.154.0.0/16,AWS
+	13.158.0.0-13.160.255.255,AWS 13.162.0.0/16,AWS 13.166.0.0/15,AWS 13.184.0.0-13.239.255.255,AWS 13.244.0.0-13.248.73.255,AWS 13.248.75.0-13.248.82.255,AWS
+	13.248.96.0-13.251.255.255,AWS 14.1.112.0/22,Alibaba 15.103.0.0/16,AWS 15.128.0.0-15.129.85.255,AWS 15.129.96.0/22,AWS 15.134.0.0/15,AWS
+	15.143.0.0/16,AWS 15.145.0.0-15.145.5.255,AWS 15.145.8.0-15.145.25.255,AWS 15.148.0.0/16,AWS 15.152.0.0/16,AWS 15.156.0.0-15.161.255.255,AWS
+	15.164.0.0/15,AWS 15.168.0.0/16,AWS 15.175.0.0/16,AWS 15.177.0.0-15.177.100.255,AWS 15.177.102.0-15.177.108.255,AWS 15.178.0.0/16,AWS
+	15.181.0.0-15.181.254.255,AWS 15.184.0.0/15,AWS 15.188.0.0/16,AWS 15.190.0.0-15.190.11.255,AWS 15.190.16.0-15.190.63.255,AWS 15.190.80.0-15.190.191.255,AWS
+	15.190.224.0/22,AWS 15.190.232.0-15.190.255.255,AWS 15.193.0.0/18,AWS 15.193.128.0-15.193.179.255,AWS 15.197.0.0-15.197.39.255,AWS 15.197.64.0/19,AWS
+	15.197.128.0/17,AWS 15.200.0.0/16,AWS 15.201.1.0/24,AWS 15.204.0.0/16,OVH 15.205.0.0-15.207.255.255,AWS 15.216.0.0/15,AWS
+	15.220.0.0-15.220.43.255,AWS 15.220.48.0-15.220.95.255,AWS 15.220.112.0-15.220.207.255,AWS 15.220.208.128/26,AWS 15.220.216.0-15.221.53.255,AWS 15.221.128.0/21,AWS
+	15.221.144.0-15.221.153.255,AWS 15.221.160.0/21,AWS 15.221.176.0/20,AWS 15.222.0.0-15.225.255.255,AWS 15.227.0.0-15.229.255.255,AWS 15.230.0.4-15.230.0.9,AWS
+	15.230.0.12-15.230.0.14,AWS 15.230.1.0/24,AWS 15.230.3.0/24,AWS 15.230.4.17/32,AWS 15.230.4.19/32,AWS 15.230.4.128/30,AWS
+	15.230.4.148-15.230.4.167,AWS 15.230.4.176/28,AWS 15.230.5.0-15.230.6.255,AWS 15.230.9.10-15.230.9.15,AWS 15.230.9.44/30,AWS 15.230.9.248/32,AWS
+	15.230.9.252/31,AWS 15.230.10.0/24,AWS 15.230.14.0-15.230.15.0,AWS 15.230.15.3-15.230.15.11,AWS 15.230.15.13-15.230.15.16,AWS 15.230.15.24-15.230.15.195,AWS
+	15.230.15.200-15.230.15.219,AWS 15.230.15.254-15.230.16.255,AWS 15.230.18.0/23,AWS 15.230.21.0-15.230.32.255,AWS 15.230.35.0-15.230.43.255,AWS 15.230.48.0-15.230.63.6,AWS
+	15.230.63.8/30,AWS 15.230.64.0-15.230.79.191,AWS 15.230.80.0-15.230.100.2,AWS 15.230.101.0-15.230.107.0,AWS 15.230.107.2/31,AWS 15.230.108.0-15.230.117.1,AWS
+	15.230.118.0-15.230.119.1,AWS 15.230.120.0/31,AWS 15.230.121.0-15.230.121.9,AWS 15.230.122.0/29,AWS 15.230.123.0-15.230.124.255,AWS 15.230.129.0-15.230.138.255,AWS
+	15.230.140.0-15.230.145.255,AWS 15.230.147.0-15.230.149.5,AWS 15.230.149.8/30,AWS 15.230.150.0-15.230.169.7,AWS 15.230.170.0/23,AWS 15.230.173.0-15.230.174.255,AWS
+	15.230.176.0-15.230.179.23,AWS 15.230.180.0-15.230.190.255,AWS 15.230.192.0-15.230.199.15,AWS 15.230.200.0-15.230.202.3,AWS 15.230.203.0-15.230.204.127,AWS 15.230.205.0-15.230.216.13,AWS
+	15.230.217.0-15.230.223.15,AWS 15.230.240.0-15.230.251.7,AWS 15.230.252.0-15.230.254.4,AWS 15.230.255.0/24,AWS 15.232.0.0/15,AWS 15.235.0.0/16,OVH
+	15.236.0.0/15,AWS 15.240.0.0/15,AWS 15.248.8.0/22,AWS 15.248.16.0-15.248.43.255,AWS 15.248.48.0-15.248.71.255,AWS 15.248.80.0/20,AWS
+	15.248.104.0/24,AWS 15.248.106.0/23,AWS 15.248.112.0-15.248.162.255,AWS 15.248.164.0/23,AWS 15.248.167.0-15.248.167.23,AWS 15.248.168.0-15.248.191.255,AWS
+	15.249.0.0/16,AWS 15.251.0.0/28,AWS 15.251.0.20-15.251.0.29,AWS 15.251.0.33-15.251.0.34,AWS 15.251.0.47-15.251.0.48,AWS 15.252.0.0-15.254.255.255,AWS
+	16.4.0.0/16,AWS 16.5.198.0/24,OVH 16.6.0.0/16,AWS 16.11.0.0-16.12.21.255,AWS 16.12.24.0-16.12.44.255,AWS 16.12.48.0-16.12.67.255,AWS
+	16.12.71.0-16.12.86.255,AWS 16.12.88.0-16.12.123.255,AWS 16.15.0.0-16.15.27.255,AWS 16.15.32.0-16.15.59.255,AWS 16.15.156.0-16.16.255.255,AWS 16.18.0.0/15,AWS
+	16.22.0.0-16.31.255.255,AWS 16.48.0.0-16.57.63.255,AWS 16.58.0.0-16.64.127.255,AWS 16.65.0.0-16.67.255.255,AWS 16.71.0.0/17,AWS 16.76.0.0/14,AWS
+	16.106.0.0/15,AWS 16.112.0.0/15,AWS 16.140.0.0/15,AWS 16.144.0.0/12,AWS 16.162.0.0-16.165.255.255,AWS 16.168.0.0/14,AWS
+	16.174.0.0-16.182.255.255,AWS 16.184.0.0-16.189.255.255,AWS 16.192.0.0/13,AWS 16.206.0.0-16.209.255.255,AWS 16.214.0.0-16.214.11.255,AWS 16.214.16.0-16.214.59.255,AWS
 	16.216.27.0/24,OVH 16.217.96.0/24,OVH 18.34.0.0/19,AWS 18.34.48.0/20,AWS 18.34.72.0/21,AWS 18.34.232.0/21,AWS
-	18.34.244.0/22,AWS 18.34.252.0/22,AWS 18.60.0.0/15,AWS 18.64.0.0/14,AWS 18.68.0.0/16,AWS 18.88.0.0/17,AWS
-	18.88.128.0/18,AWS 18.89.0.0/18,AWS 18.89.128.0/18,AWS 18.96.0.0/14,AWS 18.100.0.0/15,AWS 18.102.0.0/16,AWS
-	18.116.0.0/14,AWS 18.130.0.0/16,AWS 18.132.0.0/14,AWS 18.136.0.0/16,AWS 18.138.0.0/15,AWS 18.140.0.0/14,AWS
-	18.144.0.0/15,AWS 18.153.0.0/16,AWS 18.154.0.0/15,AWS 18.156.0.0/14,AWS 18.160.0.0/13,AWS 18.168.0.0/14,AWS
-	18.172.0.0/15,AWS 18.175.0.0/16,AWS 18.176.0.0/13,AWS 18.184.0.0/15,AWS 18.188.0.0/14,AWS 18.192.0.0/11,AWS
-	18.224.0.0/12,AWS 18.244.0.0/15,AWS 18.246.0.0/16,AWS 18.252.0.0/15,AWS 18.254.0.0/16,AWS 20.0.0.0/12,Azure
-	20.16.0.0/14,Azure 20.20.32.0/19,Azure 20.20.64.0/23,Azure 20.20.66.0/25,Azure 20.20.66.128/26,Azure 20.20.66.192/27,Azure
-	20.20.66.224/28,Azure 20.20.66.240/30,Azure 20.20.66.244/31,Azure 20.20.68.0/26,Azure 20.20.68.64/29,Azure 20.20.68.72/31,Azure
-	20.20.72.0/25,Azure 20.20.72.128/26,Azure 20.20.72.192/27,Azure 20.20.72.224/30,Azure 20.20.76.0/25,Azure 20.20.76.128/27,Azure
-	20.20.76.160/28,Azure 20.20.76.176/30,Azure 20.20.76.184/29,Azure 20.20.76.192/26,Azure 20.20.77.0/24,Azure 20.20.78.0/23,Azure
-	20.20.80.0/29,Azure 20.20.80.8/30,Azure 20.20.80.12/31,Azure 20.20.80.16/29,Azure 20.20.80.24/30,Azure 20.20.80.30/31,Azure
-	20.20.80.32/30,Azure 20.20.80.36/31,Azure 20.20.80.40/29,Azure 20.20.80.48/28,Azure 20.20.80.64/26,Azure 20.20.80.128/26,Azure
-	20.20.80.192/28,Azure 20.20.80.208/30,Azure 20.20.80.212/31,Azure 20.20.80.220/30,Azure 20.20.80.224/27,Azure 20.20.81.0/26,Azure
-	20.20.81.64/29,Azure 20.20.81.72/30,Azure 20.20.84.0/24,Azure 20.20.85.0/25,Azure 20.20.85.128/26,Azure 20.20.85.192/27,Azure
-	20.20.88.0/24,Azure 20.20.89.0/26,Azure 20.20.89.64/29,Azure 20.20.89.72/30,Azure 20.20.89.76/31,Azure 20.20.92.0/27,Azure
-	20.20.92.32/28,Azure 20.20.92.48/31,Azure 20.20.92.56/29,Azure 20.20.92.64/27,Azure 20.20.92.96/28,Azure 20.20.92.112/30,Azure
-	20.20.92.122/31,Azure 20.20.92.124/30,Azure 20.20.92.128/25,Azure 20.20.93.0/26,Azure 20.20.93.64/27,Azure 20.20.93.96/28,Azure
-	20.20.93.112/29,Azure 20.20.93.120/30,Azure 20.20.93.124/31,Azure 20.20.93.132/30,Azure 20.20.93.136/29,Azure 20.20.93.144/29,Azure
-	20.20.93.156/30,Azure 20.20.93.160/27,Azure 20.20.93.192/29,Azure 20.20.93.200/30,Azure 20.20.93.204/31,Azure 20.20.93.212/30,Azure
-	20.20.93.216/29,Azure 20.20.93.224/27,Azure 20.20.94.0/24,Azure 20.20.95.0/25,Azure 20.20.95.128/26,Azure 20.20.95.192/27,Azure
-	20.20.95.224/28,Azure 20.20.95.240/30,Azure 20.20.96.0/24,Azure 20.20.97.0/25,Azure 20.20.97.128/26,Azure 20.20.97.192/27,Azure
-	20.20.97.224/28,Azure 20.20.97.240/29,Azure 20.20.97.248/30,Azure 20.20.100.0/23,Azure 20.20.102.0/27,Azure 20.20.102.32/28,Azure
-	20.20.102.48/29,Azure 20.20.102.56/30,Azure 20.20.102.68/30,Azure 20.20.102.72/29,Azure 20.20.102.80/28,Azure 20.20.102.96/27,Azure
-	20.20.102.128/25,Azure 20.20.103.0/24,Azure 20.20.104.0/25,Azure 20.20.104.128/26,Azure 20.20.104.192/27,Azure 20.20.104.224/28,Azure
-	20.20.105.0/24,Azure 20.20.106.0/25,Azure 20.20.108.0/24,Azure 20.20.109.0/25,Azure 20.20.109.128/27,Azure 20.20.109.160/29,Azure
-	20.20.109.168/30,Azure 20.20.109.172/31,Azure 20.20.109.182/31,Azure 20.20.109.184/29,Azure 20.20.109.192/26,Azure 20.20.110.0/25,Azure
-	20.20.110.128/28,Azure 20.20.110.144/29,Azure 20.20.110.152/30,Azure 20.20.110.162/31,Azure 20.20.110.164/30,Azure 20.20.110.168/29,Azure
-	20.20.110.176/28,Azure 20.20.110.192/26,Azure 20.20.111.0/24,Azure 20.20.112.0/26,Azure 20.20.112.64/27,Azure 20.20.112.96/28,Azure
-	20.20.112.112/29,Azure 20.20.112.120/31,Azure 20.20.116.0/25,Azure 20.20.116.128/28,Azure 20.20.116.144/29,Azure 20.20.120.0/24,Azure
-	20.20.121.0/25,Azure 20.20.121.128/26,Azure 20.20.121.192/30,Azure 20.20.124.0/23,Azure 20.20.126.0/26,Azure 20.20.126.64/27,Azure
-	20.20.126.96/28,Azure 20.20.126.112/29,Azure 20.20.126.120/30,Azure 20.20.126.124/31,Azure 20.20.128.0/20,Azure 20.20.144.0/23,Azure
-	20.20.190.0/23,Azure 20.20.192.0/18,Azure 20.21.0.0/16,Azure 20.22.0.0/15,Azure 20.24.0.0/14,Azure 20.28.0.0/15,Azure
-	20.31.0.0/16,Azure 20.33.0.0/16,Azure 20.36.0.0/15,Azure 20.38.0.0/19,Azure 20.38.32.0/20,Azure 20.38.48.0/21,Azure
-	20.38.56.0/22,Azure 20.38.64.0/18,Azure 20.38.128.0/18,Azure 20.38.196.0/22,Azure 20.38.200.0/22,Azure 20.38.208.0/22,Azure
-	20.39.0.0/16,Azure 20.40.0.0/15,Azure 20.42.0.0/17,Azure 20.42.128.0/19,Azure 20.42.160.0/23,Azure 20.42.168.0/21,Azure
-	20.42.176.0/20,Azure 20.42.192.0/18,Azure 20.43.0.0/16,Azure 20.44.0.0/16,Azure 20.45.0.0/17,Azure 20.45.128.0/21,Azure
-	20.45.144.0/20,Azure 20.45.160.0/19,Azure 20.45.192.0/18,Azure 20.46.0.0/17,Azure 20.46.144.0/20,Azure 20.46.160.0/19,Azure
-	20.46.192.0/18,Azure 20.47.0.0/16,Azure 20.48.0.0/17,Azure 20.48.128.0/18,Azure 20.48.192.0/20,Azure 20.48.208.0/21,Azure
-	20.48.224.0/19,Azure 20.49.0.0/16,Azure 20.50.0.0/15,Azure 20.52.0.0/18,Azure 20.52.64.0/20,Azure 20.52.80.0/26,Azure
-	20.52.80.64/27,Azure 20.52.88.0/21,Azure 20.52.96.0/19,Azure 20.52.128.0/17,Azure 20.53.0.0/19,Azure 20.53.32.0/28,Azure
-	20.53.40.0/21,Azure 20.53.48.0/20,Azure 20.53.64.0/18,Azure 20.53.128.0/17,Azure 20.54.0.0/15,Azure 20.56.0.0/13,Azure
-	20.64.0.0/12,Azure 20.80.0.0/13,Azure 20.88.0.0/14,Azure 20.92.0.0/15,Azure 20.94.0.0/16,Azure 20.95.0.0/17,Azure
-	20.95.128.0/20,Azure 20.95.144.0/22,Azure 20.95.192.0/21,Azure 20.95.200.0/22,Azure 20.95.204.0/23,Azure 20.95.208.0/24,Azure
-	20.95.224.0/23,Azure 20.95.240.0/23,Azure 20.95.248.0/22,Azure 20.95.255.0/29,Azure 20.96.0.0/13,Azure 20.104.0.0/14,Azure
-	20.108.0.0/15,Azure 20.110.0.0/16,Azure 20.111.0.0/17,Azure 20.111.136.0/21,Azure 20.112.0.0/12,Azure 20.135.0.0/17,Azure
-	20.135.128.0/18,Azure 20.135.192.0/19,Azure 20.135.224.0/20,Azure 20.135.240.0/25,Azure 20.135.242.0/23,Azure 20.135.244.0/22,Azure
-	20.135.248.0/21,Azure 20.136.0.0/22,Azure 20.136.4.0/23,Azure 20.136.7.0/25,Azure 20.136.128.0/24,Azure 20.136.129.0/25,Azure
-	20.143.0.0/17,Azure 20.143.128.0/18,Azure 20.143.192.0/19,Azure 20.143.224.0/22,Azure 20.150.0.0/15,Azure 20.152.0.0/17,Azure
-	20.153.0.0/16,Azure 20.157.0.0/16,Azure 20.160.0.0/12,Azure 20.184.0.0/15,Azure 20.186.0.0/16,Azure 20.187.0.0/17,Azure
-	20.187.128.0/18,Azure 20.187.192.0/21,Azure 20.187.224.0/19,Azure 20.188.0.0/14,Azure 20.192.0.0/15,Azure 20.194.0.0/16,Azure
-	20.195.0.0/17,Azure 20.195.128.0/22,Azure 20.195.136.0/21,Azure 20.195.144.0/20,Azure 20.195.160.0/19,Azure 20.195.192.0/18,Azure
-	20.196.0.0/14,Azure 20.200.0.0/16,Azure 20.201.0.0/17,Azure 20.201.128.0/18,Azure 20.201.192.0/20,Azure 20.201.208.0/21,Azure
-	20.201.216.0/22,Azure 20.201.220.0/23,Azure 20.201.223.0/24,Azure 20.201.224.0/21,Azure 20.201.240.0/23,Azure 20.201.242.0/24,Azure
-	20.202.0.0/17,Azure 20.202.128.0/18,Azure 20.202.192.0/19,Azure 20.202.224.0/20,Azure 20.202.240.0/22,Azure 20.202.244.0/24,Azure
-	20.202.246.0/23,Azure 20.202.248.0/22,Azure 20.203.0.0/16,Azure 20.204.0.0/14,Azure 20.208.0.0/13,Azure 20.216.0.0/17,Azure
-	20.216.128.0/18,Azure 20.216.192.0/19,Azure 20.216.224.0/21,Azure 20.217.0.0/19,Azure 20.217.32.0/22,Azure 20.217.40.0/21,Azure
-	20.217.48.0/20,Azure 20.217.64.0/19,Azure 20.217.128.0/17,Azure 20.218.0.0/15,Azure 20.220.0.0/14,Azure 20.224.0.0/14,Azure
-	20.228.0.0/15,Azure 20.230.0.0/16,Azure 20.231.0.0/17,Azure 20.231.128.0/19,Azure 20.231.192.0/18,Azure 20.232.0.0/13,Azure
-	20.240.0.0/18,Azure 20.240.64.0/19,Azure 20.240.128.0/17,Azure 20.241.0.0/16,Azure 20.242.0.0/15,Azure 20.244.0.0/14,Azure
-	20.248.0.0/13,Azure 23.20.0.0/14,AWS 23.88.0.0/17,Hetzner 23.91.0.0/19,AWS 23.92.16.0/20,Linode 23.92.224.0/19,OVH
-	23.96.0.0/16,Azure 23.97.48.0/20,Azure 23.97.64.0/19,Azure 23.97.96.0/20,Azure 23.97.112.0/25,Azure 23.97.112.128/28,Azure
-	23.97.112.160/27,Azure 23.97.112.192/26,Azure 23.97.116.0/22,Azure 23.97.120.0/21,Azure 23.97.128.0/17,Azure 23.98.32.0/20,Azure
-	23.98.48.0/21,Azure 23.98.56.0/24,Azure 23.98.57.64/26,Azure 23.98.64.0/18,Azure 23.98.128.0/17,Azure 23.99.0.0/16,Azure
-	23.100.0.0/16,Azure 23.101.0.0/19,Azure 23.101.32.0/21,Azure 23.101.48.0/20,Azure 23.101.64.0/20,Azure 23.101.80.0/21,Azure
-	23.101.112.0/20,Azure 23.101.128.0/17,Azure 23.102.0.0/16,Azure 23.103.64.0/24,Azure 23.103.132.0/25,Azure 23.103.132.128/26,Azure
-	23.103.132.192/29,Azure 23.103.132.208/28,Azure 23.103.132.240/28,Azure 23.103.133.224/27,Azure 23.103.134.0/25,Azure 23.103.134.192/26,Azure
-	23.103.135.0/26,Azure 23.103.135.128/26,Azure 23.103.135.192/27,Azure 23.103.136.32/27,Azure 23.103.136.64/28,Azure 23.103.136.96/27,Azure
-	23.103.136.224/27,Azure 23.103.137.0/26,Azure 23.103.137.96/27,Azure 23.103.137.128/25,Azure 23.103.138.0/27,Azure 23.103.138.32/28,Azure
-	23.103.138.96/27,Azure 23.103.138.128/27,Azure 23.103.138.224/27,Azure 23.103.139.96/27,Azure 23.103.139.130/31,Azure 23.103.139.162/31,Azure
-	23.103.140.192/26,Azure 23.103.141.96/27,Azure 23.103.141.192/26,Azure 23.103.142.32/27,Azure 23.103.156.1/32,Azure 23.103.156.2/31,Azure
-	23.103.156.33/32,Azure 23.103.156.34/31,Azure 23.103.156.65/32,Azure 23.103.156.66/31,Azure 23.103.156.96/27,Azure 23.103.156.128/26,Azure
-	23.103.156.192/27,Azure 23.103.157.1/32,Azure 23.103.157.2/31,Azure 23.103.157.64/26,Azure 23.103.157.128/27,Azure 23.103.157.160/28,Azure
-	23.103.157.192/26,Azure 23.103.198.1/32,Azure 23.103.198.2/31,Azure 23.103.198.33/32,Azure 23.103.198.34/31,Azure 23.103.198.128/25,Azure
-	23.103.212.0/23,Azure 23.136.172.0/24,OVH 23.137.200.0/24,OVH 23.151.184.0/24,OVH 23.156.24.0/23,OVH 23.160.0.0/24,AWS
-	23.228.196.0/23,AWS 23.228.198.0/24,AWS 23.228.212.0/23,AWS 23.228.214.0/24,AWS 23.228.219.0/24,AWS 23.228.220.0/22,AWS
-	23.228.228.0/22,AWS 23.228.244.0/24,AWS 23.228.246.0/23,AWS 23.228.248.0/22,AWS 23.230.14.0/24,OVH 23.230.93.0/24,OVH
-	23.234.192.0/18,AWS 23.236.48.0/20,GoogleCloud 23.238.128.0/17,AWS 23.239.0.0/19,Linode 23.251.128.0/19,GoogleCloud 23.254.0.0/19,AWS
-	23.254.32.0/21,AWS 23.254.120.0/21,AWS 24.110.0.0/21,AWS 24.110.8.0/23,AWS 24.110.16.0/20,AWS 24.110.32.0/19,AWS
-	24.110.128.0/17,AWS 24.144.64.0/21,DigitalOcean 24.144.74.0/23,DigitalOcean 24.144.76.0/22,DigitalOcean 24.144.80.0/20,DigitalOcean 24.144.96.0/19,DigitalOcean
-	24.199.64.0/18,DigitalOcean 27.0.0.0/22,AWS 31.6.62.0/24,OVH 31.24.81.0/24,OVH 31.41.37.0/24,OVH 31.56.219.0/24,OVH
-	31.57.161.0/24,OVH 31.57.199.0/24,OVH 31.58.88.0/24,Hetzner 31.59.68.0/24,OVH 31.77.120.0/24,OVH 31.99.205.0/24,OVH
-	31.220.220.0/22,AWS 31.220.235.0/24,AWS 31.220.236.0/24,AWS 31.220.247.0/24,AWS 31.220.252.0/24,AWS 32.184.0.0/13,AWS
-	32.192.0.0/12,AWS 32.232.0.0/16,AWS 32.236.0.0/15,AWS 32.240.0.0/15,AWS 34.0.0.0/17,GoogleCloud 34.0.128.0/18,GoogleCloud
-	34.0.192.0/19,GoogleCloud 34.0.224.0/22,GoogleCloud 34.0.240.0/20,GoogleCloud 34.1.0.0/18,GoogleCloud 34.1.128.0/17,GoogleCloud 34.2.0.0/18,GoogleCloud
-	34.2.76.0/23,GoogleCloud 34.2.96.0/20,GoogleCloud 34.2.128.0/17,GoogleCloud 34.3.32.0/20,GoogleCloud 34.3.76.0/22,GoogleCloud 34.3.80.0/20,GoogleCloud
-	34.3.96.0/20,GoogleCloud 34.4.16.0/22,GoogleCloud 34.4.24.0/21,GoogleCloud 34.4.32.0/19,GoogleCloud 34.4.64.0/19,GoogleCloud 34.4.96.0/22,GoogleCloud
-	34.4.102.0/23,GoogleCloud 34.4.104.0/21,GoogleCloud 34.4.128.0/18,GoogleCloud 34.4.192.0/24,GoogleCloud 34.6.0.0/15,GoogleCloud 34.8.0.0/14,GoogleCloud
-	34.12.0.0/16,GoogleCloud 34.13.0.0/18,GoogleCloud 34.13.68.0/22,GoogleCloud 34.13.72.0/21,GoogleCloud 34.13.112.0/20,GoogleCloud 34.13.128.0/17,GoogleCloud
-	34.14.0.0/17,GoogleCloud 34.14.128.0/18,GoogleCloud 34.14.192.0/19,GoogleCloud 34.15.0.0/16,GoogleCloud 34.16.0.0/12,GoogleCloud 34.32.0.0/15,GoogleCloud
-	34.34.0.0/17,GoogleCloud 34.34.128.0/18,GoogleCloud 34.34.216.0/21,GoogleCloud 34.35.0.0/16,GoogleCloud 34.36.0.0/14,GoogleCloud 34.40.0.0/15,GoogleCloud
-	34.42.0.0/16,GoogleCloud 34.44.0.0/14,GoogleCloud 34.48.0.0/15,GoogleCloud 34.50.0.0/17,GoogleCloud 34.50.144.0/20,GoogleCloud 34.50.160.0/19,GoogleCloud
-	34.50.192.0/18,GoogleCloud 34.51.0.0/16,GoogleCloud 34.52.128.0/17,GoogleCloud 34.53.0.0/16,GoogleCloud 34.54.0.0/15,GoogleCloud 34.56.0.0/13,GoogleCloud
-	34.64.32.0/19,GoogleCloud 34.64.64.0/18,GoogleCloud 34.64.128.0/17,GoogleCloud 34.65.0.0/16,GoogleCloud 34.66.0.0/15,GoogleCloud 34.68.0.0/14,GoogleCloud
-	34.72.0.0/13,GoogleCloud 34.80.0.0/12,GoogleCloud 34.96.64.0/18,GoogleCloud 34.96.128.0/17,GoogleCloud 34.97.0.0/16,GoogleCloud 34.98.64.0/18,GoogleCloud
-	34.98.128.0/21,GoogleCloud 34.100.128.0/17,GoogleCloud 34.101.18.0/24,GoogleCloud 34.101.20.0/22,GoogleCloud 34.101.24.0/22,GoogleCloud 34.101.32.0/19,GoogleCloud
-	34.101.64.0/18,GoogleCloud 34.101.128.0/17,GoogleCloud 34.102.0.0/16,GoogleCloud 34.104.27.0/24,GoogleCloud 34.104.49.0/24,GoogleCloud 34.104.50.0/23,GoogleCloud
-	34.104.52.0/24,GoogleCloud 34.104.56.0/21,GoogleCloud 34.104.64.0/18,GoogleCloud 34.104.128.0/17,GoogleCloud 34.105.0.0/16,GoogleCloud 34.106.0.0/15,GoogleCloud
-	34.108.0.0/16,GoogleCloud 34.110.128.0/17,GoogleCloud 34.111.0.0/16,GoogleCloud 34.112.0.0/15,GoogleCloud 34.116.0.0/21,GoogleCloud 34.116.64.0/18,GoogleCloud
-	34.116.128.0/17,GoogleCloud 34.117.0.0/16,GoogleCloud 34.118.0.0/17,GoogleCloud 34.118.128.0/18,GoogleCloud 34.118.192.0/20,GoogleCloud 34.118.240.0/20,GoogleCloud
-	34.120.0.0/14,GoogleCloud 34.124.0.0/18,GoogleCloud 34.124.112.0/20,GoogleCloud 34.124.128.0/17,GoogleCloud 34.125.0.0/16,GoogleCloud 34.126.64.0/18,GoogleCloud
-	34.126.128.0/18,GoogleCloud 34.126.192.0/19,GoogleCloud 34.127.0.0/17,GoogleCloud 34.127.156.0/22,GoogleCloud 34.127.160.0/20,GoogleCloud 34.127.177.0/24,GoogleCloud
-	34.127.178.0/23,GoogleCloud 34.127.180.0/24,GoogleCloud 34.127.184.0/21,GoogleCloud 34.128.4.0/22,GoogleCloud 34.128.32.0/22,GoogleCloud 34.128.36.0/23,GoogleCloud
-	34.128.42.0/23,GoogleCloud 34.128.44.0/22,GoogleCloud 34.128.48.0/23,GoogleCloud 34.128.52.0/22,GoogleCloud 34.128.58.0/23,GoogleCloud 34.128.60.0/22,GoogleCloud
-	34.128.64.0/18,GoogleCloud 34.128.128.0/18,GoogleCloud 34.128.208.0/20,GoogleCloud 34.128.224.0/20,GoogleCloud 34.129.0.0/16,GoogleCloud 34.130.0.0/15,GoogleCloud
-	34.132.0.0/14,GoogleCloud 34.136.0.0/14,GoogleCloud 34.140.0.0/15,GoogleCloud 34.142.0.0/16,GoogleCloud 34.143.64.0/21,GoogleCloud 34.143.128.0/17,GoogleCloud
-	34.144.172.0/22,GoogleCloud 34.144.176.0/20,GoogleCloud 34.144.192.0/18,GoogleCloud 34.145.0.0/16,GoogleCloud 34.146.0.0/15,GoogleCloud 34.148.0.0/14,GoogleCloud
-	34.152.0.0/18,GoogleCloud 34.152.64.0/22,GoogleCloud 34.152.68.0/23,GoogleCloud 34.152.72.0/21,GoogleCloud 34.152.80.0/23,GoogleCloud 34.152.84.0/22,GoogleCloud
-	34.152.96.0/23,GoogleCloud 34.152.98.0/24,GoogleCloud 34.152.100.0/22,GoogleCloud 34.152.104.0/21,GoogleCloud 34.152.112.0/20,GoogleCloud 34.153.16.0/20,GoogleCloud
-	34.153.32.0/23,GoogleCloud 34.153.38.0/24,GoogleCloud 34.153.40.0/21,GoogleCloud 34.153.48.0/21,GoogleCloud 34.153.58.0/23,GoogleCloud 34.153.62.0/23,GoogleCloud
-	34.153.128.0/18,GoogleCloud 34.153.192.0/19,GoogleCloud 34.153.224.0/23,GoogleCloud 34.153.230.0/24,GoogleCloud 34.153.232.0/21,GoogleCloud 34.153.240.0/21,GoogleCloud
-	34.153.250.0/23,GoogleCloud 34.153.252.0/22,GoogleCloud 34.154.0.0/15,GoogleCloud 34.156.0.0/16,GoogleCloud 34.157.0.0/21,GoogleCloud 34.157.8.0/23,GoogleCloud
-	34.157.12.0/22,GoogleCloud 34.157.16.0/20,GoogleCloud 34.157.32.0/19,GoogleCloud 34.157.64.0/20,GoogleCloud 34.157.80.0/22,GoogleCloud 34.157.84.0/23,GoogleCloud
-	34.157.87.0/24,GoogleCloud 34.157.88.0/21,GoogleCloud 34.157.96.0/20,GoogleCloud 34.157.112.0/21,GoogleCloud 34.157.121.0/24,GoogleCloud 34.157.122.0/24,GoogleCloud
-	34.157.123.0/25,GoogleCloud 34.157.124.0/22,GoogleCloud 34.157.128.0/21,GoogleCloud 34.157.136.0/23,GoogleCloud 34.157.140.0/22,GoogleCloud 34.157.144.0/20,GoogleCloud
-	34.157.160.0/19,GoogleCloud 34.157.192.0/20,GoogleCloud 34.157.208.0/22,GoogleCloud 34.157.212.0/23,GoogleCloud 34.157.215.0/24,GoogleCloud 34.157.216.0/21,GoogleCloud
-	34.157.224.0/20,GoogleCloud 34.157.240.0/21,GoogleCloud 34.157.249.0/24,GoogleCloud 34.157.250.0/23,GoogleCloud 34.157.252.0/22,GoogleCloud 34.158.8.0/21,GoogleCloud
-	34.158.16.0/20,GoogleCloud 34.158.32.0/19,GoogleCloud 34.158.64.0/18,GoogleCloud 34.158.128.0/18,GoogleCloud 34.158.192.0/19,GoogleCloud 34.158.224.0/20,GoogleCloud
-	34.158.240.0/21,GoogleCloud 34.159.0.0/16,GoogleCloud 34.160.0.0/14,GoogleCloud 34.164.0.0/15,GoogleCloud 34.166.0.0/16,GoogleCloud 34.168.0.0/13,GoogleCloud
-	34.176.0.0/16,GoogleCloud 34.177.32.0/22,GoogleCloud 34.177.36.0/23,GoogleCloud 34.177.40.0/21,GoogleCloud 34.177.48.0/21,GoogleCloud 34.177.64.0/23,GoogleCloud
-	34.177.66.0/24,GoogleCloud 34.177.68.0/22,GoogleCloud 34.177.72.0/22,GoogleCloud 34.177.76.0/23,GoogleCloud 34.177.78.0/25,GoogleCloud 34.177.79.0/24,GoogleCloud
-	34.177.80.0/20,GoogleCloud 34.177.96.0/20,GoogleCloud 34.177.112.0/21,GoogleCloud 34.177.120.0/22,GoogleCloud 34.178.0.0/15,GoogleCloud 34.180.0.0/17,GoogleCloud
-	34.181.0.0/16,GoogleCloud 34.182.0.0/16,GoogleCloud 34.183.0.0/21,GoogleCloud 34.183.8.0/23,GoogleCloud 34.183.12.0/22,GoogleCloud 34.183.16.0/22,GoogleCloud
-	34.183.20.128/25,GoogleCloud 34.183.21.0/24,GoogleCloud 34.183.24.0/22,GoogleCloud 34.183.28.0/23,GoogleCloud 34.183.32.0/22,GoogleCloud 34.183.36.0/23,GoogleCloud
-	34.183.40.0/21,GoogleCloud 34.183.50.0/23,GoogleCloud 34.183.52.0/22,GoogleCloud 34.183.56.0/22,GoogleCloud 34.183.60.0/23,GoogleCloud 34.183.66.0/23,GoogleCloud
-	34.183.68.0/22,GoogleCloud 34.183.72.0/21,GoogleCloud 34.183.80.0/20,GoogleCloud 34.183.96.0/20,GoogleCloud 34.183.112.0/21,GoogleCloud 34.183.120.0/22,GoogleCloud
-	34.183.124.0/24,GoogleCloud 34.184.0.0/21,GoogleCloud 34.184.8.0/23,GoogleCloud 34.184.12.0/22,GoogleCloud 34.184.16.0/22,GoogleCloud 34.184.22.0/23,GoogleCloud
-	34.184.24.0/22,GoogleCloud 34.184.30.0/23,GoogleCloud 34.184.32.0/22,GoogleCloud 34.184.40.0/21,GoogleCloud 34.184.48.0/21,GoogleCloud 34.184.56.0/22,GoogleCloud
-	34.184.64.0/19,GoogleCloud 34.184.96.0/20,GoogleCloud 34.184.112.0/21,GoogleCloud 34.184.120.0/22,GoogleCloud 34.185.64.0/18,GoogleCloud 34.185.128.0/17,GoogleCloud
-	34.186.0.0/15,GoogleCloud 34.192.0.0/10,AWS 35.18.0.0/15,AWS 35.26.0.0/15,AWS 35.28.0.0/14,AWS 35.34.96.0/21,AWS
-	35.34.104.0/23,AWS 35.42.0.0/15,AWS 35.44.0.0/15,AWS 35.48.0.0/15,AWS 35.50.128.0/21,AWS 35.50.137.0/24,AWS
-	35.50.139.0/24,AWS 35.50.141.0/24,AWS 35.50.142.0/23,AWS 35.50.144.0/23,AWS 35.50.160.0/23,AWS 35.50.176.0/23,AWS
-	35.50.178.0/24,AWS 35.50.192.0/22,AWS 35.50.196.0/23,AWS 35.50.208.0/22,AWS 35.50.212.0/23,AWS 35.50.214.0/24,AWS
-	35.50.224.0/24,AWS 35.50.226.0/23,AWS 35.50.228.0/22,AWS 35.50.232.0/21,AWS 35.50.240.0/23,AWS 35.50.242.0/24,AWS
-	35.52.0.0/15,AWS 35.54.16.0/20,AWS 35.54.32.0/20,AWS 35.54.48.0/21,AWS 35.54.56.0/22,AWS 35.54.60.0/23,AWS
-	35.54.62.0/24,AWS 35.54.64.0/18,AWS 35.55.0.0/17,AWS 35.56.0.0/15,AWS 35.60.0.0/15,AWS 35.71.64.0/20,AWS
-	35.71.92.0/23,AWS 35.71.94.0/24,AWS 35.71.96.0/19,AWS 35.71.128.0/17,AWS 35.72.0.0/13,AWS 35.80.0.0/12,AWS
-	35.128.64.0/18,AWS 35.128.128.0/18,AWS 35.152.0.0/13,AWS 35.160.0.0/12,AWS 35.176.0.0/13,AWS 35.184.0.0/15,GoogleCloud
-	35.186.0.0/16,GoogleCloud 35.187.0.0/17,GoogleCloud 35.187.144.0/20,GoogleCloud 35.187.160.0/19,GoogleCloud 35.187.192.0/18,GoogleCloud 35.188.0.0/15,GoogleCloud
-	35.190.0.0/18,GoogleCloud 35.190.64.0/19,GoogleCloud 35.190.112.0/20,GoogleCloud 35.190.128.0/18,GoogleCloud 35.190.192.0/19,GoogleCloud 35.190.224.0/20,GoogleCloud
-	35.192.0.0/14,GoogleCloud 35.196.0.0/15,GoogleCloud 35.198.0.0/16,GoogleCloud 35.199.0.0/17,GoogleCloud 35.199.144.0/20,GoogleCloud 35.199.160.0/19,GoogleCloud
-	35.200.0.0/16,GoogleCloud 35.201.0.0/19,GoogleCloud 35.201.41.0/24,GoogleCloud 35.201.64.0/18,GoogleCloud 35.201.128.0/17,GoogleCloud 35.202.0.0/16,GoogleCloud
-	35.203.0.0/17,GoogleCloud 35.203.128.0/18,GoogleCloud 35.203.210.0/23,GoogleCloud 35.203.212.0/22,GoogleCloud 35.203.216.0/22,GoogleCloud 35.203.232.0/21,GoogleCloud
-	35.204.0.0/15,GoogleCloud 35.206.10.0/23,GoogleCloud 35.206.32.0/19,GoogleCloud 35.206.64.0/18,GoogleCloud 35.206.128.0/17,GoogleCloud 35.207.0.0/16,GoogleCloud
-	35.208.0.0/13,GoogleCloud 35.216.0.0/15,GoogleCloud 35.219.0.0/17,GoogleCloud 35.219.128.0/18,GoogleCloud 35.219.224.0/19,GoogleCloud 35.220.0.0/20,GoogleCloud
-	35.220.16.0/21,GoogleCloud 35.220.24.0/22,GoogleCloud 35.220.31.0/24,GoogleCloud 35.220.32.0/19,GoogleCloud 35.220.64.0/18,GoogleCloud 35.220.128.0/17,GoogleCloud
-	35.221.0.0/16,GoogleCloud 35.222.0.0/15,GoogleCloud 35.224.0.0/14,GoogleCloud 35.228.0.0/16,GoogleCloud 35.229.16.0/20,GoogleCloud 35.229.32.0/19,GoogleCloud
-	35.229.64.0/18,GoogleCloud 35.229.128.0/17,GoogleCloud 35.230.0.0/17,GoogleCloud 35.230.128.0/18,GoogleCloud 35.230.240.0/20,GoogleCloud 35.231.0.0/16,GoogleCloud
-	35.232.0.0/15,GoogleCloud 35.234.0.0/16,GoogleCloud 35.235.0.0/17,GoogleCloud 35.235.172.0/22,GoogleCloud 35.235.216.0/21,GoogleCloud 35.236.0.0/14,GoogleCloud
-	35.240.0.0/15,GoogleCloud 35.242.0.0/20,GoogleCloud 35.242.16.0/21,GoogleCloud 35.242.24.0/22,GoogleCloud 35.242.31.0/24,GoogleCloud 35.242.32.0/19,GoogleCloud
-	35.242.64.0/18,GoogleCloud 35.242.128.0/17,GoogleCloud 35.243.0.0/20,GoogleCloud 35.243.32.0/20,GoogleCloud 35.243.56.0/21,GoogleCloud 35.243.64.0/18,GoogleCloud
-	35.243.128.0/17,GoogleCloud 35.244.0.0/14,GoogleCloud 35.252.0.0/14,GoogleCloud 36.103.232.0/25,AWS 36.103.232.128/26,AWS 37.27.0.0/16,Hetzner
-	37.59.0.0/16,OVH 37.60.48.0/20,OVH 37.98.145.0/24,OVH 37.139.0.0/19,DigitalOcean 37.139.130.0/24,OVH 37.187.0.0/16,OVH
-	37.203.157.0/24,AWS 38.47.128.0/21,Alibaba 38.47.136.0/22,Alibaba 38.47.140.0/23,Alibaba 38.47.142.0/24,Alibaba 38.47.144.0/23,Alibaba
-	38.47.146.0/24,Alibaba 38.47.148.0/23,Alibaba 38.47.150.0/24,Alibaba 38.47.152.0/21,Alibaba 38.104.155.92/31,Oracle 39.96.0.0/13,Alibaba
-	39.104.0.0/14,Alibaba 39.108.0.0/16,Alibaba 40.27.29.0/24,OVH 40.32.0.0/16,AWS 40.35.0.0/16,AWS 40.38.0.0/15,AWS
-	40.64.0.0/17,Azure 40.64.128.0/21,Azure 40.64.144.0/20,Azure 40.64.160.0/19,Azure 40.64.192.0/23,Azure 40.64.194.0/24,Azure
-	40.64.195.0/25,Azure 40.65.0.0/16,Azure 40.66.32.0/19,Azure 40.66.120.0/21,Azure 40.67.0.0/19,Azure 40.67.40.0/21,Azure
-	40.67.48.0/20,Azure 40.67.64.0/19,Azure 40.67.96.0/20,Azure 40.67.120.0/21,Azure 40.67.128.0/17,Azure 40.68.0.0/16,Azure
-	40.69.0.0/17,Azure 40.69.128.0/18,Azure 40.69.192.0/19,Azure 40.70.0.0/18,Azure 40.70.64.0/20,Azure 40.70.80.0/21,Azure
-	40.70.88.0/28,Azure 40.70.128.0/17,Azure 40.71.0.0/16,Azure 40.74.0.0/16,Azure 40.75.0.0/19,Azure 40.75.32.0/21,Azure
-	40.75.64.0/18,Azure 40.75.128.0/17,Azure 40.76.0.0/16,Azure 40.77.0.0/17,Azure 40.77.128.0/21,Azure 40.77.136.0/24,Azure
-	40.77.137.0/25,Azure 40.77.137.128/26,Azure 40.77.137.192/27,Azure 40.77.138.0/23,Azure 40.77.160.0/22,Azure 40.77.164.0/23,Azure
-	40.77.166.0/25,Azure 40.77.166.128/28,Azure 40.77.166.160/27,Azure 40.77.166.192/26,Azure 40.77.167.0/24,Azure 40.77.168.0/22,Azure
-	40.77.172.0/23,Azure 40.77.174.0/24,Azure 40.77.175.0/25,Azure 40.77.175.128/26,Azure 40.77.175.192/27,Azure 40.77.175.240/28,Azure
-	40.77.176.0/20,Azure 40.77.192.0/21,Azure 40.77.200.0/23,Azure 40.77.202.0/24,Azure 40.77.224.0/21,Azure 40.77.232.0/22,Azure
-	40.77.236.0/26,Azure 40.77.236.80/28,Azure 40.77.236.96/27,Azure 40.77.236.128/26,Azure 40.77.236.192/28,Azure 40.77.236.224/27,Azure
-	40.77.237.0/24,Azure 40.77.240.0/22,Azure 40.77.244.0/25,Azure 40.77.245.0/24,Azure 40.77.246.0/23,Azure 40.77.248.0/22,Azure
-	40.77.252.0/23,Azure 40.77.254.0/26,Azure 40.77.254.64/27,Azure 40.77.254.128/25,Azure 40.77.255.0/24,Azure 40.78.0.0/17,Azure
-	40.78.128.0/18,Azure 40.78.192.0/20,Azure 40.78.208.0/27,Azure 40.78.208.32/30,Azure 40.78.208.48/28,Azure 40.78.208.64/27,Azure
-	40.78.209.0/24,Azure 40.78.210.0/23,Azure 40.78.212.0/22,Azure 40.78.216.0/21,Azure 40.78.224.0/19,Azure 40.79.0.0/21,Azure
-	40.79.8.0/27,Azure 40.79.8.32/28,Azure 40.79.8.64/27,Azure 40.79.8.96/28,Azure 40.79.9.0/24,Azure 40.79.16.0/20,Azure
-	40.79.32.0/20,Azure 40.79.48.0/27,Azure 40.79.48.32/28,Azure 40.79.49.0/24,Azure 40.79.56.0/21,Azure 40.79.64.0/20,Azure
-	40.79.80.0/21,Azure 40.79.88.0/27,Azure 40.79.88.32/28,Azure 40.79.89.0/24,Azure 40.79.90.0/24,Azure 40.79.91.0/28,Azure
-	40.79.92.0/24,Azure 40.79.93.0/28,Azure 40.79.94.0/24,Azure 40.79.95.0/28,Azure 40.79.96.0/19,Azure 40.79.128.0/18,Azure
-	40.79.192.0/19,Azure 40.79.232.0/21,Azure 40.79.240.0/20,Azure 40.80.0.0/18,Azure 40.80.64.0/19,Azure 40.80.96.0/20,Azure
-	40.80.144.0/20,Azure 40.80.160.0/24,Azure 40.80.161.0/28,Azure 40.80.168.0/21,Azure 40.80.176.0/20,Azure 40.80.192.0/18,Azure
-	40.81.0.0/18,Azure 40.81.64.0/19,Azure 40.81.96.212/32,Azure 40.81.112.0/20,Azure 40.81.128.0/19,Azure 40.81.176.0/20,Azure
-	40.81.192.0/18,Azure 40.82.0.0/21,Azure 40.82.16.0/20,Azure 40.82.32.0/21,Azure 40.82.44.0/22,Azure 40.82.48.0/22,Azure
-	40.82.60.0/22,Azure 40.82.80.0/22,Azure 40.82.92.0/22,Azure 40.82.96.0/22,Azure 40.82.128.0/17,Azure 40.83.0.0/16,Azure
-	40.84.0.0/15,Azure 40.86.0.0/16,Azure 40.87.0.0/17,Azure 40.87.128.0/19,Azure 40.87.160.0/21,Azure 40.87.168.0/26,Azure
-	40.87.168.68/30,Azure 40.87.168.72/29,Azure 40.87.168.80/28,Azure 40.87.168.96/28,Azure 40.87.168.112/31,Azure 40.87.168.120/29,Azure
-	40.87.168.128/26,Azure 40.87.168.192/29,Azure 40.87.168.200/30,Azure 40.87.168.208/28,Azure 40.87.168.224/29,Azure 40.87.168.244/30,Azure
-	40.87.168.248/29,Azure 40.87.169.0/26,Azure 40.87.169.64/28,Azure 40.87.169.80/29,Azure 40.87.169.88/30,Azure 40.87.169.98/31,Azure
-	40.87.169.100/30,Azure 40.87.169.104/29,Azure 40.87.169.112/28,Azure 40.87.169.128/26,Azure 40.87.169.192/28,Azure 40.87.169.208/29,Azure
-	40.87.169.220/30,Azure 40.87.169.224/27,Azure 40.87.170.0/23,Azure 40.87.172.0/22,Azure 40.87.176.0/29,Azure 40.87.176.8/30,Azure
-	40.87.176.12/31,Azure 40.87.176.20/30,Azure 40.87.176.24/29,Azure 40.87.176.32/27,Azure 40.87.176.64/29,Azure 40.87.176.72/30,Azure
-	40.87.176.76/31,Azure 40.87.176.84/30,Azure 40.87.176.88/29,Azure 40.87.176.114/31,Azure 40.87.176.116/30,Azure 40.87.176.120/31,Azure
-	40.87.176.134/31,Azure 40.87.176.136/30,Azure 40.87.176.150/31,Azure 40.87.176.152/29,Azure 40.87.176.160/27,Azure 40.87.176.192/26,Azure
-	40.87.177.0/25,Azure 40.87.177.128/27,Azure 40.87.177.160/29,Azure 40.87.177.168/30,Azure 40.87.177.180/30,Azure 40.87.177.184/29,Azure
-	40.87.177.192/26,Azure 40.87.178.0/27,Azure 40.87.178.32/28,Azure 40.87.178.52/30,Azure 40.87.178.56/29,Azure 40.87.178.64/29,Azure
-	40.87.178.78/31,Azure 40.87.178.80/31,Azure 40.87.178.90/31,Azure 40.87.178.92/30,Azure 40.87.178.96/28,Azure 40.87.178.112/29,Azure
-	40.87.178.138/31,Azure 40.87.178.140/30,Azure 40.87.178.144/28,Azure 40.87.178.160/27,Azure 40.87.178.192/27,Azure 40.87.178.224/29,Azure
-	40.87.178.232/31,Azure 40.87.178.242/31,Azure 40.87.178.244/30,Azure 40.87.178.248/29,Azure 40.87.179.0/31,Azure 40.87.179.10/31,Azure
-	40.87.179.12/30,Azure 40.87.179.16/28,Azure 40.87.179.32/27,Azure 40.87.179.64/26,Azure 40.87.179.128/27,Azure 40.87.179.160/31,Azure
-	40.87.179.178/31,Azure 40.87.179.180/30,Azure 40.87.179.184/29,Azure 40.87.179.192/27,Azure 40.87.179.224/28,Azure 40.87.179.240/29,Azure
-	40.87.179.248/31,Azure 40.87.180.0/23,Azure 40.87.182.0/25,Azure 40.87.182.136/29,Azure 40.87.182.144/28,Azure 40.87.182.160/27,Azure
-	40.87.182.192/26,Azure 40.87.183.0/26,Azure 40.87.183.64/28,Azure 40.87.183.92/30,Azure 40.87.183.96/29,Azure 40.87.183.104/30,Azure
-	40.87.183.120/29,Azure 40.87.183.128/25,Azure 40.87.184.0/21,Azure 40.87.192.0/19,Azure 40.87.224.0/20,Azure 40.88.0.0/15,Azure
-	40.90.8.0/21,Azure 40.90.16.0/20,Azure 40.90.64.0/22,Azure 40.90.68.0/24,Azure 40.90.70.0/23,Azure 40.90.128.0/17,Azure
-	40.91.0.0/21,Azure 40.91.12.0/25,Azure 40.91.12.128/28,Azure 40.91.12.160/27,Azure 40.91.12.208/28,Azure 40.91.12.240/28,Azure
-	40.91.13.0/28,Azure 40.91.13.64/27,Azure 40.91.13.96/28,Azure 40.91.13.128/27,Azure 40.91.13.240/28,Azure 40.91.14.0/24,Azure
-	40.91.16.0/20,Azure 40.91.32.0/22,Azure 40.91.64.0/18,Azure 40.91.160.0/19,Azure 40.91.192.0/18,Azure 40.92.0.0/14,Azure
-	40.96.46.0/24,Azure 40.96.50.0/24,Azure 40.96.52.0/24,Azure 40.96.55.0/24,Azure 40.96.61.0/24,Azure 40.96.63.0/24,Azure
-	40.96.255.0/24,Azure 40.97.4.0/22,Azure 40.97.12.0/22,Azure 40.97.20.0/22,Azure 40.97.32.0/22,Azure 40.97.44.0/22,Azure
-	40.97.52.0/23,Azure 40.97.54.0/25,Azure 40.97.55.64/26,Azure 40.97.55.128/25,Azure 40.97.60.0/26,Azure 40.97.61.0/24,Azure
-	40.97.62.0/25,Azure 40.97.63.64/26,Azure 40.97.63.128/25,Azure 40.97.72.0/26,Azure 40.97.73.0/25,Azure 40.97.73.128/26,Azure
-	40.98.0.0/22,Azure 40.98.4.0/26,Azure 40.98.16.0/24,Azure 40.98.18.0/23,Azure 40.98.20.0/22,Azure 40.100.4.0/22,Azure
-	40.100.24.0/23,Azure 40.100.26.0/24,Azure 40.100.27.0/25,Azure 40.100.27.192/26,Azure 40.100.36.0/24,Azure 40.100.37.0/26,Azure
-	40.100.38.0/24,Azure 40.100.39.0/25,Azure 40.100.44.128/26,Azure 40.100.45.64/26,Azure 40.100.58.64/26,Azure 40.100.58.128/25,Azure
-	40.100.59.0/26,Azure 40.100.60.0/26,Azure 40.100.61.0/24,Azure 40.100.62.0/23,Azure 40.100.64.0/22,Azure 40.100.68.0/23,Azure
-	40.100.70.0/24,Azure 40.100.71.0/26,Azure 40.100.72.0/24,Azure 40.100.73.0/25,Azure 40.100.73.128/26,Azure 40.100.80.0/24,Azure
-	40.101.0.0/22,Azure 40.101.20.0/24,Azure 40.101.21.0/25,Azure 40.101.21.128/26,Azure 40.101.22.0/23,Azure 40.101.24.192/26,Azure
-	40.101.25.0/24,Azure 40.101.26.0/23,Azure 40.101.56.0/23,Azure 40.101.58.0/24,Azure 40.101.59.0/26,Azure 40.101.112.0/23,Azure
-	40.101.114.0/24,Azure 40.101.115.0/25,Azure 40.101.115.128/26,Azure 40.101.116.0/25,Azure 40.101.116.128/26,Azure 40.102.16.0/23,Azure
-	40.102.18.0/26,Azure 40.102.24.0/25,Azure 40.102.24.128/26,Azure 40.107.0.0/16,Azure 40.112.0.0/19,Azure 40.112.36.0/23,Azure
-	40.112.38.192/26,Azure 40.112.48.0/20,Azure 40.112.64.0/18,Azure 40.112.128.0/17,Azure 40.113.0.0/16,Azure 40.114.0.0/15,Azure
-	40.116.0.0/15,Azure 40.118.0.0/16,Azure 40.119.0.0/18,Azure 40.119.64.0/19,Azure 40.119.96.0/22,Azure 40.119.100.0/26,Azure
-	40.119.100.64/28,Azure 40.119.100.84/30,Azure 40.119.100.88/29,Azure 40.119.100.100/30,Azure 40.119.100.104/29,Azure 40.119.100.112/29,Azure
-	40.119.100.120/31,Azure 40.119.100.124/30,Azure 40.119.100.128/30,Azure 40.119.100.134/31,Azure 40.119.100.136/30,Azure 40.119.100.140/31,Azure
-	40.119.100.144/28,Azure 40.119.100.160/27,Azure 40.119.104.0/21,Azure 40.119.112.0/20,Azure 40.119.128.0/17,Azure 40.120.0.0/17,Azure
-	40.120.128.0/27,Azure 40.120.128.32/30,Azure 40.120.128.40/29,Azure 40.120.128.48/30,Azure 40.120.128.56/29,Azure 40.120.128.68/30,Azure
-	40.120.128.76/30,Azure 40.120.128.80/28,Azure 40.120.128.96/27,Azure 40.120.128.128/27,Azure 40.120.128.160/29,Azure 40.120.128.168/30,Azure
-	40.120.128.172/31,Azure 40.120.132.0/23,Azure 40.120.134.0/24,Azure 40.120.135.0/26,Azure 40.120.135.64/28,Azure 40.120.135.80/31,Azure
-	40.120.136.0/22,Azure 40.120.140.0/24,Azure 40.120.141.0/25,Azure 40.120.141.128/26,Azure 40.120.141.192/27,Azure 40.120.144.0/23,Azure
-	40.120.146.0/26,Azure 40.120.146.64/27,Azure 40.120.148.0/22,Azure 40.120.152.0/22,Azure 40.120.156.0/23,Azure 40.120.158.0/24,Azure
-	40.120.159.0/26,Azure 40.120.159.64/28,Azure 40.120.159.84/30,Azure 40.120.159.88/29,Azure 40.120.159.96/27,Azure 40.120.159.128/25,Azure
-	40.120.160.0/22,Azure 40.120.164.0/23,Azure 40.120.166.0/24,Azure 40.120.167.0/26,Azure 40.120.167.64/27,Azure 40.120.167.96/29,Azure
-	40.120.167.106/31,Azure 40.120.167.108/30,Azure 40.120.167.112/28,Azure 40.120.167.128/25,Azure 40.120.168.0/23,Azure 40.120.170.0/27,Azure
-	40.120.170.32/29,Azure 40.120.170.40/30,Azure 40.120.170.44/31,Azure 40.120.172.0/23,Azure 40.120.174.0/24,Azure 40.120.175.0/28,Azure
-	40.120.175.16/29,Azure 40.120.175.24/30,Azure 40.120.175.28/31,Azure 40.120.176.0/24,Azure 40.120.177.0/26,Azure 40.120.177.64/28,Azure
-	40.120.177.80/29,Azure 40.120.177.94/31,Azure 40.120.177.96/27,Azure 40.120.177.128/25,Azure 40.120.178.0/24,Azure 40.120.179.0/27,Azure
-	40.120.179.32/29,Azure 40.120.180.0/27,Azure 40.120.180.32/29,Azure 40.120.180.40/30,Azure 40.120.180.52/30,Azure 40.120.180.56/29,Azure
-	40.120.180.64/26,Azure 40.120.180.128/25,Azure 40.120.181.0/24,Azure 40.120.182.0/24,Azure 40.120.183.0/25,Azure 40.120.183.128/26,Azure
-	40.120.183.192/28,Azure 40.120.183.208/29,Azure 40.120.184.0/23,Azure 40.120.186.0/25,Azure 40.120.186.128/29,Azure 40.120.188.0/22,Azure
-	40.121.0.0/16,Azure 40.122.0.0/16,Azure 40.123.0.0/17,Azure 40.123.128.0/21,Azure 40.123.136.0/24,Azure 40.123.138.0/25,Azure
-	40.123.138.128/26,Azure 40.123.138.192/29,Azure 40.123.138.200/30,Azure 40.123.140.0/22,Azure 40.123.144.0/23,Azure 40.123.146.0/29,Azure
-	40.123.146.8/30,Azure 40.123.146.14/31,Azure 40.123.146.16/29,Azure 40.123.146.24/31,Azure 40.123.146.30/31,Azure 40.123.146.32/28,Azure
-	40.123.146.48/29,Azure 40.123.146.62/31,Azure 40.123.146.64/27,Azure 40.123.146.96/28,Azure 40.123.146.112/29,Azure 40.123.146.120/30,Azure
-	40.123.146.128/27,Azure 40.123.146.160/31,Azure 40.123.146.166/31,Azure 40.123.146.168/29,Azure 40.123.146.176/28,Azure 40.123.146.192/26,Azure
-	40.123.147.0/29,Azure 40.123.147.8/31,Azure 40.123.147.14/31,Azure 40.123.147.16/28,Azure 40.123.147.32/27,Azure 40.123.147.64/26,Azure
-	40.123.147.128/25,Azure 40.123.148.0/24,Azure 40.123.149.0/25,Azure 40.123.149.128/27,Azure 40.123.149.160/30,Azure 40.123.152.0/21,Azure
-	40.123.160.0/22,Azure 40.123.164.0/25,Azure 40.123.164.128/27,Azure 40.123.164.160/30,Azure 40.123.164.164/31,Azure 40.123.164.174/31,Azure
-	40.123.164.176/30,Azure 40.123.164.190/31,Azure 40.123.164.192/31,Azure 40.123.164.208/30,Azure 40.123.164.216/29,Azure 40.123.164.224/30,Azure
-	40.123.164.232/31,Azure 40.123.164.246/31,Azure 40.123.164.248/29,Azure 40.123.165.0/29,Azure 40.123.165.12/30,Azure 40.123.165.16/28,Azure
-	40.123.165.32/29,Azure 40.123.165.40/30,Azure 40.123.165.44/31,Azure 40.123.165.54/31,Azure 40.123.165.56/29,Azure 40.123.165.64/29,Azure
-	40.123.165.72/30,Azure 40.123.165.76/31,Azure 40.123.165.82/31,Azure 40.123.165.84/30,Azure 40.123.165.88/29,Azure 40.123.165.96/29,Azure
-	40.123.165.104/30,Azure 40.123.165.112/28,Azure 40.123.165.128/27,Azure 40.123.165.160/30,Azure 40.123.165.166/31,Azure 40.123.165.168/31,Azure
-	40.123.165.172/31,Azure 40.123.165.184/31,Azure 40.123.165.188/31,Azure 40.123.165.194/31,Azure 40.123.165.202/31,Azure 40.123.165.206/31,Azure
-	40.123.165.226/31,Azure 40.123.165.232/31,Azure 40.123.165.240/30,Azure 40.123.165.250/31,Azure 40.123.165.254/31,Azure 40.123.166.8/31,Azure
-	40.123.166.16/31,Azure 40.123.166.24/31,Azure 40.123.166.30/31,Azure 40.123.166.32/30,Azure 40.123.166.36/31,Azure 40.123.166.40/30,Azure
-	40.123.166.58/31,Azure 40.123.166.70/31,Azure 40.123.166.78/31,Azure 40.123.166.80/31,Azure 40.123.166.84/31,Azure 40.123.166.104/29,Azure
-	40.123.166.112/28,Azure 40.123.166.128/25,Azure 40.123.167.0/25,Azure 40.123.167.128/31,Azure 40.123.167.134/31,Azure 40.123.167.136/31,Azure
-	40.123.167.142/31,Azure 40.123.167.144/28,Azure 40.123.167.160/27,Azure 40.123.167.192/27,Azure 40.123.167.224/31,Azure 40.123.167.234/31,Azure
-	40.123.167.236/30,Azure 40.123.167.240/28,Azure 40.123.168.0/25,Azure 40.123.168.128/26,Azure 40.123.168.192/30,Azure 40.123.168.202/31,Azure
-	40.123.168.210/31,Azure 40.123.168.212/30,Azure 40.123.168.216/30,Azure 40.123.168.226/31,Azure 40.123.168.228/30,Azure 40.123.168.232/29,Azure
-	40.123.168.240/28,Azure 40.123.169.0/27,Azure 40.123.169.32/30,Azure 40.123.169.36/31,Azure 40.123.169.44/31,Azure 40.123.169.52/31,Azure
-	40.123.169.60/30,Azure 40.123.169.64/27,Azure 40.123.169.96/28,Azure 40.123.169.112/30,Azure 40.123.169.120/29,Azure 40.123.169.128/28,Azure
-	40.123.169.144/29,Azure 40.123.169.160/29,Azure 40.123.169.180/30,Azure 40.123.169.184/29,Azure 40.123.169.192/26,Azure 40.123.170.0/26,Azure
-	40.123.170.64/28,Azure 40.123.170.80/29,Azure 40.123.170.88/30,Azure 40.123.170.92/31,Azure 40.123.170.110/31,Azure 40.123.170.112/28,Azure
-	40.123.170.128/31,Azure 40.123.170.136/29,Azure 40.123.170.144/28,Azure 40.123.170.160/27,Azure 40.123.170.192/26,Azure 40.123.171.0/26,Azure
-	40.123.171.64/28,Azure 40.123.171.80/29,Azure 40.123.171.92/30,Azure 40.123.171.96/28,Azure 40.123.171.116/30,Azure 40.123.171.120/29,Azure
-	40.123.171.128/28,Azure 40.123.171.150/31,Azure 40.123.171.152/29,Azure 40.123.171.160/27,Azure 40.123.171.192/28,Azure 40.123.171.212/30,Azure
-	40.123.171.216/29,Azure 40.123.171.224/30,Azure 40.123.171.232/29,Azure 40.123.171.244/30,Azure 40.123.171.248/29,Azure 40.123.172.0/22,Azure
-	40.123.176.0/21,Azure 40.123.184.0/27,Azure 40.123.184.32/30,Azure 40.123.184.38/31,Azure 40.123.184.42/31,Azure 40.123.184.44/30,Azure
-	40.123.184.48/29,Azure 40.123.184.56/31,Azure 40.123.184.60/31,Azure 40.123.184.64/27,Azure 40.123.184.96/29,Azure 40.123.184.104/31,Azure
-	40.123.184.108/30,Azure 40.123.184.112/30,Azure 40.123.184.116/31,Azure 40.123.184.120/31,Azure 40.123.184.126/31,Azure 40.123.184.128/28,Azure
-	40.123.184.144/29,Azure 40.123.184.154/31,Azure 40.123.184.156/30,Azure 40.123.184.160/27,Azure 40.123.184.192/31,Azure 40.123.184.198/31,Azure
-	40.123.184.200/29,Azure 40.123.184.216/29,Azure 40.123.184.224/27,Azure 40.123.185.0/27,Azure 40.123.185.32/29,Azure 40.123.185.48/31,Azure
-	40.123.185.52/30,Azure 40.123.185.56/29,Azure 40.123.185.64/26,Azure 40.123.185.128/29,Azure 40.123.185.138/31,Azure 40.123.185.144/28,Azure
-	40.123.185.160/29,Azure 40.123.185.168/31,Azure 40.123.185.172/30,Azure 40.123.185.176/28,Azure 40.123.185.192/26,Azure 40.123.186.0/26,Azure
-	40.123.186.64/29,Azure 40.123.186.72/30,Azure 40.123.186.76/31,Azure 40.123.186.80/29,Azure 40.123.186.88/30,Azure 40.123.186.92/31,Azure
-	40.123.186.96/30,Azure 40.123.186.102/31,Azure 40.123.186.104/30,Azure 40.123.186.110/31,Azure 40.123.186.112/29,Azure 40.123.186.120/31,Azure
-	40.123.186.124/30,Azure 40.123.186.132/30,Azure 40.123.186.136/31,Azure 40.123.186.140/31,Azure 40.123.186.144/30,Azure 40.123.186.150/31,Azure
-	40.123.186.154/31,Azure 40.123.186.156/30,Azure 40.123.186.160/30,Azure 40.123.186.164/31,Azure 40.123.186.168/30,Azure 40.123.186.172/31,Azure
-	40.123.186.178/31,Azure 40.123.186.180/31,Azure 40.123.186.186/31,Azure 40.123.186.188/30,Azure 40.123.186.192/31,Azure 40.123.186.196/30,Azure
-	40.123.186.200/31,Azure 40.123.186.206/31,Azure 40.123.186.208/31,Azure 40.123.186.212/30,Azure 40.123.186.216/30,Azure 40.123.186.220/31,Azure
-	40.123.186.224/29,Azure 40.123.186.234/31,Azure 40.123.186.236/30,Azure 40.123.186.240/31,Azure 40.123.186.244/31,Azure 40.123.186.252/31,Azure
-	40.123.187.0/31,Azure 40.123.187.8/30,Azure 40.123.187.14/31,Azure 40.123.187.16/31,Azure 40.123.187.22/31,Azure 40.123.187.24/29,Azure
-	40.123.187.32/28,Azure 40.123.187.52/30,Azure 40.123.187.56/30,Azure 40.123.187.62/31,Azure 40.123.187.64/31,Azure 40.123.187.70/31,Azure
-	40.123.187.72/31,Azure 40.123.187.78/31,Azure 40.123.187.80/30,Azure 40.123.187.84/31,Azure 40.123.187.88/29,Azure 40.123.187.100/30,Azure
-	40.123.187.106/31,Azure 40.123.187.110/31,Azure 40.123.187.114/31,Azure 40.123.187.118/31,Azure 40.123.187.120/29,Azure 40.123.187.134/31,Azure
-	40.123.187.138/31,Azure 40.123.187.140/30,Azure 40.123.187.144/29,Azure 40.123.187.154/31,Azure 40.123.187.156/30,Azure 40.123.187.160/27,Azure
-	40.123.187.192/29,Azure 40.123.187.200/30,Azure 40.123.187.212/30,Azure 40.123.187.216/29,Azure 40.123.187.224/27,Azure 40.123.188.0/22,Azure
-	40.123.192.0/18,Azure 40.124.0.0/16,Azure 40.125.0.0/17,Azure 40.126.0.0/18,Azure 40.126.128.0/17,Azure 40.127.0.0/19,Azure
-	40.127.32.0/24,Azure 40.127.64.0/19,Azure 40.127.96.0/20,Azure 40.127.128.0/17,Azure 40.138.216.0/22,AWS 40.143.64.0/21,AWS
-	40.160.0.0/17,OVH 40.160.128.0/19,OVH 40.160.224.0/22,OVH 40.160.228.0/24,OVH 40.160.230.0/23,OVH 40.160.232.0/21,OVH
-	40.160.240.0/23,OVH 40.160.242.0/24,OVH 40.160.244.0/24,OVH 40.160.246.0/24,OVH 40.160.248.0/24,OVH 40.160.250.0/24,OVH
-	40.160.252.0/24,OVH 40.160.254.0/24,OVH 40.164.0.0/14,AWS 40.168.224.0/21,AWS 40.168.255.0/24,AWS 40.172.0.0/14,AWS
-	40.176.0.0/14,AWS 40.180.0.0/15,AWS 40.186.0.0/15,AWS 40.188.0.0/16,AWS 40.192.0.0/14,AWS 40.201.0.0/16,AWS
-	40.233.0.0/19,Oracle 40.233.64.0/18,Oracle 40.235.64.0/18,AWS 40.235.128.0/17,AWS 40.238.0.0/15,AWS 42.96.128.0/17,Alibaba
-	42.120.0.0/15,Alibaba 42.156.128.0/17,Alibaba 43.90.0.0/15,Alibaba 43.92.0.0/17,Alibaba 43.96.0.0/24,Alibaba 43.96.3.0/24,Alibaba
-	43.96.4.0/22,Alibaba 43.96.8.0/22,Alibaba 43.96.18.0/24,Alibaba 43.96.20.0/23,Alibaba 43.96.23.0/24,Alibaba 43.96.24.0/23,Alibaba
-	43.96.26.0/24,Alibaba 43.96.32.0/22,Alibaba 43.96.40.0/24,Alibaba 43.96.44.0/23,Alibaba 43.96.48.0/21,Alibaba 43.96.59.0/24,Alibaba
-	43.96.60.0/24,Alibaba 43.96.62.0/23,Alibaba 43.96.64.0/21,Alibaba 43.96.72.0/22,Alibaba 43.96.78.0/24,Alibaba 43.96.80.0/23,Alibaba
-	43.96.85.0/24,Alibaba 43.96.86.0/24,Alibaba 43.96.88.0/23,Alibaba 43.96.90.0/24,Alibaba 43.96.100.0/23,Alibaba 43.96.103.0/24,Alibaba
-	43.96.104.0/23,Alibaba 43.96.107.0/24,Alibaba 43.96.109.0/24,Alibaba 43.96.110.0/23,Alibaba 43.96.112.0/22,Alibaba 43.96.116.0/24,Alibaba
-	43.96.118.0/23,Alibaba 43.96.120.0/24,Alibaba 43.96.122.0/24,Alibaba 43.96.124.0/24,Alibaba 43.98.0.0/15,Alibaba 43.100.0.0/15,Alibaba
-	43.102.0.0/17,Alibaba 43.102.192.0/18,Alibaba 43.103.0.0/16,Alibaba 43.104.0.0/14,Alibaba 43.108.0.0/16,Alibaba 43.110.0.0/15,Alibaba
-	43.112.0.0/15,Alibaba 43.114.0.0/16,Alibaba 43.116.0.0/17,Alibaba 43.116.192.0/18,Alibaba 43.117.0.0/16,Alibaba 43.118.0.0/17,Alibaba
-	43.118.128.0/18,Alibaba 43.119.0.0/16,Alibaba 43.120.0.0/15,Alibaba 43.122.0.0/16,Alibaba 43.123.0.0/17,Alibaba 43.123.128.0/18,Alibaba
-	43.124.0.0/16,Alibaba 43.126.0.0/17,Alibaba 43.192.0.0/16,AWS 43.193.0.0/18,AWS 43.193.64.0/22,AWS 43.194.0.0/20,AWS
-	43.194.16.0/24,AWS 43.195.0.0/20,AWS 43.195.16.0/22,AWS 43.195.20.0/23,AWS 43.196.0.0/16,AWS 43.198.0.0/15,AWS
-	43.200.0.0/13,AWS 43.208.0.0/13,AWS 43.216.0.0/15,AWS 43.218.0.0/16,AWS 43.220.0.0/15,AWS 43.226.0.0/23,OVH
-	43.226.27.0/24,AWS 43.249.44.0/22,AWS 43.250.192.0/23,AWS 44.192.0.0/10,AWS 45.15.99.0/24,Hetzner 45.33.0.0/17,Linode
-	45.33.160.0/20,AWS 45.33.176.0/21,AWS 45.33.184.0/22,AWS 45.34.0.0/15,AWS 45.39.79.0/24,OVH 45.43.142.0/24,OVH
-	45.55.0.0/16,DigitalOcean 45.56.64.0/18,Linode 45.57.128.0/18,AWS 45.66.82.0/23,OVH 45.79.0.0/21,Linode 45.79.8.0/22,Linode
-	45.79.13.0/24,Linode 45.79.14.0/23,Linode 45.79.16.0/20,Linode 45.79.32.0/19,Linode 45.79.64.0/19,Linode 45.79.96.0/20,Linode
-	45.79.112.0/22,Linode 45.79.116.0/23,Linode 45.79.118.0/24,Linode 45.79.120.0/21,Linode 45.79.128.0/18,Linode 45.79.192.0/19,Linode
-	45.79.224.0/21,Linode 45.79.234.0/24,Linode 45.79.236.0/22,Linode 45.79.240.0/21,Linode 45.79.248.0/22,Linode 45.79.252.0/23,Linode
-	45.92.60.0/22,OVH 45.94.49.0/24,OVH 45.112.195.0/24,OVH 45.113.40.0/22,Alibaba 45.113.128.0/22,AWS 45.118.132.0/22,Linode
-	45.145.227.0/24,Hetzner 45.149.63.0/24,OVH 45.149.185.0/24,OVH 45.149.243.0/24,OVH 45.152.164.0/24,OVH 45.154.157.0/24,OVH
-	45.158.9.0/24,OVH 45.177.236.0/22,OVH 45.194.56.0/22,Alibaba 45.194.61.0/24,Alibaba 45.194.63.0/24,Alibaba 45.199.179.0/24,Alibaba
-	46.4.0.0/16,Hetzner 46.17.217.0/24,OVH 46.28.236.0/24,OVH 46.51.128.0/18,AWS 46.51.192.0/20,AWS 46.51.208.0/22,AWS
-	46.51.216.0/21,AWS 46.51.224.0/19,AWS 46.62.128.0/17,Hetzner 46.101.0.0/16,DigitalOcean 46.105.0.0/16,OVH 46.137.0.0/16,AWS
-	46.168.0.0/15,AWS 46.202.232.0/22,OVH 46.202.240.0/22,OVH 46.203.108.0/22,OVH 46.203.116.0/22,OVH 46.203.128.0/22,OVH
-	46.203.140.0/22,OVH 46.224.0.0/15,Hetzner 46.236.211.0/24,OVH 46.244.32.0/20,OVH 47.52.0.0/16,Alibaba 47.56.0.0/15,Alibaba
-	47.74.0.0/15,Alibaba 47.76.0.0/16,Alibaba 47.77.0.0/20,Alibaba 47.77.16.0/21,Alibaba 47.77.24.0/22,Alibaba 47.77.32.0/19,Alibaba
-	47.77.64.0/19,Alibaba 47.77.96.0/20,Alibaba 47.77.128.0/17,Alibaba 47.78.0.0/15,Alibaba 47.80.0.0/13,Alibaba 47.88.0.0/16,Alibaba
-	47.89.0.0/18,Alibaba 47.89.72.0/21,Alibaba 47.89.80.0/22,Alibaba 47.89.84.0/24,Alibaba 47.89.88.0/21,Alibaba 47.89.96.0/20,Alibaba
-	47.89.122.0/23,Alibaba 47.89.124.0/23,Alibaba 47.89.128.0/17,Alibaba 47.90.0.0/15,Alibaba 47.92.0.0/14,Alibaba 47.96.0.0/12,Alibaba
-	47.112.0.0/13,Alibaba 47.120.0.0/14,Alibaba 47.128.0.0/14,AWS 47.235.0.0/21,Alibaba 47.235.8.0/22,Alibaba 47.235.12.0/23,Alibaba
-	47.235.16.0/20,Alibaba 47.236.0.0/14,Alibaba 47.240.0.0/14,Alibaba 47.244.0.0/15,Alibaba 47.246.32.0/22,Alibaba 47.246.66.0/23,Alibaba
-	47.246.68.0/23,Alibaba 47.246.72.0/21,Alibaba 47.246.82.0/23,Alibaba 47.246.84.0/22,Alibaba 47.246.88.0/22,Alibaba 47.246.92.0/23,Alibaba
-	47.246.96.0/20,Alibaba 47.246.120.0/24,Alibaba 47.246.122.0/23,Alibaba 47.246.124.0/23,Alibaba 47.246.128.0/20,Alibaba 47.246.144.0/22,Alibaba
-	47.246.150.0/23,Alibaba 47.246.152.0/21,Alibaba 47.246.160.0/19,Alibaba 47.246.192.0/20,Alibaba 47.246.208.0/23,Alibaba 47.250.0.0/15,Alibaba
-	47.252.0.0/15,Alibaba 47.254.0.0/16,Alibaba 48.192.0.0/16,Azure 48.193.0.0/17,Azure 48.194.0.0/15,Azure 48.196.0.0/14,Azure
-	48.200.0.0/13,Azure 48.208.0.0/18,Azure 48.208.64.0/20,Azure 48.208.80.0/21,Azure 48.208.88.0/22,Azure 48.208.92.0/23,Azure
-	48.208.94.0/24,Azure 48.208.128.0/18,Azure 48.208.192.0/20,Azure 48.208.208.0/23,Azure 48.208.211.0/24,Azure 48.208.212.0/24,Azure
-	48.208.214.0/23,Azure 48.208.216.0/21,Azure 48.209.0.0/16,Azure 48.210.0.0/15,Azure 48.212.0.0/19,Azure 48.212.32.0/20,Azure
-	48.212.48.0/21,Azure 48.212.56.0/22,Azure 48.212.128.0/19,Azure 48.212.160.0/20,Azure 48.212.176.0/21,Azure 48.212.184.0/22,Azure
-	48.213.0.0/19,Azure 48.213.32.0/20,Azure 48.213.48.0/21,Azure 48.213.56.0/22,Azure 48.213.128.0/20,Azure 48.214.0.0/15,Azure
-	48.216.0.0/14,Azure 48.220.0.0/17,Azure 48.221.0.0/16,Azure 48.222.0.0/15,Azure 49.12.0.0/15,Hetzner 50.3.38.0/24,OVH
-	50.16.0.0/14,AWS 50.85.0.0/16,Azure 50.112.0.0/16,AWS 50.114.91.0/24,OVH 50.116.0.0/18,Linode 51.0.0.0/20,AWS
-	51.0.16.0/21,AWS 51.0.24.0/22,AWS 51.0.28.0/24,AWS 51.0.29.0/28,AWS 51.0.29.128/28,AWS 51.0.31.0/24,AWS
-	51.0.64.0/18,AWS 51.0.128.0/20,AWS 51.0.250.0/30,AWS 51.0.251.0/24,AWS 51.0.252.0/24,AWS 51.4.0.0/15,Azure
-	51.8.0.0/16,Azure 51.11.0.0/16,Azure 51.12.0.0/18,Azure 51.12.64.0/19,Azure 51.12.96.0/21,Azure 51.12.104.0/26,Azure
-	51.12.112.0/20,Azure 51.12.128.0/17,Azure 51.13.0.0/17,Azure 51.13.128.0/18,Azure 51.16.0.0/15,AWS 51.20.0.0/15,AWS
-	51.24.0.0/16,AWS 51.34.0.0/15,AWS 51.38.0.0/16,OVH 51.44.0.0/14,AWS 51.48.0.0/15,AWS 51.53.0.0/17,Azure
-	51.53.128.0/18,Azure 51.53.192.0/20,Azure 51.56.0.0/15,Azure 51.58.0.0/16,Azure 51.59.0.0/17,Azure 51.68.0.0/16,OVH
-	51.72.0.0/15,AWS 51.74.0.0/20,AWS 51.74.16.0/23,AWS 51.74.128.0/17,AWS 51.75.0.0/16,OVH 51.77.0.0/16,OVH
+	18.34.244.0/22,AWS 18.34.252.0/22,AWS 18.60.0.0/15,AWS 18.64.0.0-18.68.255.255,AWS 18.88.0.0-18.88.191.255,AWS 18.89.0.0/18,AWS
+	18.89.128.0/18,AWS 18.96.0.0-18.102.255.255,AWS 18.116.0.0/14,AWS 18.130.0.0/16,AWS 18.132.0.0-18.136.255.255,AWS 18.138.0.0-18.145.255.255,AWS
+	18.153.0.0-18.173.255.255,AWS 18.175.0.0-18.185.255.255,AWS 18.188.0.0-18.239.255.255,AWS 18.244.0.0-18.246.255.255,AWS 18.252.0.0-18.254.255.255,AWS 20.0.0.0-20.19.255.255,Azure
+	20.20.32.0-20.20.66.245,Azure 20.20.68.0-20.20.68.73,Azure 20.20.72.0-20.20.72.227,Azure 20.20.76.0-20.20.76.179,Azure 20.20.76.184-20.20.80.13,Azure 20.20.80.16-20.20.80.27,Azure
+	20.20.80.30-20.20.80.37,Azure 20.20.80.40-20.20.80.213,Azure 20.20.80.220-20.20.81.75,Azure 20.20.84.0-20.20.85.223,Azure 20.20.88.0-20.20.89.77,Azure 20.20.92.0-20.20.92.49,Azure
+	20.20.92.56-20.20.92.115,Azure 20.20.92.122-20.20.93.125,Azure 20.20.93.132-20.20.93.151,Azure 20.20.93.156-20.20.93.205,Azure 20.20.93.212-20.20.95.243,Azure 20.20.96.0-20.20.97.251,Azure
+	20.20.100.0-20.20.102.59,Azure 20.20.102.68-20.20.104.239,Azure 20.20.105.0-20.20.106.127,Azure 20.20.108.0-20.20.109.173,Azure 20.20.109.182-20.20.110.155,Azure 20.20.110.162-20.20.112.121,Azure
+	20.20.116.0-20.20.116.151,Azure 20.20.120.0-20.20.121.195,Azure 20.20.124.0-20.20.126.125,Azure 20.20.128.0-20.20.145.255,Azure 20.20.190.0-20.29.255.255,Azure 20.31.0.0/16,Azure
+	20.33.0.0/16,Azure 20.36.0.0-20.38.59.255,Azure 20.38.64.0-20.38.191.255,Azure 20.38.196.0-20.38.203.255,Azure 20.38.208.0/22,Azure 20.39.0.0-20.42.161.255,Azure
+	20.42.168.0-20.45.135.255,Azure 20.45.144.0-20.46.127.255,Azure 20.46.144.0-20.48.215.255,Azure 20.48.224.0-20.52.80.95,Azure 20.52.88.0-20.53.32.15,Azure 20.53.40.0-20.95.147.255,Azure
+	20.95.192.0-20.95.205.255,Azure 20.95.208.0/24,Azure 20.95.224.0/23,Azure 20.95.240.0/23,Azure 20.95.248.0/22,Azure 20.95.255.0/29,Azure
+	20.96.0.0-20.111.127.255,Azure 20.111.136.0/21,Azure 20.112.0.0/12,Azure 20.135.0.0-20.135.240.127,Azure 20.135.242.0-20.136.5.255,Azure 20.136.7.0/25,Azure
+	20.136.128.0-20.136.129.127,Azure 20.143.0.0-20.143.227.255,Azure 20.150.0.0-20.152.127.255,Azure 20.153.0.0/16,Azure 20.157.0.0/16,Azure 20.160.0.0/12,Azure
+	20.184.0.0-20.187.199.255,Azure 20.187.224.0-20.195.131.255,Azure 20.195.136.0-20.201.221.255,Azure 20.201.223.0-20.201.231.255,Azure 20.201.240.0-20.201.242.255,Azure 20.202.0.0-20.202.244.255,Azure
+	20.202.246.0-20.202.251.255,Azure 20.203.0.0-20.216.231.255,Azure 20.217.0.0-20.217.35.255,Azure 20.217.40.0-20.217.95.255,Azure 20.217.128.0-20.231.159.255,Azure 20.231.192.0-20.240.95.255,Azure
+	20.240.128.0-20.255.255.255,Azure 23.20.0.0/14,AWS 23.88.0.0/17,Hetzner 23.91.0.0/19,AWS 23.92.16.0/20,Linode 23.92.224.0/19,OVH
+	23.96.0.0/16,Azure 23.97.48.0-23.97.112.143,Azure 23.97.112.160-23.97.112.255,Azure 23.97.116.0-23.97.255.255,Azure 23.98.32.0-23.98.56.255,Azure 23.98.57.64/26,Azure
+	23.98.64.0-23.101.39.255,Azure 23.101.48.0-23.101.87.255,Azure 23.101.112.0-23.102.255.255,Azure 23.103.64.0/24,Azure 23.103.132.0-23.103.132.199,Azure 23.103.132.208/28,Azure
+	23.103.132.240/28,Azure 23.103.133.224-23.103.134.127,Azure 23.103.134.192-23.103.135.63,Azure 23.103.135.128-23.103.135.223,Azure 23.103.136.32-23.103.136.79,Azure 23.103.136.96/27,Azure
+	23.103.136.224-23.103.137.63,Azure 23.103.137.96-23.103.138.47,Azure 23.103.138.96-23.103.138.159,Azure 23.103.138.224/27,Azure 23.103.139.96/27,Azure 23.103.139.130/31,Azure
+	23.103.139.162/31,Azure 23.103.140.192/26,Azure 23.103.141.96/27,Azure 23.103.141.192/26,Azure 23.103.142.32/27,Azure 23.103.156.1-23.103.156.3,Azure
+	23.103.156.33-23.103.156.35,Azure 23.103.156.65-23.103.156.67,Azure 23.103.156.96-23.103.156.223,Azure 23.103.157.1-23.103.157.3,Azure 23.103.157.64-23.103.157.175,Azure 23.103.157.192/26,Azure
+	23.103.198.1-23.103.198.3,Azure 23.103.198.33-23.103.198.35,Azure 23.103.198.128/25,Azure 23.103.212.0/23,Azure 23.136.172.0/24,OVH 23.137.200.0/24,OVH
+	23.151.184.0/24,OVH 23.156.24.0/23,OVH 23.160.0.0/24,AWS 23.228.196.0-23.228.198.255,AWS 23.228.212.0-23.228.214.255,AWS 23.228.219.0-23.228.223.255,AWS
+	23.228.228.0/22,AWS 23.228.244.0/24,AWS 23.228.246.0-23.228.251.255,AWS 23.230.14.0/24,OVH 23.230.93.0/24,OVH 23.234.192.0/18,AWS
+	23.236.48.0/20,GoogleCloud 23.238.128.0/17,AWS 23.239.0.0/19,Linode 23.251.128.0/19,GoogleCloud 23.254.0.0-23.254.39.255,AWS 23.254.120.0/21,AWS
+	24.110.0.0-24.110.9.255,AWS 24.110.16.0-24.110.63.255,AWS 24.110.128.0/17,AWS 24.144.64.0/21,DigitalOcean 24.144.74.0-24.144.127.255,DigitalOcean 24.199.64.0/18,DigitalOcean
+	27.0.0.0/22,AWS 31.6.62.0/24,OVH 31.24.81.0/24,OVH 31.41.37.0/24,OVH 31.56.219.0/24,OVH 31.57.161.0/24,OVH
+	31.57.199.0/24,OVH 31.58.88.0/24,Hetzner 31.59.68.0/24,OVH 31.77.120.0/24,OVH 31.99.205.0/24,OVH 31.220.220.0/22,AWS
+	31.220.235.0-31.220.236.255,AWS 31.220.247.0/24,AWS 31.220.252.0/24,AWS 32.184.0.0-32.207.255.255,AWS 32.232.0.0/16,AWS 32.236.0.0/15,AWS
+	32.240.0.0/15,AWS 34.0.0.0-34.0.227.255,GoogleCloud 34.0.240.0-34.1.63.255,GoogleCloud 34.1.128.0-34.2.63.255,GoogleCloud 34.2.76.0/23,GoogleCloud 34.2.96.0/20,GoogleCloud
+	34.2.128.0/17,GoogleCloud 34.3.32.0/20,GoogleCloud 34.3.76.0-34.3.111.255,GoogleCloud 34.4.16.0/22,GoogleCloud 34.4.24.0-34.4.99.255,GoogleCloud 34.4.102.0-34.4.111.255,GoogleCloud
+	34.4.128.0-34.4.192.255,GoogleCloud 34.6.0.0-34.13.63.255,GoogleCloud 34.13.68.0-34.13.79.255,GoogleCloud 34.13.112.0-34.14.223.255,GoogleCloud 34.15.0.0-34.34.191.255,GoogleCloud 34.34.216.0/21,GoogleCloud
+	34.35.0.0-34.42.255.255,GoogleCloud 34.44.0.0-34.50.127.255,GoogleCloud 34.50.144.0-34.51.255.255,GoogleCloud 34.52.128.0-34.63.255.255,GoogleCloud 34.64.32.0-34.95.255.255,GoogleCloud 34.96.64.0-34.97.255.255,GoogleCloud
+	34.98.64.0-34.98.135.255,GoogleCloud 34.100.128.0/17,GoogleCloud 34.101.18.0/24,GoogleCloud 34.101.20.0-34.101.27.255,GoogleCloud 34.101.32.0-34.102.255.255,GoogleCloud 34.104.27.0/24,GoogleCloud
+	34.104.49.0-34.104.52.255,GoogleCloud 34.104.56.0-34.108.255.255,GoogleCloud 34.110.128.0-34.113.255.255,GoogleCloud 34.116.0.0/21,GoogleCloud 34.116.64.0-34.118.207.255,GoogleCloud 34.118.240.0/20,GoogleCloud
+	34.120.0.0-34.124.63.255,GoogleCloud 34.124.112.0-34.125.255.255,GoogleCloud 34.126.64.0-34.126.223.255,GoogleCloud 34.127.0.0/17,GoogleCloud 34.127.156.0-34.127.175.255,GoogleCloud 34.127.177.0-34.127.180.255,GoogleCloud
+	34.127.184.0/21,GoogleCloud 34.128.4.0/22,GoogleCloud 34.128.32.0-34.128.37.255,GoogleCloud 34.128.42.0-34.128.49.255,GoogleCloud 34.128.52.0/22,GoogleCloud 34.128.58.0-34.128.191.255,GoogleCloud
+	34.128.208.0-34.128.239.255,GoogleCloud 34.129.0.0-34.142.255.255,GoogleCloud 34.143.64.0/21,GoogleCloud 34.143.128.0/17,GoogleCloud 34.144.172.0-34.152.69.255,GoogleCloud 34.152.72.0-34.152.81.255,GoogleCloud
+	34.152.84.0/22,GoogleCloud 34.152.96.0-34.152.98.255,GoogleCloud 34.152.100.0-34.152.127.255,GoogleCloud 34.153.16.0-34.153.33.255,GoogleCloud 34.153.38.0/24,GoogleCloud 34.153.40.0-34.153.55.255,GoogleCloud
+	34.153.58.0/23,GoogleCloud 34.153.62.0/23,GoogleCloud 34.153.128.0-34.153.225.255,GoogleCloud 34.153.230.0/24,GoogleCloud 34.153.232.0-34.153.247.255,GoogleCloud 34.153.250.0-34.157.9.255,GoogleCloud
+	34.157.12.0-34.157.85.255,GoogleCloud 34.157.87.0-34.157.119.255,GoogleCloud 34.157.121.0-34.157.123.127,GoogleCloud 34.157.124.0-34.157.137.255,GoogleCloud 34.157.140.0-34.157.213.255,GoogleCloud 34.157.215.0-34.157.247.255,GoogleCloud
+	34.157.249.0-34.157.255.255,GoogleCloud 34.158.8.0-34.158.247.255,GoogleCloud 34.159.0.0-34.166.255.255,GoogleCloud 34.168.0.0-34.176.255.255,GoogleCloud 34.177.32.0-34.177.37.255,GoogleCloud 34.177.40.0-34.177.55.255,GoogleCloud
+	34.177.64.0-34.177.66.255,GoogleCloud 34.177.68.0-34.177.78.127,GoogleCloud 34.177.79.0-34.177.123.255,GoogleCloud 34.178.0.0-34.180.127.255,GoogleCloud 34.181.0.0-34.183.9.255,GoogleCloud 34.183.12.0-34.183.19.255,GoogleCloud
+	34.183.20.128-34.183.21.255,GoogleCloud 34.183.24.0-34.183.29.255,GoogleCloud 34.183.32.0-34.183.37.255,GoogleCloud 34.183.40.0/21,GoogleCloud 34.183.50.0-34.183.61.255,GoogleCloud 34.183.66.0-34.183.124.255,GoogleCloud
+	34.184.0.0-34.184.9.255,GoogleCloud 34.184.12.0-34.184.19.255,GoogleCloud 34.184.22.0-34.184.27.255,GoogleCloud 34.184.30.0-34.184.35.255,GoogleCloud 34.184.40.0-34.184.59.255,GoogleCloud 34.184.64.0-34.184.123.255,GoogleCloud
+	34.185.64.0-34.187.255.255,GoogleCloud 34.192.0.0/10,AWS 35.18.0.0/15,AWS 35.26.0.0-35.31.255.255,AWS 35.34.96.0-35.34.105.255,AWS 35.42.0.0-35.45.255.255,AWS
+	35.48.0.0/15,AWS 35.50.128.0/21,AWS 35.50.137.0/24,AWS 35.50.139.0/24,AWS 35.50.141.0-35.50.145.255,AWS 35.50.160.0/23,AWS
+	35.50.176.0-35.50.178.255,AWS 35.50.192.0-35.50.197.255,AWS 35.50.208.0-35.50.214.255,AWS 35.50.224.0/24,AWS 35.50.226.0-35.50.242.255,AWS 35.52.0.0/15,AWS
+	35.54.16.0-35.54.62.255,AWS 35.54.64.0/18,AWS 35.55.0.0/17,AWS 35.56.0.0/15,AWS 35.60.0.0/15,AWS 35.71.64.0/20,AWS
+	35.71.92.0-35.71.94.255,AWS 35.71.96.0-35.95.255.255,AWS 35.128.64.0-35.128.191.255,AWS 35.152.0.0-35.183.255.255,AWS 35.184.0.0-35.187.127.255,GoogleCloud 35.187.144.0-35.190.95.255,GoogleCloud
+	35.190.112.0-35.190.239.255,GoogleCloud 35.192.0.0-35.199.127.255,GoogleCloud 35.199.144.0-35.199.191.255,GoogleCloud 35.200.0.0-35.201.31.255,GoogleCloud 35.201.41.0/24,GoogleCloud 35.201.64.0-35.203.191.255,GoogleCloud
+	35.203.210.0-35.203.219.255,GoogleCloud 35.203.232.0/21,GoogleCloud 35.204.0.0/15,GoogleCloud 35.206.10.0/23,GoogleCloud 35.206.32.0-35.217.255.255,GoogleCloud 35.219.0.0-35.219.191.255,GoogleCloud
+	35.219.224.0-35.220.27.255,GoogleCloud 35.220.31.0-35.228.255.255,GoogleCloud 35.229.16.0-35.230.191.255,GoogleCloud 35.230.240.0-35.235.127.255,GoogleCloud 35.235.172.0/22,GoogleCloud 35.235.216.0/21,GoogleCloud
+	35.236.0.0-35.242.27.255,GoogleCloud 35.242.31.0-35.243.15.255,GoogleCloud 35.243.32.0/20,GoogleCloud 35.243.56.0-35.247.255.255,GoogleCloud 35.252.0.0/14,GoogleCloud 36.103.232.0-36.103.232.191,AWS
+	37.27.0.0/16,Hetzner 37.59.0.0/16,OVH 37.60.48.0/20,OVH 37.98.145.0/24,OVH 37.139.0.0/19,DigitalOcean 37.139.130.0/24,OVH
+	37.187.0.0/16,OVH 37.203.157.0/24,AWS 38.47.128.0-38.47.142.255,Alibaba 38.47.144.0-38.47.146.255,Alibaba 38.47.148.0-38.47.150.255,Alibaba 38.47.152.0/21,Alibaba
+	38.104.155.92/31,Oracle 39.96.0.0-39.108.255.255,Alibaba 40.27.29.0/24,OVH 40.32.0.0/16,AWS 40.35.0.0/16,AWS 40.38.0.0/15,AWS
+	40.64.0.0-40.64.135.255,Azure 40.64.144.0-40.64.195.127,Azure 40.65.0.0/16,Azure 40.66.32.0/19,Azure 40.66.120.0/21,Azure 40.67.0.0/19,Azure
+	40.67.40.0-40.67.111.255,Azure 40.67.120.0-40.69.223.255,Azure 40.70.0.0-40.70.88.15,Azure 40.70.128.0-40.71.255.255,Azure 40.74.0.0-40.75.39.255,Azure 40.75.64.0-40.77.137.223,Azure
+	40.77.138.0/23,Azure 40.77.160.0-40.77.166.143,Azure 40.77.166.160-40.77.175.223,Azure 40.77.175.240-40.77.202.255,Azure 40.77.224.0-40.77.236.63,Azure 40.77.236.80-40.77.236.207,Azure
+	40.77.236.224-40.77.237.255,Azure 40.77.240.0-40.77.244.127,Azure 40.77.245.0-40.77.254.95,Azure 40.77.254.128-40.78.208.35,Azure 40.78.208.48-40.78.208.95,Azure 40.78.209.0-40.79.8.47,Azure
+	40.79.8.64-40.79.8.111,Azure 40.79.9.0/24,Azure 40.79.16.0-40.79.48.47,Azure 40.79.49.0/24,Azure 40.79.56.0-40.79.88.47,Azure 40.79.89.0-40.79.91.15,Azure
+	40.79.92.0-40.79.93.15,Azure 40.79.94.0-40.79.95.15,Azure 40.79.96.0-40.79.223.255,Azure 40.79.232.0-40.80.111.255,Azure 40.80.144.0-40.80.161.15,Azure 40.80.168.0-40.81.95.255,Azure
+	40.81.96.212/32,Azure 40.81.112.0-40.81.159.255,Azure 40.81.176.0-40.82.7.255,Azure 40.82.16.0-40.82.39.255,Azure 40.82.44.0-40.82.51.255,Azure 40.82.60.0/22,Azure
+	40.82.80.0/22,Azure 40.82.92.0-40.82.99.255,Azure 40.82.128.0-40.87.168.63,Azure 40.87.168.68-40.87.168.113,Azure 40.87.168.120-40.87.168.203,Azure 40.87.168.208-40.87.168.231,Azure
+	40.87.168.244-40.87.169.91,Azure 40.87.169.98-40.87.169.215,Azure 40.87.169.220-40.87.176.13,Azure 40.87.176.20-40.87.176.77,Azure 40.87.176.84-40.87.176.95,Azure 40.87.176.114-40.87.176.121,Azure
+	40.87.176.134-40.87.176.139,Azure 40.87.176.150-40.87.177.171,Azure 40.87.177.180-40.87.178.47,Azure 40.87.178.52-40.87.178.71,Azure 40.87.178.78-40.87.178.81,Azure 40.87.178.90-40.87.178.119,Azure
+	40.87.178.138-40.87.178.233,Azure 40.87.178.242-40.87.179.1,Azure 40.87.179.10-40.87.179.161,Azure 40.87.179.178-40.87.179.249,Azure 40.87.180.0-40.87.182.127,Azure 40.87.182.136-40.87.183.79,Azure
+	40.87.183.92-40.87.183.107,Azure 40.87.183.120-40.87.239.255,Azure 40.88.0.0/15,Azure 40.90.8.0-40.90.31.255,Azure 40.90.64.0-40.90.68.255,Azure 40.90.70.0/23,Azure
+	40.90.128.0-40.91.7.255,Azure 40.91.12.0-40.91.12.143,Azure 40.91.12.160/27,Azure 40.91.12.208/28,Azure 40.91.12.240-40.91.13.15,Azure 40.91.13.64-40.91.13.111,Azure
+	40.91.13.128/27,Azure 40.91.13.240-40.91.14.255,Azure 40.91.16.0-40.91.35.255,Azure 40.91.64.0/18,Azure 40.91.160.0-40.95.255.255,Azure 40.96.46.0/24,Azure
+	40.96.50.0/24,Azure 40.96.52.0/24,Azure 40.96.55.0/24,Azure 40.96.61.0/24,Azure 40.96.63.0/24,Azure 40.96.255.0/24,Azure
+	40.97.4.0/22,Azure 40.97.12.0/22,Azure 40.97.20.0/22,Azure 40.97.32.0/22,Azure 40.97.44.0/22,Azure 40.97.52.0-40.97.54.127,Azure
+	40.97.55.64-40.97.55.255,Azure 40.97.60.0/26,Azure 40.97.61.0-40.97.62.127,Azure 40.97.63.64-40.97.63.255,Azure 40.97.72.0/26,Azure 40.97.73.0-40.97.73.191,Azure
+	40.98.0.0-40.98.4.63,Azure 40.98.16.0/24,Azure 40.98.18.0-40.98.23.255,Azure 40.100.4.0/22,Azure 40.100.24.0-40.100.27.127,Azure 40.100.27.192/26,Azure
+	40.100.36.0-40.100.37.63,Azure 40.100.38.0-40.100.39.127,Azure 40.100.44.128/26,Azure 40.100.45.64/26,Azure 40.100.58.64-40.100.59.63,Azure 40.100.60.0/26,Azure
+	40.100.61.0-40.100.71.63,Azure 40.100.72.0-40.100.73.191,Azure 40.100.80.0/24,Azure 40.101.0.0/22,Azure 40.101.20.0-40.101.21.191,Azure 40.101.22.0/23,Azure
+	40.101.24.192-40.101.27.255,Azure 40.101.56.0-40.101.59.63,Azure 40.101.112.0-40.101.115.191,Azure 40.101.116.0-40.101.116.191,Azure 40.102.16.0-40.102.18.63,Azure 40.102.24.0-40.102.24.191,Azure
+	40.107.0.0/16,Azure 40.112.0.0/19,Azure 40.112.36.0/23,Azure 40.112.38.192/26,Azure 40.112.48.0-40.119.100.79,Azure 40.119.100.84-40.119.100.95,Azure
+	40.119.100.100-40.119.100.121,Azure 40.119.100.124-40.119.100.131,Azure 40.119.100.134-40.119.100.141,Azure 40.119.100.144-40.119.100.191,Azure 40.119.104.0-40.120.128.35,Azure 40.120.128.40-40.120.128.51,Azure
+	40.120.128.56/29,Azure 40.120.128.68/30,Azure 40.120.128.76-40.120.128.173,Azure 40.120.132.0-40.120.135.81,Azure 40.120.136.0-40.120.141.223,Azure 40.120.144.0-40.120.146.95,Azure
+	40.120.148.0-40.120.159.79,Azure 40.120.159.84-40.120.167.103,Azure 40.120.167.106-40.120.170.45,Azure 40.120.172.0-40.120.175.29,Azure 40.120.176.0-40.120.177.87,Azure 40.120.177.94-40.120.179.39,Azure
+	40.120.180.0-40.120.180.43,Azure 40.120.180.52-40.120.183.215,Azure 40.120.184.0-40.120.186.135,Azure 40.120.188.0/22,Azure 40.121.0.0-40.123.136.255,Azure 40.123.138.0-40.123.138.203,Azure
+	40.123.140.0-40.123.146.11,Azure 40.123.146.14-40.123.146.25,Azure 40.123.146.30-40.123.146.55,Azure 40.123.146.62-40.123.146.123,Azure 40.123.146.128-40.123.146.161,Azure 40.123.146.166-40.123.147.9,Azure
+	40.123.147.14-40.123.149.163,Azure 40.123.152.0-40.123.164.165,Azure 40.123.164.174-40.123.164.179,Azure 40.123.164.190-40.123.164.193,Azure 40.123.164.208/30,Azure 40.123.164.216-40.123.164.227,Azure
+	40.123.164.232/31,Azure 40.123.164.246-40.123.165.7,Azure 40.123.165.12-40.123.165.45,Azure 40.123.165.54-40.123.165.77,Azure 40.123.165.82-40.123.165.107,Azure 40.123.165.112-40.123.165.163,Azure
+	40.123.165.166-40.123.165.169,Azure 40.123.165.172/31,Azure 40.123.165.184/31,Azure 40.123.165.188/31,Azure 40.123.165.194/31,Azure 40.123.165.202/31,Azure
+	40.123.165.206/31,Azure 40.123.165.226/31,Azure 40.123.165.232/31,Azure 40.123.165.240/30,Azure 40.123.165.250/31,Azure 40.123.165.254/31,Azure
+	40.123.166.8/31,Azure 40.123.166.16/31,Azure 40.123.166.24/31,Azure 40.123.166.30-40.123.166.37,Azure 40.123.166.40/30,Azure 40.123.166.58/31,Azure
+	40.123.166.70/31,Azure 40.123.166.78-40.123.166.81,Azure 40.123.166.84/31,Azure 40.123.166.104-40.123.167.129,Azure 40.123.167.134-40.123.167.137,Azure 40.123.167.142-40.123.167.225,Azure
+	40.123.167.234-40.123.168.195,Azure 40.123.168.202/31,Azure 40.123.168.210-40.123.168.219,Azure 40.123.168.226-40.123.169.37,Azure 40.123.169.44/31,Azure 40.123.169.52/31,Azure
+	40.123.169.60-40.123.169.115,Azure 40.123.169.120-40.123.169.151,Azure 40.123.169.160/29,Azure 40.123.169.180-40.123.170.93,Azure 40.123.170.110-40.123.170.129,Azure 40.123.170.136-40.123.171.87,Azure
+	40.123.171.92-40.123.171.111,Azure 40.123.171.116-40.123.171.143,Azure 40.123.171.150-40.123.171.207,Azure 40.123.171.212-40.123.171.227,Azure 40.123.171.232/29,Azure 40.123.171.244-40.123.184.35,Azure
+	40.123.184.38/31,Azure 40.123.184.42-40.123.184.57,Azure 40.123.184.60/31,Azure 40.123.184.64-40.123.184.105,Azure 40.123.184.108-40.123.184.117,Azure 40.123.184.120/31,Azure
+	40.123.184.126-40.123.184.151,Azure 40.123.184.154-40.123.184.193,Azure 40.123.184.198-40.123.184.207,Azure 40.123.184.216-40.123.185.39,Azure 40.123.185.48/31,Azure 40.123.185.52-40.123.185.135,Azure
+	40.123.185.138/31,Azure 40.123.185.144-40.123.185.169,Azure 40.123.185.172-40.123.186.77,Azure 40.123.186.80-40.123.186.93,Azure 40.123.186.96/30,Azure 40.123.186.102-40.123.186.107,Azure
+	40.123.186.110-40.123.186.121,Azure 40.123.186.124/30,Azure 40.123.186.132-40.123.186.137,Azure 40.123.186.140/31,Azure 40.123.186.144/30,Azure 40.123.186.150/31,Azure
+	40.123.186.154-40.123.186.165,Azure 40.123.186.168-40.123.186.173,Azure 40.123.186.178-40.123.186.181,Azure 40.123.186.186-40.123.186.193,Azure 40.123.186.196-40.123.186.201,Azure 40.123.186.206-40.123.186.209,Azure
+	40.123.186.212-40.123.186.221,Azure 40.123.186.224/29,Azure 40.123.186.234-40.123.186.241,Azure 40.123.186.244/31,Azure 40.123.186.252/31,Azure 40.123.187.0/31,Azure
+	40.123.187.8/30,Azure 40.123.187.14-40.123.187.17,Azure 40.123.187.22-40.123.187.47,Azure 40.123.187.52-40.123.187.59,Azure 40.123.187.62-40.123.187.65,Azure 40.123.187.70-40.123.187.73,Azure
+	40.123.187.78-40.123.187.85,Azure 40.123.187.88/29,Azure 40.123.187.100/30,Azure 40.123.187.106/31,Azure 40.123.187.110/31,Azure 40.123.187.114/31,Azure
+	40.123.187.118-40.123.187.127,Azure 40.123.187.134/31,Azure 40.123.187.138-40.123.187.151,Azure 40.123.187.154-40.123.187.203,Azure 40.123.187.212-40.125.127.255,Azure 40.126.0.0/18,Azure
+	40.126.128.0-40.127.32.255,Azure 40.127.64.0-40.127.111.255,Azure 40.127.128.0/17,Azure 40.138.216.0/22,AWS 40.143.64.0/21,AWS 40.160.0.0-40.160.159.255,OVH
+	40.160.224.0-40.160.228.255,OVH 40.160.230.0-40.160.242.255,OVH 40.160.244.0/24,OVH 40.160.246.0/24,OVH 40.160.248.0/24,OVH 40.160.250.0/24,OVH
+	40.160.252.0/24,OVH 40.160.254.0/24,OVH 40.164.0.0/14,AWS 40.168.224.0/21,AWS 40.168.255.0/24,AWS 40.172.0.0-40.181.255.255,AWS
+	40.186.0.0-40.188.255.255,AWS 40.192.0.0/14,AWS 40.201.0.0/16,AWS 40.233.0.0/19,Oracle 40.233.64.0/18,Oracle 40.235.64.0-40.235.255.255,AWS
+	40.238.0.0/15,AWS 42.96.128.0/17,Alibaba 42.120.0.0/15,Alibaba 42.156.128.0/17,Alibaba 43.90.0.0-43.92.127.255,Alibaba 43.96.0.0/24,Alibaba
+	43.96.3.0-43.96.11.255,Alibaba 43.96.18.0/24,Alibaba 43.96.20.0/23,Alibaba 43.96.23.0-43.96.26.255,Alibaba 43.96.32.0/22,Alibaba 43.96.40.0/24,Alibaba
+	43.96.44.0/23,Alibaba 43.96.48.0/21,Alibaba 43.96.59.0-43.96.60.255,Alibaba 43.96.62.0-43.96.75.255,Alibaba 43.96.78.0/24,Alibaba 43.96.80.0/23,Alibaba
+	43.96.85.0-43.96.86.255,Alibaba 43.96.88.0-43.96.90.255,Alibaba 43.96.100.0/23,Alibaba 43.96.103.0-43.96.105.255,Alibaba 43.96.107.0/24,Alibaba 43.96.109.0-43.96.116.255,Alibaba
+	43.96.118.0-43.96.120.255,Alibaba 43.96.122.0/24,Alibaba 43.96.124.0/24,Alibaba 43.98.0.0-43.102.127.255,Alibaba 43.102.192.0-43.108.255.255,Alibaba 43.110.0.0-43.114.255.255,Alibaba
+	43.116.0.0/17,Alibaba 43.116.192.0-43.118.191.255,Alibaba 43.119.0.0-43.123.191.255,Alibaba 43.124.0.0/16,Alibaba 43.126.0.0/17,Alibaba 43.192.0.0-43.193.67.255,AWS
+	43.194.0.0-43.194.16.255,AWS 43.195.0.0-43.195.21.255,AWS 43.196.0.0/16,AWS 43.198.0.0-43.218.255.255,AWS 43.220.0.0/15,AWS 43.226.0.0/23,OVH
+	43.226.27.0/24,AWS 43.249.44.0/22,AWS 43.250.192.0/23,AWS 44.192.0.0/10,AWS 45.15.99.0/24,Hetzner 45.32.0.0/16,Vultr
+	45.33.0.0/17,Linode 45.33.160.0-45.33.187.255,AWS 45.34.0.0/15,AWS 45.39.79.0/24,OVH 45.43.142.0/24,OVH 45.55.0.0/16,DigitalOcean
+	45.56.64.0/18,Linode 45.57.128.0/18,AWS 45.63.0.0/17,Vultr 45.66.82.0/23,OVH 45.76.0.0/15,Vultr 45.79.0.0-45.79.11.255,Linode
+	45.79.13.0-45.79.118.255,Linode 45.79.120.0-45.79.231.255,Linode 45.79.234.0/24,Linode 45.79.236.0-45.79.253.255,Linode 45.92.60.0/22,OVH 45.94.49.0/24,OVH
+	45.112.195.0/24,OVH 45.113.40.0/22,Alibaba 45.113.128.0/22,AWS 45.118.132.0/22,Linode 45.145.227.0/24,Hetzner 45.149.63.0/24,OVH
+	45.149.185.0/24,OVH 45.149.243.0/24,OVH 45.152.164.0/24,OVH 45.154.157.0/24,OVH 45.158.9.0/24,OVH 45.177.236.0/22,OVH
+	45.194.56.0/22,Alibaba 45.194.61.0/24,Alibaba 45.194.63.0/24,Alibaba 45.199.179.0/24,Alibaba 46.4.0.0/16,Hetzner 46.17.217.0/24,OVH
+	46.28.236.0/24,OVH 46.51.128.0-46.51.211.255,AWS 46.51.216.0-46.51.255.255,AWS 46.62.128.0/17,Hetzner 46.101.0.0/16,DigitalOcean 46.105.0.0/16,OVH
+	46.137.0.0/16,AWS 46.168.0.0/15,AWS 46.202.232.0/22,OVH 46.202.240.0/22,OVH 46.203.108.0/22,OVH 46.203.116.0/22,OVH
+	46.203.128.0/22,OVH 46.203.140.0/22,OVH 46.224.0.0/15,Hetzner 46.236.211.0/24,OVH 46.244.32.0/20,OVH 47.52.0.0/16,Alibaba
+	47.56.0.0/15,Alibaba 47.74.0.0-47.77.27.255,Alibaba 47.77.32.0-47.77.111.255,Alibaba 47.77.128.0-47.89.63.255,Alibaba 47.89.72.0-47.89.84.255,Alibaba 47.89.88.0-47.89.111.255,Alibaba
+	47.89.122.0-47.89.125.255,Alibaba 47.89.128.0-47.123.255.255,Alibaba 47.128.0.0/14,AWS 47.235.0.0-47.235.13.255,Alibaba 47.235.16.0/20,Alibaba 47.236.0.0-47.245.255.255,Alibaba
+	47.246.32.0/22,Alibaba 47.246.66.0-47.246.69.255,Alibaba 47.246.72.0/21,Alibaba 47.246.82.0-47.246.93.255,Alibaba 47.246.96.0/20,Alibaba 47.246.120.0/24,Alibaba
+	47.246.122.0-47.246.125.255,Alibaba 47.246.128.0-47.246.147.255,Alibaba 47.246.150.0-47.246.209.255,Alibaba 47.250.0.0-47.254.255.255,Alibaba 48.192.0.0-48.193.127.255,Azure 48.194.0.0-48.208.94.255,Azure
+	48.208.128.0-48.208.209.255,Azure 48.208.211.0-48.208.212.255,Azure 48.208.214.0-48.208.223.255,Azure 48.209.0.0-48.212.59.255,Azure 48.212.128.0-48.212.187.255,Azure 48.213.0.0-48.213.59.255,Azure
+	48.213.128.0/20,Azure 48.214.0.0-48.220.127.255,Azure 48.221.0.0-48.223.255.255,Azure 49.12.0.0/15,Hetzner 49.51.0.0/16,TencentCloud 50.3.38.0/24,OVH
+	50.16.0.0/14,AWS 50.22.0.0/15,IBMCloud 50.85.0.0/16,Azure 50.112.0.0/16,AWS 50.114.91.0/24,OVH 50.116.0.0/18,Linode
+	51.0.0.0-51.0.29.15,AWS 51.0.29.128/28,AWS 51.0.31.0/24,AWS 51.0.64.0-51.0.143.255,AWS 51.0.250.0/30,AWS 51.0.251.0-51.0.252.255,AWS
+	51.4.0.0/15,Azure 51.8.0.0/16,Azure 51.11.0.0-51.12.104.63,Azure 51.12.112.0-51.13.191.255,Azure 51.15.0.0/16,Scaleway 51.16.0.0/15,AWS
+	51.20.0.0/15,AWS 51.24.0.0/16,AWS 51.34.0.0/15,AWS 51.38.0.0/16,OVH 51.44.0.0-51.49.255.255,AWS 51.53.0.0-51.53.207.255,Azure
+	51.56.0.0-51.59.127.255,Azure 51.68.0.0/16,OVH 51.72.0.0-51.74.17.255,AWS 51.74.128.0/17,AWS 51.75.0.0/16,OVH 51.77.0.0/16,OVH
 	51.79.0.0/16,OVH 51.81.0.0/16,OVH 51.83.0.0/16,OVH 51.84.0.0/14,AWS 51.89.0.0/16,OVH 51.91.0.0/16,OVH
-	51.92.0.0/14,AWS 51.96.0.0/16,AWS 51.100.0.0/15,AWS 51.102.0.0/16,AWS 51.103.0.0/17,Azure 51.103.128.0/18,Azure
-	51.103.192.0/26,Azure 51.103.200.0/21,Azure 51.103.208.0/20,Azure 51.103.224.0/19,Azure 51.104.0.0/15,Azure 51.107.0.0/19,Azure
-	51.107.40.0/21,Azure 51.107.48.0/20,Azure 51.107.64.0/18,Azure 51.107.128.0/17,Azure 51.112.0.0/16,AWS 51.116.0.0/16,Azure
-	51.118.0.0/16,AWS 51.120.0.0/19,Azure 51.120.40.0/21,Azure 51.120.48.0/20,Azure 51.120.64.0/18,Azure 51.120.128.0/17,Azure
-	51.124.0.0/16,Azure 51.132.0.0/16,Azure 51.136.0.0/15,Azure 51.138.0.0/17,Azure 51.138.128.0/19,Azure 51.138.160.0/21,Azure
-	51.138.176.0/20,Azure 51.138.192.0/19,Azure 51.138.224.0/20,Azure 51.140.0.0/16,Azure 51.141.0.0/17,Azure 51.141.128.0/24,Azure
-	51.141.129.64/26,Azure 51.141.129.128/26,Azure 51.141.130.0/25,Azure 51.141.134.0/23,Azure 51.141.136.0/22,Azure 51.141.160.0/19,Azure
-	51.141.192.0/18,Azure 51.142.0.0/19,Azure 51.142.48.0/21,Azure 51.142.64.0/18,Azure 51.142.128.0/17,Azure 51.143.0.0/16,Azure
-	51.144.0.0/15,Azure 51.161.0.0/16,OVH 51.164.0.0/14,AWS 51.168.0.0/15,AWS 51.170.32.0/19,Oracle 51.170.80.0/20,Oracle
-	51.170.128.0/20,Oracle 51.170.152.0/21,Oracle 51.172.0.0/15,AWS 51.178.0.0/16,OVH 51.195.0.0/16,OVH 51.200.0.0/13,AWS
-	51.210.0.0/16,OVH 51.222.0.0/16,OVH 51.224.0.0/15,AWS 51.241.1.0/24,OVH 51.254.0.0/15,OVH 52.0.0.0/11,AWS
-	52.32.0.0/13,AWS 52.40.0.0/14,AWS 52.44.0.0/15,AWS 52.46.0.0/17,AWS 52.46.128.0/19,AWS 52.46.164.0/22,AWS
-	52.46.168.0/21,AWS 52.46.176.0/21,AWS 52.46.184.0/22,AWS 52.46.192.0/19,AWS 52.46.224.0/20,AWS 52.46.240.0/22,AWS
-	52.46.249.0/24,AWS 52.46.250.0/23,AWS 52.46.252.0/22,AWS 52.47.0.0/16,AWS 52.48.0.0/12,AWS 52.64.0.0/12,AWS
-	52.80.0.0/15,AWS 52.82.0.0/17,AWS 52.82.128.0/19,AWS 52.82.160.0/21,AWS 52.82.168.0/24,AWS 52.82.169.0/27,AWS
-	52.82.170.0/23,AWS 52.82.176.0/21,AWS 52.82.184.0/23,AWS 52.82.187.0/24,AWS 52.82.188.0/22,AWS 52.82.192.0/18,AWS
-	52.83.0.0/16,AWS 52.84.0.0/14,AWS 52.88.0.0/14,AWS 52.92.0.0/16,AWS 52.93.0.0/21,AWS 52.93.8.0/22,AWS
-	52.93.12.0/24,AWS 52.93.14.0/24,AWS 52.93.16.0/22,AWS 52.93.20.0/23,AWS 52.93.22.48/28,AWS 52.93.22.64/29,AWS
-	52.93.23.0/24,AWS 52.93.24.0/21,AWS 52.93.32.176/32,AWS 52.93.32.179/32,AWS 52.93.32.180/32,AWS 52.93.32.183/32,AWS
-	52.93.32.184/32,AWS 52.93.33.8/30,AWS 52.93.33.224/31,AWS 52.93.33.230/31,AWS 52.93.34.0/23,AWS 52.93.36.0/22,AWS
-	52.93.40.0/22,AWS 52.93.44.0/23,AWS 52.93.47.0/24,AWS 52.93.48.0/22,AWS 52.93.52.160/29,AWS 52.93.53.0/29,AWS
-	52.93.55.144/30,AWS 52.93.55.148/31,AWS 52.93.55.152/29,AWS 52.93.55.160/29,AWS 52.93.56.0/21,AWS 52.93.64.0/22,AWS
-	52.93.68.0/23,AWS 52.93.70.40/29,AWS 52.93.70.128/25,AWS 52.93.71.37/32,AWS 52.93.71.38/31,AWS 52.93.71.40/29,AWS
-	52.93.72.0/21,AWS 52.93.80.0/22,AWS 52.93.84.160/29,AWS 52.93.84.192/29,AWS 52.93.86.160/29,AWS 52.93.86.192/29,AWS
-	52.93.87.96/27,AWS 52.93.88.160/29,AWS 52.93.88.192/29,AWS 52.93.90.160/29,AWS 52.93.90.192/29,AWS 52.93.91.96/28,AWS
-	52.93.91.112/30,AWS 52.93.92.0/22,AWS 52.93.96.0/22,AWS 52.93.100.0/23,AWS 52.93.111.0/24,AWS 52.93.112.0/23,AWS
-	52.93.115.0/24,AWS 52.93.116.0/24,AWS 52.93.119.144/30,AWS 52.93.120.176/30,AWS 52.93.121.187/32,AWS 52.93.121.188/31,AWS
-	52.93.121.190/32,AWS 52.93.121.195/32,AWS 52.93.121.196/31,AWS 52.93.121.198/32,AWS 52.93.122.131/32,AWS 52.93.122.202/31,AWS
-	52.93.122.218/32,AWS 52.93.122.255/32,AWS 52.93.123.6/32,AWS 52.93.123.11/32,AWS 52.93.123.98/31,AWS 52.93.123.136/32,AWS
-	52.93.123.255/32,AWS 52.93.124.14/31,AWS 52.93.124.96/31,AWS 52.93.124.210/31,AWS 52.93.124.212/31,AWS 52.93.125.42/31,AWS
-	52.93.126.76/32,AWS 52.93.126.122/31,AWS 52.93.126.130/31,AWS 52.93.126.132/30,AWS 52.93.126.136/30,AWS 52.93.126.144/30,AWS
-	52.93.126.198/31,AWS 52.93.126.204/30,AWS 52.93.126.212/30,AWS 52.93.126.234/31,AWS 52.93.126.244/31,AWS 52.93.126.250/31,AWS
-	52.93.127.17/32,AWS 52.93.127.18/31,AWS 52.93.127.24/30,AWS 52.93.127.68/30,AWS 52.93.127.92/30,AWS 52.93.127.96/27,AWS
-	52.93.127.128/30,AWS 52.93.127.132/31,AWS 52.93.127.138/31,AWS 52.93.127.146/31,AWS 52.93.127.148/31,AWS 52.93.127.152/29,AWS
-	52.93.127.160/29,AWS 52.93.127.168/31,AWS 52.93.127.172/30,AWS 52.93.127.176/29,AWS 52.93.127.184/31,AWS 52.93.127.194/31,AWS
-	52.93.127.196/30,AWS 52.93.127.200/29,AWS 52.93.127.216/30,AWS 52.93.127.220/31,AWS 52.93.127.232/32,AWS 52.93.127.237/32,AWS
-	52.93.127.238/31,AWS 52.93.127.244/30,AWS 52.93.127.248/29,AWS 52.93.129.95/32,AWS 52.93.131.217/32,AWS 52.93.133.127/32,AWS
-	52.93.133.129/32,AWS 52.93.133.131/32,AWS 52.93.133.133/32,AWS 52.93.133.153/32,AWS 52.93.133.155/32,AWS 52.93.133.175/32,AWS
-	52.93.133.177/32,AWS 52.93.133.179/32,AWS 52.93.133.181/32,AWS 52.93.134.181/32,AWS 52.93.135.195/32,AWS 52.93.136.0/22,AWS
-	52.93.140.0/24,AWS 52.93.141.128/25,AWS 52.93.146.0/23,AWS 52.93.148.0/25,AWS 52.93.148.128/26,AWS 52.93.149.0/24,AWS
-	52.93.150.0/23,AWS 52.93.152.160/29,AWS 52.93.152.192/29,AWS 52.93.153.64/29,AWS 52.93.153.80/32,AWS 52.93.153.96/29,AWS
-	52.93.153.128/29,AWS 52.93.153.148/31,AWS 52.93.153.168/29,AWS 52.93.153.176/30,AWS 52.93.156.0/22,AWS 52.93.178.128/26,AWS
-	52.93.178.192/27,AWS 52.93.178.224/29,AWS 52.93.178.232/30,AWS 52.93.182.128/26,AWS 52.93.183.64/27,AWS 52.93.193.192/29,AWS
-	52.93.193.200/30,AWS 52.93.198.0/25,AWS 52.93.199.24/29,AWS 52.93.199.32/28,AWS 52.93.199.88/29,AWS 52.93.199.96/28,AWS
-	52.93.201.80/28,AWS 52.93.201.96/28,AWS 52.93.228.160/29,AWS 52.93.228.192/29,AWS 52.93.229.64/29,AWS 52.93.229.96/29,AWS
-	52.93.229.128/29,AWS 52.93.229.148/31,AWS 52.93.236.0/22,AWS 52.93.240.0/22,AWS 52.93.244.0/23,AWS 52.93.246.216/29,AWS
-	52.93.247.0/25,AWS 52.93.248.0/22,AWS 52.93.254.0/23,AWS 52.94.0.0/20,AWS 52.94.16.0/22,AWS 52.94.20.0/24,AWS
-	52.94.22.0/23,AWS 52.94.24.0/22,AWS 52.94.28.0/23,AWS 52.94.30.0/24,AWS 52.94.32.0/19,AWS 52.94.64.0/22,AWS
-	52.94.68.0/23,AWS 52.94.72.0/21,AWS 52.94.80.0/20,AWS 52.94.96.0/19,AWS 52.94.128.0/20,AWS 52.94.144.0/23,AWS
-	52.94.146.0/24,AWS 52.94.148.0/22,AWS 52.94.152.3/32,AWS 52.94.152.9/32,AWS 52.94.152.11/32,AWS 52.94.152.12/32,AWS
-	52.94.152.44/32,AWS 52.94.152.60/30,AWS 52.94.152.64/30,AWS 52.94.152.68/31,AWS 52.94.152.176/28,AWS 52.94.152.192/30,AWS
-	52.94.160.0/19,AWS 52.94.192.0/22,AWS 52.94.196.0/23,AWS 52.94.198.0/25,AWS 52.94.198.128/27,AWS 52.94.199.0/24,AWS
-	52.94.200.0/24,AWS 52.94.201.0/25,AWS 52.94.204.0/22,AWS 52.94.208.0/20,AWS 52.94.224.0/20,AWS 52.94.240.0/21,AWS
-	52.94.248.0/25,AWS 52.94.248.128/26,AWS 52.94.248.192/27,AWS 52.94.248.224/28,AWS 52.94.249.32/27,AWS 52.94.249.64/26,AWS
-	52.94.249.128/25,AWS 52.94.250.0/26,AWS 52.94.250.80/28,AWS 52.94.250.96/27,AWS 52.94.250.128/26,AWS 52.94.250.192/28,AWS
-	52.94.252.0/22,AWS 52.95.0.0/20,AWS 52.95.16.0/21,AWS 52.95.24.0/22,AWS 52.95.28.0/24,AWS 52.95.29.0/26,AWS
-	52.95.30.0/23,AWS 52.95.34.0/23,AWS 52.95.36.0/22,AWS 52.95.40.0/23,AWS 52.95.42.0/24,AWS 52.95.48.0/20,AWS
-	52.95.64.0/18,AWS 52.95.128.0/18,AWS 52.95.192.0/20,AWS 52.95.208.0/21,AWS 52.95.216.0/22,AWS 52.95.224.0/22,AWS
-	52.95.228.0/23,AWS 52.95.230.0/24,AWS 52.95.235.0/24,AWS 52.95.239.0/24,AWS 52.95.240.0/21,AWS 52.95.248.0/22,AWS
-	52.95.252.0/23,AWS 52.95.254.0/24,AWS 52.95.255.0/25,AWS 52.95.255.128/28,AWS 52.96.11.0/24,Azure 52.100.0.0/14,Azure
-	52.106.0.0/20,Azure 52.106.16.0/23,Azure 52.106.64.0/23,Azure 52.106.66.0/24,Azure 52.106.120.0/23,Azure 52.106.122.32/27,Azure
-	52.106.122.64/26,Azure 52.106.122.128/27,Azure 52.106.128.0/21,Azure 52.106.136.0/22,Azure 52.106.184.0/22,Azure 52.106.188.0/26,Azure
-	52.106.189.0/24,Azure 52.106.191.0/24,Azure 52.106.192.0/22,Azure 52.106.216.0/23,Azure 52.106.218.0/25,Azure 52.106.218.128/26,Azure
-	52.108.0.0/21,Azure 52.108.16.0/20,Azure 52.108.32.0/19,Azure 52.108.68.0/22,Azure 52.108.72.0/21,Azure 52.108.80.0/20,Azure
-	52.108.96.0/20,Azure 52.108.112.0/23,Azure 52.108.115.0/24,Azure 52.108.116.0/23,Azure 52.108.118.0/24,Azure 52.108.121.0/24,Azure
-	52.108.122.0/23,Azure 52.108.124.0/22,Azure 52.108.128.0/21,Azure 52.108.136.0/22,Azure 52.108.144.0/21,Azure 52.108.152.0/22,Azure
-	52.108.156.0/24,Azure 52.108.165.0/24,Azure 52.108.166.0/23,Azure 52.108.168.0/21,Azure 52.108.176.0/20,Azure 52.108.192.0/18,Azure
-	52.109.0.0/19,Azure 52.109.32.0/22,Azure 52.109.44.0/22,Azure 52.109.48.0/20,Azure 52.109.64.0/18,Azure 52.109.128.0/19,Azure
-	52.109.160.0/20,Azure 52.109.176.0/24,Azure 52.111.192.0/20,Azure 52.111.208.0/22,Azure 52.111.224.0/19,Azure 52.112.0.0/22,Azure
-	52.112.4.0/23,Azure 52.112.6.0/24,Azure 52.112.8.0/21,Azure 52.112.16.0/24,Azure 52.112.22.0/23,Azure 52.112.32.0/24,Azure
-	52.112.34.0/23,Azure 52.112.37.0/24,Azure 52.112.38.0/23,Azure 52.112.40.0/21,Azure 52.112.48.0/22,Azure 52.112.53.0/24,Azure
-	52.112.54.0/23,Azure 52.112.56.0/21,Azure 52.112.65.0/24,Azure 52.112.66.0/24,Azure 52.112.68.0/23,Azure 52.112.70.0/24,Azure
-	52.112.72.0/21,Azure 52.112.84.0/22,Azure 52.112.88.0/21,Azure 52.112.96.0/24,Azure 52.112.100.0/22,Azure 52.112.105.0/24,Azure
-	52.112.106.0/23,Azure 52.112.108.0/23,Azure 52.112.112.0/21,Azure 52.112.120.0/24,Azure 52.112.122.0/23,Azure 52.112.124.0/22,Azure
-	52.112.128.0/18,Azure 52.112.192.0/19,Azure 52.112.224.0/22,Azure 52.112.228.0/23,Azure 52.112.231.0/24,Azure 52.112.232.0/21,Azure
-	52.112.240.0/20,Azure 52.113.0.0/21,Azure 52.113.8.0/22,Azure 52.113.12.0/24,Azure 52.113.14.0/24,Azure 52.113.16.0/20,Azure
-	52.113.32.0/19,Azure 52.113.64.0/19,Azure 52.113.96.0/22,Azure 52.113.101.0/24,Azure 52.113.102.0/23,Azure 52.113.106.0/23,Azure
-	52.113.108.0/22,Azure 52.113.112.0/20,Azure 52.113.128.0/23,Azure 52.113.131.0/24,Azure 52.113.132.0/22,Azure 52.113.136.0/21,Azure
-	52.113.144.0/20,Azure 52.113.160.0/19,Azure 52.113.192.0/23,Azure 52.113.198.0/23,Azure 52.113.200.0/21,Azure 52.113.208.0/20,Azure
-	52.113.224.0/19,Azure 52.114.0.0/19,Azure 52.114.32.0/20,Azure 52.114.48.0/21,Azure 52.114.56.0/22,Azure 52.114.61.0/24,Azure
-	52.114.62.0/23,Azure 52.114.65.0/24,Azure 52.114.67.0/24,Azure 52.114.69.0/24,Azure 52.114.71.0/24,Azure 52.114.72.0/21,Azure
-	52.114.80.0/20,Azure 52.114.96.0/22,Azure 52.114.100.0/24,Azure 52.114.104.0/21,Azure 52.114.112.0/22,Azure 52.114.120.0/24,Azure
-	52.114.122.0/24,Azure 52.114.124.0/24,Azure 52.114.126.0/24,Azure 52.114.128.0/19,Azure 52.114.160.0/20,Azure 52.114.177.0/24,Azure
-	52.114.179.0/24,Azure 52.114.180.0/22,Azure 52.114.184.0/22,Azure 52.114.189.0/24,Azure 52.114.191.0/24,Azure 52.114.192.0/19,Azure
-	52.114.224.0/22,Azure 52.114.229.0/24,Azure 52.114.230.0/23,Azure 52.114.232.0/21,Azure 52.114.240.0/22,Azure 52.114.244.0/23,Azure
-	52.114.247.0/24,Azure 52.114.248.0/21,Azure 52.115.0.0/21,Azure 52.115.8.0/22,Azure 52.115.12.0/23,Azure 52.115.15.0/24,Azure
-	52.115.17.0/24,Azure 52.115.19.0/24,Azure 52.115.20.0/22,Azure 52.115.24.0/21,Azure 52.115.32.0/20,Azure 52.115.49.0/24,Azure
-	52.115.50.0/23,Azure 52.115.52.0/23,Azure 52.115.55.0/24,Azure 52.115.56.0/24,Azure 52.115.58.0/23,Azure 52.115.60.0/22,Azure
-	52.115.65.0/24,Azure 52.115.66.0/23,Azure 52.115.68.0/22,Azure 52.115.72.0/21,Azure 52.115.80.0/21,Azure 52.115.88.0/22,Azure
-	52.115.92.0/23,Azure 52.115.94.0/24,Azure 52.115.96.0/20,Azure 52.115.112.0/22,Azure 52.115.116.0/23,Azure 52.115.119.0/24,Azure
-	52.115.121.0/24,Azure 52.115.123.0/24,Azure 52.115.125.0/24,Azure 52.115.127.0/24,Azure 52.115.128.0/18,Azure 52.115.192.0/19,Azure
-	52.115.224.0/20,Azure 52.115.240.0/21,Azure 52.119.128.0/18,AWS 52.119.192.0/21,AWS 52.119.205.0/24,AWS 52.119.206.0/23,AWS
-	52.119.208.0/20,AWS 52.119.224.0/20,AWS 52.119.240.0/21,AWS 52.119.248.0/23,AWS 52.119.252.0/22,AWS 52.120.0.0/17,Azure
-	52.120.128.0/18,Azure 52.120.192.0/19,Azure 52.120.224.0/20,Azure 52.121.0.0/17,Azure 52.121.128.0/18,Azure 52.121.192.0/20,Azure
-	52.121.208.0/21,Azure 52.121.216.0/23,Azure 52.121.224.0/20,Azure 52.121.240.0/21,Azure 52.121.248.0/24,Azure 52.122.0.0/16,Azure
-	52.123.0.0/18,Azure 52.123.64.0/24,Azure 52.123.94.0/23,Azure 52.123.96.0/19,Azure 52.123.133.0/24,Azure 52.123.134.0/23,Azure
-	52.123.136.0/21,Azure 52.123.144.0/20,Azure 52.123.160.0/19,Azure 52.123.192.0/22,Azure 52.123.198.0/23,Azure 52.123.200.0/23,Azure
-	52.123.204.0/22,Azure 52.123.208.0/20,Azure 52.124.128.0/17,AWS 52.125.128.0/21,Azure 52.125.136.0/22,Azure 52.125.140.0/23,Azure
-	52.125.144.0/20,Azure 52.129.130.0/23,AWS 52.129.224.0/22,AWS 52.136.0.0/13,Azure 52.144.133.32/27,AWS 52.144.192.0/24,AWS
-	52.144.193.0/25,AWS 52.144.193.128/26,AWS 52.144.194.0/24,AWS 52.144.195.0/26,AWS 52.144.196.192/26,AWS 52.144.197.128/25,AWS
-	52.144.199.128/26,AWS 52.144.200.64/26,AWS 52.144.200.128/26,AWS 52.144.201.64/26,AWS 52.144.201.128/26,AWS 52.144.205.0/26,AWS
-	52.144.208.0/30,AWS 52.144.208.64/26,AWS 52.144.208.128/25,AWS 52.144.209.0/24,AWS 52.144.210.0/24,AWS 52.144.211.0/25,AWS
-	52.144.211.128/26,AWS 52.144.211.192/29,AWS 52.144.211.200/30,AWS 52.144.212.64/26,AWS 52.144.212.192/26,AWS 52.144.213.64/26,AWS
-	52.144.214.128/26,AWS 52.144.215.0/30,AWS 52.144.215.192/29,AWS 52.144.215.200/30,AWS 52.144.216.0/29,AWS 52.144.216.8/30,AWS
-	52.144.218.0/25,AWS 52.144.223.64/26,AWS 52.144.223.128/26,AWS 52.144.224.64/26,AWS 52.144.224.128/25,AWS 52.144.225.0/25,AWS
-	52.144.225.128/26,AWS 52.144.227.64/26,AWS 52.144.227.192/26,AWS 52.144.228.0/30,AWS 52.144.228.64/26,AWS 52.144.228.128/25,AWS
-	52.144.229.0/25,AWS 52.144.230.0/26,AWS 52.144.230.204/30,AWS 52.144.230.208/30,AWS 52.144.231.64/26,AWS 52.144.233.64/29,AWS
-	52.144.233.128/29,AWS 52.144.233.192/26,AWS 52.146.0.0/15,Azure 52.148.0.0/14,Azure 52.152.0.0/13,Azure 52.160.0.0/14,Azure
-	52.164.0.0/16,Azure 52.165.0.0/19,Azure 52.165.32.0/20,Azure 52.165.48.0/28,Azure 52.165.49.0/24,Azure 52.165.56.0/21,Azure
-	52.165.64.0/19,Azure 52.165.96.0/21,Azure 52.165.104.0/25,Azure 52.165.104.128/26,Azure 52.165.128.0/17,Azure 52.166.0.0/15,Azure
-	52.168.0.0/13,Azure 52.176.0.0/17,Azure 52.176.128.0/19,Azure 52.176.160.0/21,Azure 52.176.176.0/20,Azure 52.176.192.0/19,Azure
-	52.176.224.0/23,Azure 52.176.232.0/21,Azure 52.176.240.0/20,Azure 52.177.0.0/16,Azure 52.178.0.0/15,Azure 52.180.0.0/17,Azure
-	52.180.128.0/19,Azure 52.180.160.0/20,Azure 52.180.176.0/21,Azure 52.180.184.0/27,Azure 52.180.184.32/28,Azure 52.180.185.0/24,Azure
-	52.182.128.0/17,Azure 52.183.0.0/16,Azure 52.184.0.0/17,Azure 52.184.128.0/19,Azure 52.184.160.0/21,Azure 52.184.168.0/27,Azure
-	52.184.168.32/28,Azure 52.184.168.80/28,Azure 52.184.168.96/27,Azure 52.184.168.128/28,Azure 52.184.169.0/24,Azure 52.184.170.0/24,Azure
-	52.184.176.0/20,Azure 52.184.192.0/18,Azure 52.185.0.0/19,Azure 52.185.32.0/20,Azure 52.185.48.0/21,Azure 52.185.56.0/25,Azure
-	52.185.56.128/27,Azure 52.185.56.160/28,Azure 52.185.64.0/19,Azure 52.185.96.0/20,Azure 52.185.112.0/25,Azure 52.185.120.0/21,Azure
-	52.185.128.0/17,Azure 52.186.0.0/15,Azure 52.188.0.0/14,Azure 52.192.0.0/12,AWS 52.208.0.0/13,AWS 52.216.0.0/15,AWS
-	52.218.0.0/16,AWS 52.219.0.0/20,AWS 52.219.16.0/22,AWS 52.219.20.0/23,AWS 52.219.23.0/24,AWS 52.219.24.0/21,AWS
-	52.219.32.0/20,AWS 52.219.56.0/21,AWS 52.219.64.0/21,AWS 52.219.72.0/22,AWS 52.219.80.0/20,AWS 52.219.96.0/19,AWS
-	52.219.128.0/18,AWS 52.219.192.0/20,AWS 52.219.208.0/21,AWS 52.219.216.0/22,AWS 52.219.220.0/23,AWS 52.219.224.0/21,AWS
-	52.219.232.0/22,AWS 52.219.254.0/23,AWS 52.220.0.0/15,AWS 52.222.0.0/16,AWS 52.223.0.0/17,AWS 52.223.192.0/18,AWS
-	52.224.0.0/16,Azure 52.225.0.0/17,Azure 52.225.128.0/21,Azure 52.225.136.0/26,Azure 52.225.136.64/28,Azure 52.225.137.0/24,Azure
-	52.225.144.0/20,Azure 52.225.160.0/19,Azure 52.225.192.0/18,Azure 52.226.0.0/16,Azure 52.228.0.0/14,Azure 52.232.0.0/15,Azure
-	52.234.0.0/16,Azure 52.235.0.0/17,Azure 52.236.0.0/15,Azure 52.238.0.0/18,Azure 52.238.192.0/18,Azure 52.239.0.0/17,Azure
-	52.239.128.0/19,Azure 52.239.160.0/22,Azure 52.239.164.0/23,Azure 52.239.167.0/24,Azure 52.239.168.0/21,Azure 52.239.176.128/25,Azure
-	52.239.177.0/24,Azure 52.239.178.0/23,Azure 52.239.180.0/22,Azure 52.239.184.0/22,Azure 52.239.188.0/23,Azure 52.239.190.0/24,Azure
-	52.239.191.0/28,Azure 52.239.192.0/18,Azure 52.240.0.0/15,Azure 52.242.0.0/16,Azure 52.243.32.0/19,Azure 52.243.64.0/18,Azure
-	52.245.8.0/21,Azure 52.245.16.0/20,Azure 52.245.32.0/21,Azure 52.245.40.0/22,Azure 52.245.44.0/23,Azure 52.245.46.0/25,Azure
-	52.245.46.128/28,Azure 52.245.46.160/27,Azure 52.245.46.192/26,Azure 52.245.48.0/20,Azure 52.245.64.0/22,Azure 52.245.68.0/24,Azure
-	52.245.69.0/26,Azure 52.245.69.64/27,Azure 52.245.69.96/28,Azure 52.245.69.144/28,Azure 52.245.69.160/27,Azure 52.245.69.192/26,Azure
-	52.245.70.0/23,Azure 52.245.72.0/21,Azure 52.245.80.0/20,Azure 52.245.96.0/19,Azure 52.246.0.0/17,Azure 52.246.128.0/20,Azure
-	52.246.152.0/21,Azure 52.246.160.0/19,Azure 52.246.192.0/18,Azure 52.247.0.0/17,Azure 52.247.192.0/18,Azure 52.248.0.0/16,Azure
-	52.249.0.0/18,Azure 52.249.64.0/19,Azure 52.249.128.0/17,Azure 52.250.0.0/16,Azure 52.251.0.0/17,Azure 52.251.128.0/23,Azure
-	52.252.0.0/16,Azure 52.253.0.0/17,Azure 52.253.128.0/20,Azure 52.253.148.0/22,Azure 52.253.152.0/21,Azure 52.253.160.0/19,Azure
-	52.253.192.0/19,Azure 52.253.224.0/20,Azure 52.254.0.0/15,Azure 54.5.0.0/16,AWS 54.6.0.0/15,AWS 54.8.0.0/16,AWS
+	51.92.0.0-51.96.255.255,AWS 51.100.0.0-51.102.255.255,AWS 51.103.0.0-51.103.192.63,Azure 51.103.200.0-51.105.255.255,Azure 51.107.0.0/19,Azure 51.107.40.0-51.107.255.255,Azure
+	51.112.0.0/16,AWS 51.116.0.0/16,Azure 51.118.0.0/16,AWS 51.120.0.0/19,Azure 51.120.40.0-51.120.255.255,Azure 51.124.0.0/16,Azure
+	51.132.0.0/16,Azure 51.136.0.0-51.138.167.255,Azure 51.138.176.0-51.138.239.255,Azure 51.140.0.0-51.141.128.255,Azure 51.141.129.64-51.141.129.191,Azure 51.141.130.0/25,Azure
+	51.141.134.0-51.141.139.255,Azure 51.141.160.0-51.142.31.255,Azure 51.142.48.0/21,Azure 51.142.64.0-51.145.255.255,Azure 51.158.0.0/15,Scaleway 51.161.0.0/16,OVH
+	51.164.0.0-51.169.255.255,AWS 51.170.32.0/19,Oracle 51.170.80.0/20,Oracle 51.170.128.0/20,Oracle 51.170.152.0/21,Oracle 51.172.0.0/15,AWS
+	51.178.0.0/16,OVH 51.195.0.0/16,OVH 51.200.0.0/13,AWS 51.210.0.0/16,OVH 51.222.0.0/16,OVH 51.224.0.0/15,AWS
+	51.241.1.0/24,OVH 51.254.0.0/15,OVH 52.0.0.0-52.46.159.255,AWS 52.46.164.0-52.46.187.255,AWS 52.46.192.0-52.46.243.255,AWS 52.46.249.0-52.82.169.31,AWS
+	52.82.170.0/23,AWS 52.82.176.0-52.82.185.255,AWS 52.82.187.0-52.93.12.255,AWS 52.93.14.0/24,AWS 52.93.16.0-52.93.21.255,AWS 52.93.22.48-52.93.22.71,AWS
+	52.93.23.0-52.93.31.255,AWS 52.93.32.176/32,AWS 52.93.32.179-52.93.32.180,AWS 52.93.32.183-52.93.32.184,AWS 52.93.33.8/30,AWS 52.93.33.224/31,AWS
+	52.93.33.230/31,AWS 52.93.34.0-52.93.45.255,AWS 52.93.47.0-52.93.51.255,AWS 52.93.52.160/29,AWS 52.93.53.0/29,AWS 52.93.55.144-52.93.55.149,AWS
+	52.93.55.152-52.93.55.167,AWS 52.93.56.0-52.93.69.255,AWS 52.93.70.40/29,AWS 52.93.70.128/25,AWS 52.93.71.37-52.93.71.47,AWS 52.93.72.0-52.93.83.255,AWS
+	52.93.84.160/29,AWS 52.93.84.192/29,AWS 52.93.86.160/29,AWS 52.93.86.192/29,AWS 52.93.87.96/27,AWS 52.93.88.160/29,AWS
+	52.93.88.192/29,AWS 52.93.90.160/29,AWS 52.93.90.192/29,AWS 52.93.91.96-52.93.91.115,AWS 52.93.92.0-52.93.101.255,AWS 52.93.111.0-52.93.113.255,AWS
+	52.93.115.0-52.93.116.255,AWS 52.93.119.144/30,AWS 52.93.120.176/30,AWS 52.93.121.187-52.93.121.190,AWS 52.93.121.195-52.93.121.198,AWS 52.93.122.131/32,AWS
+	52.93.122.202/31,AWS 52.93.122.218/32,AWS 52.93.122.255/32,AWS 52.93.123.6/32,AWS 52.93.123.11/32,AWS 52.93.123.98/31,AWS
+	52.93.123.136/32,AWS 52.93.123.255/32,AWS 52.93.124.14/31,AWS 52.93.124.96/31,AWS 52.93.124.210-52.93.124.213,AWS 52.93.125.42/31,AWS
+	52.93.126.76/32,AWS 52.93.126.122/31,AWS 52.93.126.130-52.93.126.139,AWS 52.93.126.144/30,AWS 52.93.126.198/31,AWS 52.93.126.204/30,AWS
+	52.93.126.212/30,AWS 52.93.126.234/31,AWS 52.93.126.244/31,AWS 52.93.126.250/31,AWS 52.93.127.17-52.93.127.19,AWS 52.93.127.24/30,AWS
+	52.93.127.68/30,AWS 52.93.127.92-52.93.127.133,AWS 52.93.127.138/31,AWS 52.93.127.146-52.93.127.149,AWS 52.93.127.152-52.93.127.169,AWS 52.93.127.172-52.93.127.185,AWS
+	52.93.127.194-52.93.127.207,AWS 52.93.127.216-52.93.127.221,AWS 52.93.127.232/32,AWS 52.93.127.237-52.93.127.239,AWS 52.93.127.244-52.93.127.255,AWS 52.93.129.95/32,AWS
+	52.93.131.217/32,AWS 52.93.133.127/32,AWS 52.93.133.129/32,AWS 52.93.133.131/32,AWS 52.93.133.133/32,AWS 52.93.133.153/32,AWS
+	52.93.133.155/32,AWS 52.93.133.175/32,AWS 52.93.133.177/32,AWS 52.93.133.179/32,AWS 52.93.133.181/32,AWS 52.93.134.181/32,AWS
+	52.93.135.195/32,AWS 52.93.136.0-52.93.140.255,AWS 52.93.141.128/25,AWS 52.93.146.0-52.93.148.191,AWS 52.93.149.0-52.93.151.255,AWS 52.93.152.160/29,AWS
+	52.93.152.192/29,AWS 52.93.153.64/29,AWS 52.93.153.80/32,AWS 52.93.153.96/29,AWS 52.93.153.128/29,AWS 52.93.153.148/31,AWS
+	52.93.153.168-52.93.153.179,AWS 52.93.156.0/22,AWS 52.93.178.128-52.93.178.235,AWS 52.93.182.128/26,AWS 52.93.183.64/27,AWS 52.93.193.192-52.93.193.203,AWS
+	52.93.198.0/25,AWS 52.93.199.24-52.93.199.47,AWS 52.93.199.88-52.93.199.111,AWS 52.93.201.80-52.93.201.111,AWS 52.93.228.160/29,AWS 52.93.228.192/29,AWS
+	52.93.229.64/29,AWS 52.93.229.96/29,AWS 52.93.229.128/29,AWS 52.93.229.148/31,AWS 52.93.236.0-52.93.245.255,AWS 52.93.246.216/29,AWS
+	52.93.247.0/25,AWS 52.93.248.0/22,AWS 52.93.254.0-52.94.20.255,AWS 52.94.22.0-52.94.30.255,AWS 52.94.32.0-52.94.69.255,AWS 52.94.72.0-52.94.146.255,AWS
+	52.94.148.0/22,AWS 52.94.152.3/32,AWS 52.94.152.9/32,AWS 52.94.152.11-52.94.152.12,AWS 52.94.152.44/32,AWS 52.94.152.60-52.94.152.69,AWS
+	52.94.152.176-52.94.152.195,AWS 52.94.160.0-52.94.198.159,AWS 52.94.199.0-52.94.201.127,AWS 52.94.204.0-52.94.248.239,AWS 52.94.249.32-52.94.250.63,AWS 52.94.250.80-52.94.250.207,AWS
+	52.94.252.0-52.95.29.63,AWS 52.95.30.0/23,AWS 52.95.34.0-52.95.42.255,AWS 52.95.48.0-52.95.219.255,AWS 52.95.224.0-52.95.230.255,AWS 52.95.235.0/24,AWS
+	52.95.239.0-52.95.255.143,AWS 52.96.11.0/24,Azure 52.100.0.0/14,Azure 52.106.0.0-52.106.17.255,Azure 52.106.64.0-52.106.66.255,Azure 52.106.120.0/23,Azure
+	52.106.122.32-52.106.122.159,Azure 52.106.128.0-52.106.139.255,Azure 52.106.184.0-52.106.188.63,Azure 52.106.189.0/24,Azure 52.106.191.0-52.106.195.255,Azure 52.106.216.0-52.106.218.191,Azure
+	52.108.0.0/21,Azure 52.108.16.0-52.108.63.255,Azure 52.108.68.0-52.108.113.255,Azure 52.108.115.0-52.108.118.255,Azure 52.108.121.0-52.108.139.255,Azure 52.108.144.0-52.108.156.255,Azure
+	52.108.165.0-52.109.35.255,Azure 52.109.44.0-52.109.176.255,Azure 52.111.192.0-52.111.211.255,Azure 52.111.224.0-52.112.6.255,Azure 52.112.8.0-52.112.16.255,Azure 52.112.22.0/23,Azure
+	52.112.32.0/24,Azure 52.112.34.0/23,Azure 52.112.37.0-52.112.51.255,Azure 52.112.53.0-52.112.63.255,Azure 52.112.65.0-52.112.66.255,Azure 52.112.68.0-52.112.70.255,Azure
+	52.112.72.0/21,Azure 52.112.84.0-52.112.96.255,Azure 52.112.100.0/22,Azure 52.112.105.0-52.112.109.255,Azure 52.112.112.0-52.112.120.255,Azure 52.112.122.0-52.112.229.255,Azure
+	52.112.231.0-52.113.12.255,Azure 52.113.14.0/24,Azure 52.113.16.0-52.113.99.255,Azure 52.113.101.0-52.113.103.255,Azure 52.113.106.0-52.113.129.255,Azure 52.113.131.0-52.113.193.255,Azure
+	52.113.198.0-52.114.59.255,Azure 52.114.61.0-52.114.63.255,Azure 52.114.65.0/24,Azure 52.114.67.0/24,Azure 52.114.69.0/24,Azure 52.114.71.0-52.114.100.255,Azure
+	52.114.104.0-52.114.115.255,Azure 52.114.120.0/24,Azure 52.114.122.0/24,Azure 52.114.124.0/24,Azure 52.114.126.0/24,Azure 52.114.128.0-52.114.175.255,Azure
+	52.114.177.0/24,Azure 52.114.179.0-52.114.187.255,Azure 52.114.189.0/24,Azure 52.114.191.0-52.114.227.255,Azure 52.114.229.0-52.114.245.255,Azure 52.114.247.0-52.115.13.255,Azure
+	52.115.15.0/24,Azure 52.115.17.0/24,Azure 52.115.19.0-52.115.47.255,Azure 52.115.49.0-52.115.53.255,Azure 52.115.55.0-52.115.56.255,Azure 52.115.58.0-52.115.63.255,Azure
+	52.115.65.0-52.115.94.255,Azure 52.115.96.0-52.115.117.255,Azure 52.115.119.0/24,Azure 52.115.121.0/24,Azure 52.115.123.0/24,Azure 52.115.125.0/24,Azure
+	52.115.127.0-52.115.247.255,Azure 52.119.128.0-52.119.199.255,AWS 52.119.205.0-52.119.249.255,AWS 52.119.252.0/22,AWS 52.120.0.0-52.120.239.255,Azure 52.121.0.0-52.121.217.255,Azure
+	52.121.224.0-52.121.248.255,Azure 52.122.0.0-52.123.64.255,Azure 52.123.94.0-52.123.127.255,Azure 52.123.133.0-52.123.195.255,Azure 52.123.198.0-52.123.201.255,Azure 52.123.204.0-52.123.223.255,Azure
+	52.124.128.0/17,AWS 52.125.128.0-52.125.141.255,Azure 52.125.144.0/20,Azure 52.129.130.0/23,AWS 52.129.224.0/22,AWS 52.136.0.0/13,Azure
+	52.144.133.32/27,AWS 52.144.192.0-52.144.193.191,AWS 52.144.194.0-52.144.195.63,AWS 52.144.196.192/26,AWS 52.144.197.128/25,AWS 52.144.199.128/26,AWS
+	52.144.200.64-52.144.200.191,AWS 52.144.201.64-52.144.201.191,AWS 52.144.205.0/26,AWS 52.144.208.0/30,AWS 52.144.208.64-52.144.211.203,AWS 52.144.212.64/26,AWS
+	52.144.212.192/26,AWS 52.144.213.64/26,AWS 52.144.214.128/26,AWS 52.144.215.0/30,AWS 52.144.215.192-52.144.215.203,AWS 52.144.216.0-52.144.216.11,AWS
+	52.144.218.0/25,AWS 52.144.223.64-52.144.223.191,AWS 52.144.224.64-52.144.225.191,AWS 52.144.227.64/26,AWS 52.144.227.192-52.144.228.3,AWS 52.144.228.64-52.144.229.127,AWS
+	52.144.230.0/26,AWS 52.144.230.204-52.144.230.211,AWS 52.144.231.64/26,AWS 52.144.233.64/29,AWS 52.144.233.128/29,AWS 52.144.233.192/26,AWS
+	52.146.0.0-52.165.48.15,Azure 52.165.49.0/24,Azure 52.165.56.0-52.165.104.191,Azure 52.165.128.0-52.176.167.255,Azure 52.176.176.0-52.176.225.255,Azure 52.176.232.0-52.180.184.47,Azure
+	52.180.185.0/24,Azure 52.182.128.0-52.184.168.47,Azure 52.184.168.80-52.184.168.143,Azure 52.184.169.0-52.184.170.255,Azure 52.184.176.0-52.185.56.175,Azure 52.185.64.0-52.185.112.127,Azure
+	52.185.120.0-52.191.255.255,Azure 52.192.0.0-52.219.21.255,AWS 52.219.23.0-52.219.47.255,AWS 52.219.56.0-52.219.75.255,AWS 52.219.80.0-52.219.221.255,AWS 52.219.224.0-52.219.235.255,AWS
+	52.219.254.0-52.223.127.255,AWS 52.223.192.0/18,AWS 52.224.0.0-52.225.136.79,Azure 52.225.137.0/24,Azure 52.225.144.0-52.226.255.255,Azure 52.228.0.0-52.235.127.255,Azure
+	52.236.0.0-52.238.63.255,Azure 52.238.192.0-52.239.165.255,Azure 52.239.167.0-52.239.175.255,Azure 52.239.176.128-52.239.191.15,Azure 52.239.192.0-52.242.255.255,Azure 52.243.32.0-52.243.127.255,Azure
+	52.245.8.0-52.245.46.143,Azure 52.245.46.160-52.245.46.255,Azure 52.245.48.0-52.245.69.111,Azure 52.245.69.144-52.245.127.255,Azure 52.246.0.0-52.246.143.255,Azure 52.246.152.0-52.247.127.255,Azure
+	52.247.192.0-52.249.95.255,Azure 52.249.128.0-52.251.129.255,Azure 52.252.0.0-52.253.143.255,Azure 52.253.148.0-52.253.239.255,Azure 52.254.0.0/15,Azure 54.5.0.0-54.8.255.255,AWS
 	54.20.0.0/15,AWS 54.25.0.0/21,AWS 54.25.14.0/23,AWS 54.25.20.0/24,AWS 54.25.32.0/19,AWS 54.25.82.0/24,AWS
 	54.26.166.0/24,AWS 54.32.0.0/15,AWS 54.36.0.0/14,OVH 54.46.0.0/17,AWS 54.54.0.0/15,AWS 54.64.0.0/11,AWS
-	54.102.0.0/16,AWS 54.112.0.0/18,AWS 54.115.0.0/16,AWS 54.116.0.0/15,AWS 54.136.0.0/15,AWS 54.144.0.0/12,AWS
-	54.160.0.0/11,AWS 54.192.0.0/12,AWS 54.208.0.0/13,AWS 54.216.0.0/14,AWS 54.220.0.0/15,AWS 54.222.0.0/19,AWS
-	54.222.32.0/21,AWS 54.222.48.0/21,AWS 54.222.57.0/24,AWS 54.222.58.0/28,AWS 54.222.58.32/27,AWS 54.222.64.0/21,AWS
-	54.222.76.0/22,AWS 54.222.80.0/20,AWS 54.222.96.0/21,AWS 54.222.112.0/20,AWS 54.222.128.0/17,AWS 54.223.0.0/16,AWS
-	54.224.0.0/13,AWS 54.232.0.0/14,AWS 54.236.0.0/15,AWS 54.238.0.0/16,AWS 54.239.0.0/19,AWS 54.239.32.0/21,AWS
-	54.239.40.128/31,AWS 54.239.40.132/31,AWS 54.239.40.134/32,AWS 54.239.40.152/29,AWS 54.239.48.0/20,AWS 54.239.64.0/21,AWS
-	54.239.96.0/24,AWS 54.239.98.0/23,AWS 54.239.100.0/23,AWS 54.239.102.0/24,AWS 54.239.103.0/25,AWS 54.239.103.128/26,AWS
-	54.239.104.0/21,AWS 54.239.112.0/23,AWS 54.239.114.0/25,AWS 54.239.114.128/26,AWS 54.239.115.0/25,AWS 54.239.116.0/22,AWS
-	54.239.120.0/21,AWS 54.239.128.0/18,AWS 54.239.192.0/19,AWS 54.240.128.0/18,AWS 54.240.192.0/21,AWS 54.240.200.0/24,AWS
-	54.240.202.0/23,AWS 54.240.204.0/22,AWS 54.240.208.0/20,AWS 54.240.225.0/24,AWS 54.240.226.0/23,AWS 54.240.228.0/22,AWS
-	54.240.232.0/22,AWS 54.240.236.1/32,AWS 54.240.236.2/32,AWS 54.240.236.5/32,AWS 54.240.236.6/32,AWS 54.240.236.9/32,AWS
-	54.240.236.10/32,AWS 54.240.236.13/32,AWS 54.240.236.14/32,AWS 54.240.236.17/32,AWS 54.240.236.18/32,AWS 54.240.236.21/32,AWS
-	54.240.236.22/32,AWS 54.240.236.25/32,AWS 54.240.236.26/32,AWS 54.240.236.29/32,AWS 54.240.236.30/32,AWS 54.240.236.33/32,AWS
-	54.240.236.34/32,AWS 54.240.236.37/32,AWS 54.240.236.38/32,AWS 54.240.236.41/32,AWS 54.240.236.42/32,AWS 54.240.236.45/32,AWS
-	54.240.236.46/32,AWS 54.240.236.49/32,AWS 54.240.236.50/32,AWS 54.240.236.53/32,AWS 54.240.236.54/32,AWS 54.240.236.57/32,AWS
-	54.240.236.58/32,AWS 54.240.236.61/32,AWS 54.240.236.62/32,AWS 54.240.236.65/32,AWS 54.240.236.66/32,AWS 54.240.236.69/32,AWS
-	54.240.236.70/32,AWS 54.240.236.73/32,AWS 54.240.236.74/32,AWS 54.240.236.77/32,AWS 54.240.236.78/32,AWS 54.240.236.81/32,AWS
-	54.240.236.82/32,AWS 54.240.236.85/32,AWS 54.240.236.86/32,AWS 54.240.236.89/32,AWS 54.240.236.90/32,AWS 54.240.236.93/32,AWS
-	54.240.236.94/32,AWS 54.240.241.0/24,AWS 54.240.242.0/23,AWS 54.240.244.0/22,AWS 54.240.248.0/21,AWS 54.241.0.0/16,AWS
-	54.242.0.0/15,AWS 54.244.0.0/14,AWS 54.248.0.0/13,AWS 56.1.0.0/16,AWS 56.5.0.0/16,AWS 56.6.0.0/16,AWS
-	56.8.0.0/16,AWS 56.10.0.0/15,AWS 56.47.0.0/16,AWS 56.48.0.0/13,AWS 56.56.0.0/16,AWS 56.61.0.0/16,AWS
-	56.62.0.0/16,AWS 56.68.0.0/17,AWS 56.69.0.0/16,AWS 56.70.0.0/15,AWS 56.96.0.0/14,AWS 56.112.0.0/14,AWS
-	56.124.0.0/14,AWS 56.128.0.0/14,AWS 56.136.0.0/14,AWS 56.155.0.0/16,AWS 56.156.0.0/15,AWS 56.159.0.0/16,AWS
-	56.162.0.0/16,AWS 56.164.0.0/16,AWS 56.184.0.0/14,AWS 56.228.0.0/14,AWS 56.240.0.0/13,AWS 57.128.0.0/15,OVH
-	57.130.0.0/16,OVH 57.131.0.0/17,OVH 57.131.128.0/18,OVH 57.150.0.0/15,Azure 57.152.0.0/14,Azure 57.156.0.0/17,Azure
-	57.157.0.0/28,Azure 57.157.0.16/29,Azure 57.157.0.24/30,Azure 57.157.0.28/31,Azure 57.157.0.34/31,Azure 57.157.0.36/30,Azure
-	57.157.0.40/29,Azure 57.157.0.48/28,Azure 57.157.0.64/26,Azure 57.157.0.128/30,Azure 57.157.0.136/31,Azure 57.157.0.140/30,Azure
-	57.157.0.144/28,Azure 57.157.0.160/29,Azure 57.157.0.168/30,Azure 57.157.0.174/31,Azure 57.157.0.176/28,Azure 57.157.0.192/26,Azure
-	57.157.1.0/26,Azure 57.157.1.64/27,Azure 57.157.1.96/28,Azure 57.157.1.114/31,Azure 57.157.1.116/30,Azure 57.157.1.120/29,Azure
-	57.157.1.128/25,Azure 57.157.2.0/30,Azure 57.157.2.4/31,Azure 57.157.2.12/30,Azure 57.157.2.16/28,Azure 57.157.2.32/27,Azure
-	57.157.2.64/29,Azure 57.157.2.72/31,Azure 57.157.2.76/30,Azure 57.157.2.80/28,Azure 57.157.2.96/28,Azure 57.157.2.112/29,Azure
-	57.157.2.120/31,Azure 57.157.2.124/30,Azure 57.157.2.128/25,Azure 57.157.3.0/24,Azure 57.157.4.0/25,Azure 57.157.4.128/27,Azure
-	57.157.4.160/29,Azure 57.157.4.172/30,Azure 57.157.4.176/29,Azure 57.157.4.190/31,Azure 57.157.4.192/30,Azure 57.157.4.202/31,Azure
-	57.157.4.204/30,Azure 57.157.4.208/28,Azure 57.157.4.224/27,Azure 57.157.5.0/24,Azure 57.157.6.0/28,Azure 57.157.6.16/29,Azure
-	57.157.6.60/30,Azure 57.157.6.64/26,Azure 57.157.6.128/25,Azure 57.157.7.0/24,Azure 57.157.8.0/23,Azure 57.157.10.0/28,Azure
-	57.157.10.16/29,Azure 57.157.10.24/30,Azure 57.157.10.48/28,Azure 57.157.10.64/26,Azure 57.157.10.128/25,Azure 57.157.11.0/27,Azure
-	57.157.11.32/30,Azure 57.157.11.72/29,Azure 57.157.11.80/28,Azure 57.157.11.96/27,Azure 57.157.11.128/25,Azure 57.157.12.0/26,Azure
-	57.157.12.64/31,Azure 57.157.12.68/30,Azure 57.157.12.72/29,Azure 57.157.12.80/28,Azure 57.157.12.96/27,Azure 57.157.12.128/25,Azure
-	57.157.13.0/25,Azure 57.157.13.128/29,Azure 57.157.13.136/31,Azure 57.157.13.140/30,Azure 57.157.13.144/30,Azure 57.157.13.148/31,Azure
-	57.157.13.170/31,Azure 57.157.13.174/31,Azure 57.157.13.178/31,Azure 57.157.13.182/31,Azure 57.157.13.186/31,Azure 57.157.13.190/31,Azure
-	57.157.13.194/31,Azure 57.157.13.198/31,Azure 57.157.13.202/31,Azure 57.157.13.206/31,Azure 57.157.13.210/31,Azure 57.157.13.214/31,Azure
-	57.157.13.216/29,Azure 57.157.13.224/30,Azure 57.157.13.228/31,Azure 57.157.13.232/29,Azure 57.157.13.240/28,Azure 57.157.14.0/23,Azure
-	57.157.16.0/24,Azure 57.157.17.0/27,Azure 57.157.17.32/28,Azure 57.157.17.48/30,Azure 57.157.17.52/31,Azure 57.157.20.0/24,Azure
-	57.157.21.0/25,Azure 57.157.21.128/26,Azure 57.157.21.192/31,Azure 57.157.24.0/24,Azure 57.157.25.0/25,Azure 57.157.25.128/28,Azure
-	57.157.25.144/30,Azure 57.157.25.148/31,Azure 57.157.28.0/25,Azure 57.157.28.128/26,Azure 57.157.28.192/29,Azure 57.157.28.200/30,Azure
-	57.157.28.206/31,Azure 57.157.28.208/28,Azure 57.157.28.224/27,Azure 57.157.29.0/25,Azure 57.157.29.128/26,Azure 57.157.29.192/27,Azure
-	57.157.29.224/28,Azure 57.157.29.240/30,Azure 57.157.32.0/23,Azure 57.157.34.0/24,Azure 57.157.35.0/25,Azure 57.157.35.128/27,Azure
-	57.157.35.160/30,Azure 57.157.35.164/31,Azure 57.157.36.0/26,Azure 57.157.36.64/28,Azure 57.157.36.80/30,Azure 57.157.36.100/30,Azure
-	57.157.36.104/29,Azure 57.157.36.112/29,Azure 57.157.36.136/29,Azure 57.157.36.144/28,Azure 57.157.36.160/27,Azure 57.157.36.192/26,Azure
-	57.157.37.0/26,Azure 57.157.37.64/27,Azure 57.157.37.96/28,Azure 57.157.37.112/30,Azure 57.157.37.116/31,Azure 57.157.40.0/26,Azure
-	57.157.40.64/28,Azure 57.157.40.80/29,Azure 57.157.40.88/30,Azure 57.157.44.0/25,Azure 57.157.44.128/27,Azure 57.157.44.160/28,Azure
-	57.157.44.180/30,Azure 57.157.44.184/29,Azure 57.157.44.192/26,Azure 57.157.45.0/27,Azure 57.157.45.32/30,Azure 57.157.45.36/31,Azure
-	57.157.48.0/23,Azure 57.157.50.0/26,Azure 57.157.50.64/27,Azure 57.157.56.0/24,Azure 57.157.57.0/27,Azure 57.157.57.32/28,Azure
-	57.157.57.48/30,Azure 57.157.60.0/24,Azure 57.157.61.0/27,Azure 57.157.61.32/29,Azure 57.157.64.0/24,Azure 57.157.65.0/25,Azure
-	57.157.65.128/26,Azure 57.157.65.192/28,Azure 57.157.65.208/29,Azure 57.157.65.216/31,Azure 57.157.68.0/23,Azure 57.157.70.0/24,Azure
-	57.157.71.0/28,Azure 57.157.72.0/24,Azure 57.157.73.0/26,Azure 57.157.73.64/28,Azure 57.157.73.80/30,Azure 57.157.76.0/24,Azure
-	57.157.77.0/27,Azure 57.157.77.32/30,Azure 57.157.77.36/31,Azure 57.157.80.0/24,Azure 57.157.81.0/26,Azure 57.157.81.64/27,Azure
-	57.157.81.96/28,Azure 57.157.81.112/29,Azure 57.157.88.0/25,Azure 57.157.88.128/26,Azure 57.157.88.192/27,Azure 57.157.88.224/28,Azure
-	57.157.88.240/29,Azure 57.157.92.0/25,Azure 57.157.92.128/26,Azure 57.157.92.192/28,Azure 57.157.92.208/29,Azure 57.157.92.216/31,Azure
-	57.157.96.0/25,Azure 57.157.96.128/26,Azure 57.157.96.192/27,Azure 57.157.96.224/29,Azure 57.157.108.0/28,Azure 57.157.108.16/29,Azure
-	57.157.108.24/31,Azure 57.157.112.0/25,Azure 57.157.112.128/31,Azure 57.157.120.0/26,Azure 57.157.120.64/27,Azure 57.157.120.96/28,Azure
-	57.157.120.112/29,Azure 57.157.120.120/30,Azure 57.157.120.124/31,Azure 57.157.124.0/25,Azure 57.157.124.128/27,Azure 57.157.124.160/28,Azure
-	57.157.124.176/29,Azure 57.157.124.184/30,Azure 57.157.124.188/31,Azure 57.157.128.0/24,Azure 57.157.129.0/25,Azure 57.157.129.128/26,Azure
-	57.157.129.192/29,Azure 57.157.156.0/27,Azure 57.157.156.32/28,Azure 57.157.156.48/31,Azure 57.157.168.0/27,Azure 57.157.168.32/28,Azure
-	57.157.168.48/30,Azure 57.158.0.0/17,Azure 57.158.128.0/18,Azure 57.159.0.0/16,Azure 57.160.0.0/17,Azure 57.162.0.0/15,Azure
-	57.165.0.0/16,Azure 57.166.0.0/15,Azure 57.168.0.0/15,Azure 57.170.0.0/17,Azure 57.171.0.0/20,Azure 57.171.16.0/22,Azure
-	57.171.20.0/23,Azure 57.171.22.0/24,Azure 57.173.0.0/16,Azure 57.174.0.0/15,Azure 57.180.0.0/14,AWS 58.254.138.0/25,AWS
-	58.254.138.128/26,AWS 59.82.0.0/17,Alibaba 59.82.128.0/19,Alibaba 59.110.0.0/16,Alibaba 60.205.0.0/16,Alibaba 62.115.179.220/31,Oracle
-	62.115.179.228/31,Oracle 62.122.126.0/24,OVH 62.238.0.0/17,Hetzner 63.32.0.0/14,AWS 63.176.0.0/12,AWS 63.246.112.0/22,AWS
-	63.246.119.0/24,AWS 63.246.120.0/21,AWS 63.249.128.0/22,AWS 63.249.132.0/23,AWS 63.249.138.0/23,AWS 63.249.140.0/22,AWS
-	63.249.144.0/20,AWS 63.249.160.0/20,AWS 63.249.176.0/21,AWS 63.249.184.0/23,AWS 63.249.186.0/24,AWS 63.249.188.0/22,AWS
-	63.249.192.0/20,AWS 63.249.208.0/23,AWS 63.249.213.0/24,AWS 63.249.214.0/23,AWS 63.249.216.0/24,AWS 63.251.117.0/24,OVH
-	64.4.8.0/24,Azure 64.4.54.0/24,Azure 64.23.128.0/17,DigitalOcean 64.66.128.0/22,AWS 64.66.133.0/24,AWS 64.66.134.0/23,AWS
-	64.66.136.0/21,AWS 64.66.144.0/20,AWS 64.66.160.0/23,AWS 64.66.162.0/24,AWS 64.73.195.0/24,AWS 64.73.196.0/23,AWS
-	64.73.201.0/24,AWS 64.73.202.0/23,AWS 64.73.204.0/22,AWS 64.73.208.0/21,AWS 64.73.216.0/24,AWS 64.91.192.0/19,AWS
-	64.94.92.0/23,OVH 64.95.150.0/23,OVH 64.110.64.0/18,Oracle 64.112.98.0/24,OVH 64.181.132.0/24,Oracle 64.181.136.0/22,Oracle
-	64.181.140.0/23,Oracle 64.181.144.0/22,Oracle 64.181.150.0/23,Oracle 64.181.152.0/21,Oracle 64.181.160.0/19,Oracle 64.181.192.0/18,Oracle
-	64.187.128.0/20,AWS 64.188.20.0/24,OVH 64.225.0.0/17,DigitalOcean 64.225.244.0/23,OVH 64.226.64.0/18,DigitalOcean 64.227.0.0/17,DigitalOcean
-	64.227.128.0/18,DigitalOcean 64.232.0.0/16,AWS 64.236.0.0/16,Azure 64.252.64.0/18,AWS 64.252.128.0/18,AWS 65.0.0.0/14,AWS
-	65.4.0.0/16,AWS 65.8.0.0/16,AWS 65.9.0.0/17,AWS 65.9.128.0/18,AWS 65.21.0.0/16,Hetzner 65.52.0.0/19,Azure
-	65.52.32.0/21,Azure 65.52.48.0/20,Azure 65.52.64.0/20,Azure 65.52.104.0/24,Azure 65.52.106.0/24,Azure 65.52.108.0/22,Azure
-	65.52.112.0/20,Azure 65.52.128.0/17,Azure 65.54.19.128/27,Azure 65.55.32.128/28,Azure 65.55.32.193/32,Azure 65.55.32.194/31,Azure
-	65.55.32.196/32,Azure 65.55.32.209/32,Azure 65.55.32.210/31,Azure 65.55.44.8/29,Azure 65.55.44.16/28,Azure 65.55.44.32/27,Azure
-	65.55.44.64/26,Azure 65.55.44.128/27,Azure 65.55.51.0/24,Azure 65.55.60.176/28,Azure 65.55.105.0/26,Azure 65.55.105.96/27,Azure
-	65.55.105.160/27,Azure 65.55.105.192/26,Azure 65.55.106.0/26,Azure 65.55.106.64/27,Azure 65.55.106.128/25,Azure 65.55.107.0/28,Azure
-	65.55.107.48/28,Azure 65.55.107.64/26,Azure 65.55.108.0/23,Azure 65.55.110.0/24,Azure 65.55.120.0/24,Azure 65.55.144.0/23,Azure
-	65.55.146.0/24,Azure 65.55.207.0/24,Azure 65.55.209.0/24,Azure 65.55.210.0/24,Azure 65.55.211.0/26,Azure 65.55.212.0/27,Azure
-	65.55.212.128/25,Azure 65.55.213.0/27,Azure 65.55.213.64/26,Azure 65.55.213.128/26,Azure 65.55.217.0/24,Azure 65.55.218.0/23,Azure
-	65.55.250.0/24,Azure 65.55.252.0/24,Azure 65.108.0.0/15,Hetzner 65.176.0.0/14,AWS 66.7.0.0/21,AWS 66.36.0.0/21,AWS
-	66.36.8.0/23,AWS 66.36.10.0/24,AWS 66.47.0.0/16,AWS 66.70.128.0/17,OVH 66.92.11.0/24,OVH 66.92.25.0/24,OVH
-	66.92.161.0/24,OVH 66.92.168.0/24,OVH 66.175.208.0/20,Linode 66.179.218.0/23,OVH 66.182.96.0/20,AWS 66.228.32.0/19,Linode
-	66.246.75.0/24,Linode 67.202.0.0/18,AWS 67.205.128.0/18,DigitalOcean 67.207.68.0/22,DigitalOcean 67.207.72.0/21,DigitalOcean 67.207.80.0/20,DigitalOcean
-	67.220.224.0/19,AWS 68.65.214.0/24,OVH 68.66.112.0/20,AWS 68.79.0.0/18,AWS 68.154.0.0/15,Azure 68.183.0.0/16,DigitalOcean
-	68.210.0.0/16,Azure 68.211.0.0/17,Azure 68.211.128.0/18,Azure 68.211.192.0/19,Azure 68.218.0.0/17,Azure 68.218.128.0/18,Azure
-	68.219.0.0/16,Azure 68.220.0.0/15,Azure 68.232.108.0/24,OVH 68.233.96.0/19,Oracle 69.0.136.0/22,AWS 69.15.0.0/16,Azure
-	69.17.37.0/24,OVH 69.55.48.0/22,DigitalOcean 69.55.54.0/23,DigitalOcean 69.55.58.0/23,DigitalOcean 69.55.60.0/22,DigitalOcean 69.72.31.0/24,OVH
-	69.107.3.176/28,AWS 69.107.6.112/28,AWS 69.107.6.160/28,AWS 69.107.6.200/29,AWS 69.107.6.208/28,AWS 69.107.6.224/29,AWS
-	69.107.7.0/28,AWS 69.107.7.16/29,AWS 69.107.7.32/27,AWS 69.107.7.64/26,AWS 69.107.7.128/28,AWS 69.107.9.128/28,AWS
-	69.107.9.176/28,AWS 69.107.9.192/26,AWS 69.107.10.0/23,AWS 69.107.12.0/24,AWS 69.107.13.0/26,AWS 69.164.192.0/21,Linode
-	69.164.201.0/24,Linode 69.164.202.0/23,Linode 69.164.204.0/22,Linode 69.164.208.0/20,Linode 69.230.192.0/18,AWS 69.231.128.0/18,AWS
-	69.234.192.0/18,AWS 69.235.128.0/18,AWS 70.37.0.0/21,Azure 70.37.8.0/22,Azure 70.37.12.0/32,Azure 70.37.16.0/20,Azure
-	70.37.32.0/19,Azure 70.37.64.0/18,Azure 70.37.152.0/23,Azure 70.37.160.0/21,Azure 70.132.0.0/18,AWS 70.152.0.0/16,Azure
-	70.153.0.0/17,Azure 70.153.128.0/18,Azure 70.153.192.0/19,Azure 70.156.0.0/15,Azure 70.224.192.0/18,AWS 70.232.64.0/18,AWS
-	71.131.192.0/18,AWS 71.132.0.0/18,AWS 71.136.64.0/18,AWS 71.137.0.0/18,AWS 71.152.0.0/17,AWS 72.11.139.0/24,OVH
-	72.14.176.0/22,Linode 72.14.181.0/24,Linode 72.14.182.0/23,Linode 72.14.184.0/21,Linode 72.21.192.0/19,AWS 72.41.0.0/20,AWS
-	72.44.32.0/19,AWS 72.144.0.0/16,Azure 72.145.0.0/17,Azure 72.145.128.0/18,Azure 72.146.0.0/15,Azure 72.152.0.0/15,Azure
-	72.154.0.0/16,Azure 72.155.0.0/17,Azure 72.242.0.0/15,AWS 72.244.230.0/24,OVH 72.251.0.0/17,OVH 74.0.7.0/24,OVH
-	74.7.0.0/16,Azure 74.144.0.0/15,Azure 74.146.0.0/16,Azure 74.147.0.0/17,Azure 74.148.0.0/17,Azure 74.149.0.0/17,Azure
-	74.149.128.0/19,Azure 74.150.0.0/17,Azure 74.151.0.0/16,Azure 74.152.0.0/17,Azure 74.153.0.0/17,Azure 74.154.0.0/15,Azure
-	74.156.0.0/17,Azure 74.157.0.0/16,Azure 74.158.0.0/15,Azure 74.160.0.0/17,Azure 74.161.0.0/16,Azure 74.162.0.0/15,Azure
-	74.176.0.0/14,Azure 74.207.224.0/21,Linode 74.207.232.0/22,Linode 74.207.236.0/23,Linode 74.207.240.0/21,Linode 74.207.248.0/22,Linode
-	74.207.252.0/23,Linode 74.207.254.0/24,Linode 74.224.0.0/15,Azure 74.226.0.0/16,Azure 74.227.0.0/17,Azure 74.227.128.0/20,Azure
-	74.234.0.0/15,Azure 74.240.0.0/17,Azure 74.240.128.0/21,Azure 74.240.192.0/18,Azure 74.241.0.0/16,Azure 74.242.0.0/18,Azure
-	74.242.64.0/21,Azure 74.242.128.0/17,Azure 74.243.0.0/17,Azure 74.243.128.0/21,Azure 74.243.144.0/20,Azure 74.243.160.0/19,Azure
-	74.243.192.0/18,Azure 74.248.0.0/15,Azure 75.2.0.0/17,AWS 75.2.128.0/18,AWS 75.3.0.0/18,AWS 75.3.128.0/18,AWS
-	75.22.192.0/18,AWS 75.45.128.0/18,AWS 75.47.0.0/18,AWS 75.79.0.0/16,AWS 75.101.128.0/17,AWS 76.9.104.0/23,OVH
-	76.162.0.0/15,AWS 76.223.0.0/17,AWS 76.223.168.0/23,AWS 76.223.170.0/28,AWS 76.223.170.32/27,AWS 76.223.170.64/26,AWS
-	76.223.170.128/27,AWS 76.223.172.0/22,AWS 77.42.0.0/17,Hetzner 77.67.28.0/24,OVH 77.73.34.0/24,OVH 77.74.120.0/23,OVH
-	77.74.122.0/24,OVH 77.74.230.0/24,OVH 77.81.138.0/24,OVH 77.83.244.0/24,OVH 77.87.123.0/24,OVH 77.107.88.0/24,OVH
-	77.112.0.0/14,AWS 78.12.0.0/14,AWS 78.46.0.0/15,Hetzner 78.105.156.0/24,OVH 78.111.138.0/24,OVH 78.138.62.0/24,Hetzner
-	79.72.0.0/20,Oracle 79.72.16.0/22,Oracle 79.72.20.0/23,Oracle 79.72.24.0/21,Oracle 79.72.32.0/22,Oracle 79.72.40.0/21,Oracle
-	79.72.48.0/20,Oracle 79.72.64.0/19,Oracle 79.76.16.0/22,Oracle 79.76.20.0/24,Oracle 79.76.24.0/21,Oracle 79.76.32.0/19,Oracle
-	79.76.96.0/19,Oracle 79.99.146.0/24,OVH 79.110.61.0/24,OVH 79.125.0.0/17,AWS 79.137.0.0/17,OVH 79.172.216.0/24,OVH
-	80.85.84.0/22,Linode 80.87.206.0/24,OVH 80.126.0.0/23,AWS 80.126.2.0/24,AWS 80.225.64.0/19,Oracle 80.225.160.0/26,Oracle
-	80.225.160.128/25,Oracle 80.225.161.192/26,Oracle 80.225.162.0/23,Oracle 80.225.164.0/22,Oracle 80.225.168.0/22,Oracle 80.225.176.0/23,Oracle
-	80.225.178.128/25,Oracle 80.225.184.0/21,Oracle 80.225.192.0/18,Oracle 80.240.94.0/24,OVH 80.240.128.0/20,DigitalOcean 81.168.32.0/24,OVH
-	81.168.80.0/24,OVH 81.208.160.0/20,Oracle 81.208.188.0/22,Oracle 82.21.139.0/24,OVH 82.22.15.0/24,OVH 82.22.25.0/24,OVH
-	82.22.51.0/24,OVH 82.22.118.0/24,OVH 82.24.96.0/22,OVH 82.24.190.0/24,OVH 82.25.14.0/24,OVH 82.25.146.0/23,OVH
-	82.26.81.0/24,OVH 82.26.176.0/20,OVH 82.29.93.0/24,OVH 82.29.126.0/24,OVH 82.38.35.0/24,OVH 82.38.82.0/24,OVH
-	82.38.124.0/22,OVH 82.38.140.0/22,OVH 82.38.152.0/21,OVH 82.38.160.0/21,OVH 82.38.224.0/19,OVH 82.39.108.0/24,OVH
-	82.39.119.0/24,OVH 82.39.156.0/22,OVH 82.39.176.0/21,OVH 82.39.216.0/22,OVH 82.39.224.0/22,OVH 82.40.0.0/20,OVH
-	82.40.16.0/22,OVH 82.41.44.0/22,OVH 82.41.52.0/22,OVH 82.41.68.0/22,OVH 82.41.80.0/22,OVH 82.41.88.0/22,OVH
-	82.41.100.0/22,OVH 82.41.104.0/22,OVH 82.41.124.0/22,OVH 82.41.134.0/24,OVH 82.41.148.0/22,OVH 82.41.152.0/22,OVH
-	82.41.160.0/21,OVH 82.41.172.0/22,OVH 82.41.176.0/22,OVH 82.41.184.0/21,OVH 82.41.204.0/22,OVH 82.41.212.0/22,OVH
-	82.41.224.0/21,OVH 82.47.36.0/23,OVH 82.47.63.0/24,OVH 82.47.98.0/24,OVH 82.47.180.0/24,OVH 82.70.32.0/19,Oracle
-	82.70.64.0/19,Oracle 82.70.208.0/20,Oracle 82.70.224.0/20,Oracle 82.70.248.0/21,Oracle 82.117.230.0/23,OVH 82.117.245.0/24,OVH
-	82.129.0.0/22,OVH 82.152.8.0/24,OVH 82.152.57.0/24,OVH 82.152.58.0/24,OVH 82.152.73.0/24,OVH 82.152.75.0/24,OVH
-	82.152.98.0/24,OVH 82.152.109.0/24,OVH 82.152.197.0/24,OVH 82.152.200.0/24,OVH 82.152.211.0/24,OVH 82.152.226.0/24,OVH
-	82.152.240.0/24,OVH 82.152.243.0/24,OVH 82.153.66.0/24,OVH 82.153.205.0/24,OVH 82.196.0.0/20,DigitalOcean 83.118.240.0/21,AWS
-	83.119.128.0/18,AWS 83.136.214.0/23,OVH 83.143.16.0/21,OVH 83.160.0.0/14,AWS 83.175.167.0/24,OVH 83.175.173.0/24,OVH
-	84.8.64.0/22,Oracle 84.8.68.0/25,Oracle 84.8.72.0/22,Oracle 84.8.88.128/25,Oracle 84.8.96.0/19,Oracle 84.8.128.0/19,Oracle
-	84.8.192.0/26,Oracle 84.8.192.128/25,Oracle 84.8.193.192/26,Oracle 84.8.194.0/23,Oracle 84.8.196.0/22,Oracle 84.8.200.0/22,Oracle
-	84.8.208.0/23,Oracle 84.8.210.128/25,Oracle 84.8.216.0/21,Oracle 84.19.167.0/24,OVH 84.32.10.0/24,OVH 84.75.33.0/24,OVH
-	84.235.160.0/19,Oracle 84.235.192.0/23,Oracle 84.235.208.0/20,Oracle 84.235.224.0/19,Oracle 85.10.192.0/18,Hetzner 85.90.244.0/22,Linode
-	85.93.20.0/24,Hetzner 85.118.164.0/24,OVH 85.159.208.0/21,Linode 85.210.0.0/15,Azure 85.217.144.0/23,OVH 86.54.26.0/24,OVH
-	86.112.0.0/15,AWS 87.76.137.0/24,OVH 87.76.140.0/24,OVH 87.76.170.0/24,OVH 87.86.181.0/24,OVH 87.86.252.0/24,OVH
-	87.98.128.0/17,OVH 87.99.128.0/17,Hetzner 87.229.40.0/24,OVH 87.229.51.0/24,OVH 87.238.80.0/21,AWS 88.80.184.0/21,Linode
-	88.96.0.0/17,Oracle 88.99.0.0/16,Hetzner 88.104.0.0/13,AWS 88.198.0.0/16,Hetzner 88.216.221.0/24,OVH 88.218.34.0/24,OVH
-	89.19.44.0/24,OVH 89.40.83.0/24,OVH 89.48.0.0/13,AWS 89.60.0.0/15,AWS 89.125.172.0/24,OVH 89.167.0.0/17,Hetzner
-	89.168.0.0/21,Oracle 89.168.16.0/20,Oracle 89.168.32.0/19,Oracle 89.168.64.0/18,Oracle 91.90.88.0/21,OVH 91.98.0.0/15,Hetzner
-	91.107.128.0/17,Hetzner 91.121.0.0/16,OVH 91.124.192.0/24,OVH 91.134.0.0/16,OVH 91.190.240.0/21,Hetzner 91.198.19.0/24,OVH
-	91.199.32.0/24,OVH 91.199.83.0/24,OVH 91.209.58.0/24,OVH 91.213.192.0/24,OVH 91.224.117.0/24,OVH 91.246.38.0/24,OVH
-	92.4.64.0/19,Oracle 92.4.128.0/18,Oracle 92.4.192.0/21,Oracle 92.4.202.0/23,Oracle 92.4.228.0/22,Oracle 92.4.232.0/23,Oracle
-	92.4.240.0/20,Oracle 92.5.0.0/17,Oracle 92.5.128.0/18,Oracle 92.5.192.0/19,Oracle 92.5.224.0/20,Oracle 92.5.240.0/21,Oracle
-	92.5.248.0/22,Oracle 92.5.254.0/23,Oracle 92.62.117.0/24,OVH 92.62.241.0/24,OVH 92.113.13.0/24,OVH 92.118.168.0/24,OVH
-	92.222.0.0/16,OVH 92.246.224.0/19,OVH 93.77.128.0/19,AWS 93.114.69.0/24,OVH 93.174.111.0/24,OVH 94.23.0.0/16,OVH
-	94.36.0.0/14,AWS 94.130.0.0/16,Hetzner 94.158.184.0/24,OVH 94.183.158.0/24,OVH 94.245.88.0/21,Azure 94.245.104.0/21,Azure
-	94.245.117.96/27,Azure 94.245.118.0/25,Azure 94.245.120.128/27,Azure 94.245.122.0/24,Azure 94.245.123.144/28,Azure 94.245.123.176/28,Azure
-	95.40.0.0/15,AWS 95.81.72.0/22,OVH 95.85.1.0/24,DigitalOcean 95.85.2.0/23,DigitalOcean 95.85.4.0/22,DigitalOcean 95.85.8.0/21,DigitalOcean
-	95.85.16.0/20,DigitalOcean 95.85.32.0/19,DigitalOcean 95.135.166.0/23,OVH 95.216.0.0/15,Hetzner 96.0.0.0/18,AWS 96.0.64.0/19,AWS
-	96.0.96.0/21,AWS 96.0.104.0/22,AWS 96.0.108.0/24,AWS 96.0.110.0/23,AWS 96.0.112.0/20,AWS 96.0.128.0/19,AWS
-	96.0.160.0/20,AWS 96.0.176.0/21,AWS 96.0.184.0/22,AWS 96.62.105.0/24,OVH 96.126.96.0/19,Linode 96.127.0.0/17,AWS
-	97.107.128.0/21,Linode 97.107.136.0/22,Linode 97.107.140.0/23,Linode 97.107.142.0/24,Linode 98.64.0.0/15,Azure 98.66.0.0/16,Azure
-	98.67.0.0/18,Azure 98.67.64.0/21,Azure 98.67.128.0/17,Azure 98.70.0.0/15,Azure 98.80.0.0/12,AWS 98.130.0.0/15,AWS
-	99.10.0.0/18,AWS 99.77.0.0/18,AWS 99.77.128.0/18,AWS 99.77.232.0/21,AWS 99.77.240.0/21,AWS 99.77.248.0/22,AWS
-	99.77.252.0/23,AWS 99.77.254.0/24,AWS 99.78.128.0/19,AWS 99.78.160.0/21,AWS 99.78.168.0/22,AWS 99.78.172.0/24,AWS
-	99.78.176.0/20,AWS 99.78.192.0/21,AWS 99.78.208.0/21,AWS 99.78.216.0/22,AWS 99.78.220.0/26,AWS 99.78.220.65/32,AWS
-	99.78.220.66/32,AWS 99.78.220.128/26,AWS 99.78.228.0/22,AWS 99.78.232.0/21,AWS 99.78.240.0/20,AWS 99.79.0.0/16,AWS
-	99.80.0.0/15,AWS 99.82.0.0/22,AWS 99.82.8.0/21,AWS 99.82.128.0/18,AWS 99.83.64.0/19,AWS 99.83.96.0/21,AWS
-	99.83.104.0/22,AWS 99.83.108.0/23,AWS 99.83.112.0/21,AWS 99.83.120.0/22,AWS 99.83.128.0/17,AWS 99.84.0.0/16,AWS
-	99.86.0.0/16,AWS 99.87.0.0/19,AWS 99.87.32.0/22,AWS 99.150.0.0/17,AWS 99.151.64.0/18,AWS 99.151.128.0/19,AWS
-	99.151.160.0/20,AWS 99.151.184.0/21,AWS 99.181.64.0/18,AWS 99.200.0.0/13,AWS 100.20.0.0/14,AWS 100.24.0.0/13,AWS
-	100.48.0.0/12,AWS 101.37.0.0/16,Alibaba 101.132.0.0/15,Alibaba 101.200.0.0/15,Alibaba 102.37.0.0/20,Azure 102.37.16.0/21,Azure
-	102.37.24.0/23,Azure 102.37.26.0/26,Azure 102.37.32.0/19,Azure 102.37.64.0/18,Azure 102.37.128.0/19,Azure 102.37.160.0/21,Azure
-	102.37.176.0/20,Azure 102.37.192.0/18,Azure 102.133.0.0/19,Azure 102.133.32.0/20,Azure 102.133.56.0/21,Azure 102.133.64.0/19,Azure
-	102.133.96.0/20,Azure 102.133.112.0/28,Azure 102.133.120.0/21,Azure 102.133.128.0/18,Azure 102.133.192.0/19,Azure 102.133.224.0/20,Azure
-	102.133.240.0/25,Azure 102.133.240.128/26,Azure 102.133.248.0/21,Azure 103.3.60.0/22,Linode 103.4.8.0/21,AWS 103.5.12.0/22,OVH
-	103.8.172.0/22,AWS 103.13.188.0/23,AWS 103.25.156.0/24,Azure 103.29.68.0/22,Linode 103.36.96.0/24,Azure 103.52.196.0/22,Alibaba
-	103.53.48.0/22,AWS 103.81.186.0/23,Alibaba 103.82.16.0/22,OVH 103.135.210.0/23,Alibaba 103.166.228.0/24,OVH 103.167.178.0/23,OVH
-	103.168.196.0/23,OVH 103.170.116.0/23,OVH 103.173.12.0/23,Alibaba 103.189.191.0/24,OVH 103.199.80.0/24,OVH 103.206.40.0/22,Alibaba
-	103.206.156.0/23,OVH 103.239.50.0/24,OVH 103.244.227.0/24,OVH 103.246.148.0/22,AWS 103.253.145.0/24,DigitalOcean 103.253.146.0/23,DigitalOcean
-	103.255.140.0/23,Azure 104.40.0.0/14,Azure 104.44.88.0/21,Azure 104.44.128.0/18,Azure 104.45.0.0/16,Azure 104.46.0.0/20,Azure
-	104.46.24.0/21,Azure 104.46.32.0/19,Azure 104.46.64.0/18,Azure 104.46.160.0/19,Azure 104.46.192.0/19,Azure 104.46.224.0/20,Azure
-	104.47.0.1/32,Azure 104.47.0.2/31,Azure 104.47.1.1/32,Azure 104.47.1.2/31,Azure 104.47.2.1/32,Azure 104.47.2.2/31,Azure
-	104.47.3.0/24,Azure 104.47.4.1/32,Azure 104.47.4.2/31,Azure 104.47.5.0/24,Azure 104.47.6.0/23,Azure 104.47.8.1/32,Azure
-	104.47.8.2/31,Azure 104.47.9.1/32,Azure 104.47.9.2/31,Azure 104.47.10.1/32,Azure 104.47.10.2/31,Azure 104.47.11.0/24,Azure
-	104.47.15.0/24,Azure 104.47.16.0/22,Azure 104.47.22.0/23,Azure 104.47.24.0/21,Azure 104.47.32.1/32,Azure 104.47.32.2/31,Azure
-	104.47.33.1/32,Azure 104.47.33.2/31,Azure 104.47.34.0/24,Azure 104.47.38.1/32,Azure 104.47.38.2/31,Azure 104.47.39.0/24,Azure
-	104.47.40.1/32,Azure 104.47.40.2/31,Azure 104.47.41.1/32,Azure 104.47.41.2/31,Azure 104.47.42.0/23,Azure 104.47.44.1/32,Azure
-	104.47.44.2/31,Azure 104.47.45.1/32,Azure 104.47.45.2/31,Azure 104.47.46.1/32,Azure 104.47.46.2/31,Azure 104.47.47.0/24,Azure
-	104.47.48.1/32,Azure 104.47.48.2/31,Azure 104.47.49.1/32,Azure 104.47.49.2/31,Azure 104.47.50.0/23,Azure 104.47.52.1/32,Azure
-	104.47.52.2/31,Azure 104.47.53.1/32,Azure 104.47.53.2/31,Azure 104.47.54.1/32,Azure 104.47.54.2/31,Azure 104.47.55.0/24,Azure
-	104.47.56.0/23,Azure 104.47.58.0/24,Azure 104.47.59.128/25,Azure 104.47.60.1/32,Azure 104.47.60.2/31,Azure 104.47.61.0/25,Azure
-	104.47.61.128/26,Azure 104.47.62.0/23,Azure 104.47.64.0/21,Azure 104.47.72.0/27,Azure 104.47.72.64/26,Azure 104.47.72.128/25,Azure
-	104.47.73.0/24,Azure 104.47.74.0/23,Azure 104.47.76.0/25,Azure 104.47.76.128/26,Azure 104.47.81.0/24,Azure 104.47.82.0/23,Azure
-	104.47.84.0/24,Azure 104.47.85.0/25,Azure 104.47.92.1/32,Azure 104.47.92.2/31,Azure 104.47.93.1/32,Azure 104.47.93.2/31,Azure
-	104.47.110.0/24,Azure 104.47.116.1/32,Azure 104.47.116.2/31,Azure 104.47.117.1/32,Azure 104.47.117.2/31,Azure 104.47.118.0/23,Azure
-	104.47.120.1/32,Azure 104.47.120.2/31,Azure 104.47.121.1/32,Azure 104.47.121.2/31,Azure 104.47.124.1/32,Azure 104.47.124.2/31,Azure
-	104.47.125.1/32,Azure 104.47.125.2/31,Azure 104.47.127.0/24,Azure 104.47.128.0/18,Azure 104.47.200.0/21,Azure 104.47.208.0/21,Azure
-	104.47.216.64/26,Azure 104.47.217.71/32,Azure 104.47.217.87/32,Azure 104.47.217.151/32,Azure 104.47.218.0/23,Azure 104.47.220.0/22,Azure
-	104.47.224.0/20,Azure 104.47.240.167/32,Azure 104.47.240.183/32,Azure 104.47.240.215/32,Azure 104.64.0.0/16,Linode 104.66.128.0/18,Linode
-	104.66.192.0/19,Linode 104.66.224.0/23,Linode 104.67.0.0/18,Linode 104.67.64.0/19,Linode 104.105.0.0/16,Linode 104.111.0.0/18,Linode
-	104.131.0.0/16,DigitalOcean 104.153.112.0/22,AWS 104.153.116.0/24,AWS 104.153.118.0/24,AWS 104.154.16.0/20,GoogleCloud 104.154.32.0/19,GoogleCloud
-	104.154.64.0/19,GoogleCloud 104.154.96.0/20,GoogleCloud 104.154.113.0/24,GoogleCloud 104.154.114.0/23,GoogleCloud 104.154.116.0/22,GoogleCloud 104.154.120.0/23,GoogleCloud
-	104.154.128.0/17,GoogleCloud 104.155.0.0/17,GoogleCloud 104.155.128.0/18,GoogleCloud 104.155.192.0/19,GoogleCloud 104.155.224.0/20,GoogleCloud 104.164.137.0/24,OVH
-	104.167.16.0/24,OVH 104.196.0.0/18,GoogleCloud 104.196.65.0/24,GoogleCloud 104.196.66.0/23,GoogleCloud 104.196.68.0/22,GoogleCloud 104.196.96.0/19,GoogleCloud
-	104.196.128.0/17,GoogleCloud 104.197.0.0/16,GoogleCloud 104.198.0.0/16,GoogleCloud 104.199.0.0/18,GoogleCloud 104.199.66.0/23,GoogleCloud 104.199.68.0/22,GoogleCloud
-	104.199.72.0/21,GoogleCloud 104.199.80.0/20,GoogleCloud 104.199.96.0/19,GoogleCloud 104.199.128.0/18,GoogleCloud 104.199.192.0/19,GoogleCloud 104.199.224.0/20,GoogleCloud
-	104.199.242.0/23,GoogleCloud 104.199.244.0/22,GoogleCloud 104.199.248.0/21,GoogleCloud 104.200.16.0/20,Linode 104.208.0.0/16,Azure 104.209.0.0/18,Azure
-	104.209.64.0/19,Azure 104.209.128.0/17,Azure 104.210.0.0/20,Azure 104.210.32.0/19,Azure 104.210.64.0/18,Azure 104.210.128.0/19,Azure
-	104.210.176.0/20,Azure 104.210.192.0/19,Azure 104.211.0.0/16,Azure 104.212.67.0/24,Azure 104.212.68.0/24,Azure 104.214.0.0/15,Azure
-	104.216.0.0/15,AWS 104.222.182.0/24,OVH 104.225.253.0/24,OVH 104.234.50.0/24,OVH 104.234.94.0/24,OVH 104.234.135.0/24,OVH
-	104.234.168.0/24,OVH 104.236.0.0/16,DigitalOcean 104.237.128.0/19,Linode 104.239.79.0/24,OVH 104.248.0.0/16,DigitalOcean 104.255.56.0/32,AWS
-	104.255.56.3/32,AWS 104.255.56.11/32,AWS 104.255.56.12/32,AWS 104.255.56.15/32,AWS 104.255.56.16/30,AWS 104.255.56.20/32,AWS
-	104.255.56.23/32,AWS 104.255.56.24/30,AWS 104.255.56.28/31,AWS 104.255.56.49/32,AWS 104.255.56.50/31,AWS 104.255.56.52/32,AWS
-	104.255.56.55/32,AWS 104.255.56.56/31,AWS 104.255.56.60/32,AWS 104.255.56.63/32,AWS 104.255.56.64/30,AWS 104.255.56.68/31,AWS
-	104.255.56.70/32,AWS 104.255.57.0/32,AWS 104.255.57.41/32,AWS 104.255.57.98/32,AWS 104.255.57.100/30,AWS 104.255.57.164/30,AWS
-	104.255.57.168/29,AWS 104.255.57.176/30,AWS 104.255.58.0/32,AWS 104.255.58.43/32,AWS 104.255.58.44/32,AWS 104.255.58.63/32,AWS
-	104.255.58.84/32,AWS 104.255.58.91/32,AWS 104.255.58.236/30,AWS 104.255.59.81/32,AWS 104.255.59.82/31,AWS 104.255.59.85/32,AWS
-	104.255.59.86/31,AWS 104.255.59.88/32,AWS 104.255.59.91/32,AWS 104.255.59.101/32,AWS 104.255.59.102/31,AWS 104.255.59.104/31,AWS
-	104.255.59.106/32,AWS 104.255.59.114/31,AWS 104.255.59.118/31,AWS 104.255.59.122/31,AWS 104.255.59.124/30,AWS 104.255.59.130/31,AWS
-	104.255.59.132/30,AWS 104.255.59.136/30,AWS 104.255.59.196/30,AWS 104.255.59.200/31,AWS 104.255.59.206/31,AWS 104.255.59.208/29,AWS
-	104.255.59.216/31,AWS 104.255.59.238/31,AWS 104.255.59.240/30,AWS 104.255.61.0/31,AWS 106.11.0.0/16,Alibaba 106.14.0.0/15,Alibaba
-	107.20.0.0/14,AWS 107.167.160.0/19,GoogleCloud 107.170.0.0/16,DigitalOcean 107.176.0.0/15,AWS 107.178.208.0/20,GoogleCloud 107.178.240.0/20,GoogleCloud
-	107.189.64.0/18,OVH 108.59.80.0/20,GoogleCloud 108.128.0.0/13,AWS 108.136.0.0/14,AWS 108.140.0.0/14,Azure 108.156.0.0/14,AWS
-	108.166.224.0/20,AWS 108.166.240.0/22,AWS 108.166.244.0/26,AWS 108.166.248.0/21,AWS 108.174.65.0/24,OVH 108.175.48.0/20,AWS
-	109.74.192.0/21,Linode 109.74.200.0/22,Linode 109.74.204.0/23,Linode 109.74.206.0/24,Linode 109.105.195.0/24,OVH 109.110.184.0/24,OVH
-	109.122.15.0/24,OVH 109.122.20.0/24,OVH 109.122.58.0/24,OVH 109.176.40.0/21,OVH 109.176.48.0/21,OVH 109.176.153.0/24,OVH
-	109.232.89.0/24,OVH 109.237.24.0/22,Linode 110.75.0.0/16,Alibaba 110.76.0.0/18,Alibaba 110.173.192.0/19,Alibaba 110.238.2.0/23,AWS
-	111.13.171.128/25,AWS 111.13.185.32/27,AWS 111.13.185.64/27,AWS 111.221.28.0/22,Azure 111.221.80.0/20,Azure 111.221.96.0/20,Azure
-	112.74.0.0/16,Alibaba 112.124.0.0/14,Alibaba 114.55.0.0/16,Alibaba 114.129.44.0/24,OVH 114.215.0.0/16,Alibaba 115.28.0.0/15,Alibaba
-	115.124.16.0/20,Alibaba 116.62.0.0/16,Alibaba 116.129.226.0/25,AWS 116.129.226.128/26,AWS 116.202.0.0/15,Hetzner 116.251.64.0/18,Alibaba
-	117.18.104.0/24,OVH 118.31.0.0/16,Alibaba 118.178.0.0/16,Alibaba 118.190.0.0/16,Alibaba 118.193.97.64/26,AWS 118.193.97.128/25,AWS
-	119.23.0.0/16,Alibaba 119.38.208.0/20,Alibaba 119.42.224.0/19,Alibaba 119.147.182.0/25,AWS 119.147.182.128/26,AWS 120.24.0.0/14,Alibaba
+	54.102.0.0/16,AWS 54.112.0.0/18,AWS 54.115.0.0-54.117.255.255,AWS 54.136.0.0/15,AWS 54.144.0.0-54.222.39.255,AWS 54.222.48.0/21,AWS
+	54.222.57.0-54.222.58.15,AWS 54.222.58.32/27,AWS 54.222.64.0/21,AWS 54.222.76.0-54.222.103.255,AWS 54.222.112.0-54.239.39.255,AWS 54.239.40.128/31,AWS
+	54.239.40.132-54.239.40.134,AWS 54.239.40.152/29,AWS 54.239.48.0-54.239.71.255,AWS 54.239.96.0/24,AWS 54.239.98.0-54.239.103.191,AWS 54.239.104.0-54.239.114.191,AWS
+	54.239.115.0/25,AWS 54.239.116.0-54.239.223.255,AWS 54.240.128.0-54.240.200.255,AWS 54.240.202.0-54.240.223.255,AWS 54.240.225.0-54.240.235.255,AWS 54.240.236.1-54.240.236.2,AWS
+	54.240.236.5-54.240.236.6,AWS 54.240.236.9-54.240.236.10,AWS 54.240.236.13-54.240.236.14,AWS 54.240.236.17-54.240.236.18,AWS 54.240.236.21-54.240.236.22,AWS 54.240.236.25-54.240.236.26,AWS
+	54.240.236.29-54.240.236.30,AWS 54.240.236.33-54.240.236.34,AWS 54.240.236.37-54.240.236.38,AWS 54.240.236.41-54.240.236.42,AWS 54.240.236.45-54.240.236.46,AWS 54.240.236.49-54.240.236.50,AWS
+	54.240.236.53-54.240.236.54,AWS 54.240.236.57-54.240.236.58,AWS 54.240.236.61-54.240.236.62,AWS 54.240.236.65-54.240.236.66,AWS 54.240.236.69-54.240.236.70,AWS 54.240.236.73-54.240.236.74,AWS
+	54.240.236.77-54.240.236.78,AWS 54.240.236.81-54.240.236.82,AWS 54.240.236.85-54.240.236.86,AWS 54.240.236.89-54.240.236.90,AWS 54.240.236.93-54.240.236.94,AWS 54.240.241.0-54.255.255.255,AWS
+	56.1.0.0/16,AWS 56.5.0.0-56.6.255.255,AWS 56.8.0.0/16,AWS 56.10.0.0/15,AWS 56.47.0.0-56.56.255.255,AWS 56.61.0.0-56.62.255.255,AWS
+	56.68.0.0/17,AWS 56.69.0.0-56.71.255.255,AWS 56.96.0.0/14,AWS 56.112.0.0/14,AWS 56.124.0.0-56.131.255.255,AWS 56.136.0.0/14,AWS
+	56.155.0.0-56.157.255.255,AWS 56.159.0.0/16,AWS 56.162.0.0/16,AWS 56.164.0.0/16,AWS 56.184.0.0/14,AWS 56.228.0.0/14,AWS
+	56.240.0.0/13,AWS 57.128.0.0-57.131.191.255,OVH 57.150.0.0-57.156.127.255,Azure 57.157.0.0-57.157.0.29,Azure 57.157.0.34-57.157.0.131,Azure 57.157.0.136/31,Azure
+	57.157.0.140-57.157.0.171,Azure 57.157.0.174-57.157.1.111,Azure 57.157.1.114-57.157.2.5,Azure 57.157.2.12-57.157.2.73,Azure 57.157.2.76-57.157.2.121,Azure 57.157.2.124-57.157.4.167,Azure
+	57.157.4.172-57.157.4.183,Azure 57.157.4.190-57.157.4.195,Azure 57.157.4.202-57.157.6.23,Azure 57.157.6.60-57.157.10.27,Azure 57.157.10.48-57.157.11.35,Azure 57.157.11.72-57.157.12.65,Azure
+	57.157.12.68-57.157.13.137,Azure 57.157.13.140-57.157.13.149,Azure 57.157.13.170/31,Azure 57.157.13.174/31,Azure 57.157.13.178/31,Azure 57.157.13.182/31,Azure
+	57.157.13.186/31,Azure 57.157.13.190/31,Azure 57.157.13.194/31,Azure 57.157.13.198/31,Azure 57.157.13.202/31,Azure 57.157.13.206/31,Azure
+	57.157.13.210/31,Azure 57.157.13.214-57.157.13.229,Azure 57.157.13.232-57.157.17.53,Azure 57.157.20.0-57.157.21.193,Azure 57.157.24.0-57.157.25.149,Azure 57.157.28.0-57.157.28.203,Azure
+	57.157.28.206-57.157.29.243,Azure 57.157.32.0-57.157.35.165,Azure 57.157.36.0-57.157.36.83,Azure 57.157.36.100-57.157.36.119,Azure 57.157.36.136-57.157.37.117,Azure 57.157.40.0-57.157.40.91,Azure
+	57.157.44.0-57.157.44.175,Azure 57.157.44.180-57.157.45.37,Azure 57.157.48.0-57.157.50.95,Azure 57.157.56.0-57.157.57.51,Azure 57.157.60.0-57.157.61.39,Azure 57.157.64.0-57.157.65.217,Azure
+	57.157.68.0-57.157.71.15,Azure 57.157.72.0-57.157.73.83,Azure 57.157.76.0-57.157.77.37,Azure 57.157.80.0-57.157.81.119,Azure 57.157.88.0-57.157.88.247,Azure 57.157.92.0-57.157.92.217,Azure
+	57.157.96.0-57.157.96.231,Azure 57.157.108.0-57.157.108.25,Azure 57.157.112.0-57.157.112.129,Azure 57.157.120.0-57.157.120.125,Azure 57.157.124.0-57.157.124.189,Azure 57.157.128.0-57.157.129.199,Azure
+	57.157.156.0-57.157.156.49,Azure 57.157.168.0-57.157.168.51,Azure 57.158.0.0-57.158.191.255,Azure 57.159.0.0-57.160.127.255,Azure 57.162.0.0/15,Azure 57.165.0.0-57.170.127.255,Azure
+	57.171.0.0-57.171.22.255,Azure 57.173.0.0-57.175.255.255,Azure 57.180.0.0/14,AWS 58.254.138.0-58.254.138.191,AWS 59.82.0.0-59.82.159.255,Alibaba 59.110.0.0/16,Alibaba
+	60.205.0.0/16,Alibaba 62.115.179.220/31,Oracle 62.115.179.228/31,Oracle 62.122.126.0/24,OVH 62.210.0.0/16,Scaleway 62.238.0.0/17,Hetzner
+	63.32.0.0/14,AWS 63.176.0.0/12,AWS 63.246.112.0/22,AWS 63.246.119.0-63.246.127.255,AWS 63.249.128.0-63.249.133.255,AWS 63.249.138.0-63.249.186.255,AWS
+	63.249.188.0-63.249.209.255,AWS 63.249.213.0-63.249.216.255,AWS 63.251.117.0/24,OVH 64.4.8.0/24,Azure 64.4.54.0/24,Azure 64.23.128.0/17,DigitalOcean
+	64.66.128.0/22,AWS 64.66.133.0-64.66.162.255,AWS 64.73.195.0-64.73.197.255,AWS 64.73.201.0-64.73.216.255,AWS 64.91.192.0/19,AWS 64.94.92.0/23,OVH
+	64.95.150.0/23,OVH 64.110.64.0/18,Oracle 64.112.98.0/24,OVH 64.181.132.0/24,Oracle 64.181.136.0-64.181.141.255,Oracle 64.181.144.0/22,Oracle
+	64.181.150.0-64.181.255.255,Oracle 64.187.128.0/20,AWS 64.188.20.0/24,OVH 64.225.0.0/17,DigitalOcean 64.225.244.0/23,OVH 64.226.64.0/18,DigitalOcean
+	64.227.0.0-64.227.191.255,DigitalOcean 64.232.0.0/16,AWS 64.236.0.0/16,Azure 64.252.64.0-64.252.191.255,AWS 65.0.0.0-65.4.255.255,AWS 65.8.0.0-65.9.191.255,AWS
+	65.21.0.0/16,Hetzner 65.52.0.0-65.52.39.255,Azure 65.52.48.0-65.52.79.255,Azure 65.52.104.0/24,Azure 65.52.106.0/24,Azure 65.52.108.0-65.52.255.255,Azure
+	65.54.19.128/27,Azure 65.55.32.128/28,Azure 65.55.32.193-65.55.32.196,Azure 65.55.32.209-65.55.32.211,Azure 65.55.44.8-65.55.44.159,Azure 65.55.51.0/24,Azure
+	65.55.60.176/28,Azure 65.55.105.0/26,Azure 65.55.105.96/27,Azure 65.55.105.160-65.55.106.95,Azure 65.55.106.128-65.55.107.15,Azure 65.55.107.48-65.55.107.127,Azure
+	65.55.108.0-65.55.110.255,Azure 65.55.120.0/24,Azure 65.55.144.0-65.55.146.255,Azure 65.55.207.0/24,Azure 65.55.209.0-65.55.211.63,Azure 65.55.212.0/27,Azure
+	65.55.212.128-65.55.213.31,Azure 65.55.213.64-65.55.213.191,Azure 65.55.217.0-65.55.219.255,Azure 65.55.250.0/24,Azure 65.55.252.0/24,Azure 65.108.0.0/15,Hetzner
+	65.176.0.0/14,AWS 66.7.0.0/21,AWS 66.36.0.0-66.36.10.255,AWS 66.47.0.0/16,AWS 66.70.128.0/17,OVH 66.92.11.0/24,OVH
+	66.92.25.0/24,OVH 66.92.161.0/24,OVH 66.92.168.0/24,OVH 66.175.208.0/20,Linode 66.179.218.0/23,OVH 66.182.96.0/20,AWS
+	66.228.32.0/19,Linode 66.246.75.0/24,Linode 67.202.0.0/18,AWS 67.205.128.0/18,DigitalOcean 67.207.68.0-67.207.95.255,DigitalOcean 67.220.224.0/19,AWS
+	68.65.214.0/24,OVH 68.66.112.0/20,AWS 68.79.0.0/18,AWS 68.154.0.0/15,Azure 68.183.0.0/16,DigitalOcean 68.210.0.0-68.211.223.255,Azure
+	68.218.0.0-68.218.191.255,Azure 68.219.0.0-68.221.255.255,Azure 68.232.108.0/24,OVH 68.233.96.0/19,Oracle 69.0.136.0/22,AWS 69.15.0.0/16,Azure
+	69.17.37.0/24,OVH 69.55.48.0/22,DigitalOcean 69.55.54.0/23,DigitalOcean 69.55.58.0-69.55.63.255,DigitalOcean 69.72.31.0/24,OVH 69.107.3.176/28,AWS
+	69.107.6.112/28,AWS 69.107.6.160/28,AWS 69.107.6.200-69.107.6.231,AWS 69.107.7.0-69.107.7.23,AWS 69.107.7.32-69.107.7.143,AWS 69.107.9.128/28,AWS
+	69.107.9.176-69.107.13.63,AWS 69.164.192.0/21,Linode 69.164.201.0-69.164.223.255,Linode 69.230.192.0/18,AWS 69.231.128.0/18,AWS 69.234.192.0/18,AWS
+	69.235.128.0/18,AWS 70.37.0.0-70.37.12.0,Azure 70.37.16.0-70.37.127.255,Azure 70.37.152.0/23,Azure 70.37.160.0/21,Azure 70.132.0.0/18,AWS
+	70.152.0.0-70.153.223.255,Azure 70.156.0.0/15,Azure 70.224.192.0/18,AWS 70.232.64.0/18,AWS 71.131.192.0-71.132.63.255,AWS 71.136.64.0/18,AWS
+	71.137.0.0/18,AWS 71.152.0.0/17,AWS 72.11.139.0/24,OVH 72.14.176.0/22,Linode 72.14.181.0-72.14.191.255,Linode 72.21.192.0/19,AWS
+	72.41.0.0/20,AWS 72.44.32.0/19,AWS 72.144.0.0-72.145.191.255,Azure 72.146.0.0/15,Azure 72.152.0.0-72.155.127.255,Azure 72.242.0.0/15,AWS
+	72.244.230.0/24,OVH 72.251.0.0/17,OVH 74.0.7.0/24,OVH 74.7.0.0/16,Azure 74.144.0.0-74.147.127.255,Azure 74.148.0.0/17,Azure
+	74.149.0.0-74.149.159.255,Azure 74.150.0.0/17,Azure 74.151.0.0-74.152.127.255,Azure 74.153.0.0/17,Azure 74.154.0.0-74.156.127.255,Azure 74.157.0.0-74.160.127.255,Azure
+	74.161.0.0-74.163.255.255,Azure 74.176.0.0/14,Azure 74.207.224.0-74.207.237.255,Linode 74.207.240.0-74.207.254.255,Linode 74.224.0.0-74.227.143.255,Azure 74.234.0.0/15,Azure
+	74.240.0.0-74.240.135.255,Azure 74.240.192.0-74.242.71.255,Azure 74.242.128.0-74.243.135.255,Azure 74.243.144.0-74.243.255.255,Azure 74.248.0.0/15,Azure 75.2.0.0-75.2.191.255,AWS
+	75.3.0.0/18,AWS 75.3.128.0/18,AWS 75.22.192.0/18,AWS 75.45.128.0/18,AWS 75.47.0.0/18,AWS 75.79.0.0/16,AWS
+	75.101.128.0/17,AWS 76.9.104.0/23,OVH 76.162.0.0/15,AWS 76.223.0.0/17,AWS 76.223.168.0-76.223.170.15,AWS 76.223.170.32-76.223.170.159,AWS
+	76.223.172.0/22,AWS 77.42.0.0/17,Hetzner 77.67.28.0/24,OVH 77.73.34.0/24,OVH 77.74.120.0-77.74.122.255,OVH 77.74.230.0/24,OVH
+	77.81.138.0/24,OVH 77.83.244.0/24,OVH 77.87.123.0/24,OVH 77.107.88.0/24,OVH 77.112.0.0/14,AWS 78.12.0.0/14,AWS
+	78.46.0.0/15,Hetzner 78.105.156.0/24,OVH 78.111.138.0/24,OVH 78.138.62.0/24,Hetzner 79.72.0.0-79.72.21.255,Oracle 79.72.24.0-79.72.35.255,Oracle
+	79.72.40.0-79.72.95.255,Oracle 79.76.16.0-79.76.20.255,Oracle 79.76.24.0-79.76.63.255,Oracle 79.76.96.0/19,Oracle 79.99.146.0/24,OVH 79.110.61.0/24,OVH
+	79.125.0.0/17,AWS 79.137.0.0/17,OVH 79.172.216.0/24,OVH 80.85.84.0/22,Linode 80.87.206.0/24,OVH 80.126.0.0-80.126.2.255,AWS
+	80.225.64.0/19,Oracle 80.225.160.0/26,Oracle 80.225.160.128/25,Oracle 80.225.161.192-80.225.171.255,Oracle 80.225.176.0/23,Oracle 80.225.178.128/25,Oracle
+	80.225.184.0-80.225.255.255,Oracle 80.240.94.0/24,OVH 80.240.128.0/20,DigitalOcean 81.168.32.0/24,OVH 81.168.80.0/24,OVH 81.208.160.0/20,Oracle
+	81.208.188.0/22,Oracle 82.21.139.0/24,OVH 82.22.15.0/24,OVH 82.22.25.0/24,OVH 82.22.51.0/24,OVH 82.22.118.0/24,OVH
+	82.24.96.0/22,OVH 82.24.190.0/24,OVH 82.25.14.0/24,OVH 82.25.146.0/23,OVH 82.26.81.0/24,OVH 82.26.176.0/20,OVH
+	82.29.93.0/24,OVH 82.29.126.0/24,OVH 82.38.35.0/24,OVH 82.38.82.0/24,OVH 82.38.124.0/22,OVH 82.38.140.0/22,OVH
+	82.38.152.0-82.38.167.255,OVH 82.38.224.0/19,OVH 82.39.108.0/24,OVH 82.39.119.0/24,OVH 82.39.156.0/22,OVH 82.39.176.0/21,OVH
+	82.39.216.0/22,OVH 82.39.224.0/22,OVH 82.40.0.0-82.40.19.255,OVH 82.41.44.0/22,OVH 82.41.52.0/22,OVH 82.41.68.0/22,OVH
+	82.41.80.0/22,OVH 82.41.88.0/22,OVH 82.41.100.0-82.41.107.255,OVH 82.41.124.0/22,OVH 82.41.134.0/24,OVH 82.41.148.0-82.41.155.255,OVH
+	82.41.160.0/21,OVH 82.41.172.0-82.41.179.255,OVH 82.41.184.0/21,OVH 82.41.204.0/22,OVH 82.41.212.0/22,OVH 82.41.224.0/21,OVH
+	82.47.36.0/23,OVH 82.47.63.0/24,OVH 82.47.98.0/24,OVH 82.47.180.0/24,OVH 82.70.32.0-82.70.95.255,Oracle 82.70.208.0-82.70.239.255,Oracle
+	82.70.248.0/21,Oracle 82.117.230.0/23,OVH 82.117.245.0/24,OVH 82.129.0.0/22,OVH 82.152.8.0/24,OVH 82.152.57.0-82.152.58.255,OVH
+	82.152.73.0/24,OVH 82.152.75.0/24,OVH 82.152.98.0/24,OVH 82.152.109.0/24,OVH 82.152.197.0/24,OVH 82.152.200.0/24,OVH
+	82.152.211.0/24,OVH 82.152.226.0/24,OVH 82.152.240.0/24,OVH 82.152.243.0/24,OVH 82.153.66.0/24,OVH 82.153.205.0/24,OVH
+	82.196.0.0/20,DigitalOcean 83.118.240.0/21,AWS 83.119.128.0/18,AWS 83.136.214.0/23,OVH 83.143.16.0/21,OVH 83.160.0.0/14,AWS
+	83.175.167.0/24,OVH 83.175.173.0/24,OVH 84.8.64.0-84.8.68.127,Oracle 84.8.72.0/22,Oracle 84.8.88.128/25,Oracle 84.8.96.0-84.8.159.255,Oracle
+	84.8.192.0/26,Oracle 84.8.192.128/25,Oracle 84.8.193.192-84.8.203.255,Oracle 84.8.208.0/23,Oracle 84.8.210.128/25,Oracle 84.8.216.0/21,Oracle
+	84.19.167.0/24,OVH 84.32.10.0/24,OVH 84.75.33.0/24,OVH 84.235.160.0-84.235.193.255,Oracle 84.235.208.0-84.235.255.255,Oracle 85.10.192.0/18,Hetzner
+	85.90.244.0/22,Linode 85.93.20.0/24,Hetzner 85.118.164.0/24,OVH 85.159.208.0/21,Linode 85.210.0.0/15,Azure 85.217.144.0/23,OVH
+	86.54.26.0/24,OVH 86.112.0.0/15,AWS 87.76.137.0/24,OVH 87.76.140.0/24,OVH 87.76.170.0/24,OVH 87.86.181.0/24,OVH
+	87.86.252.0/24,OVH 87.98.128.0/17,OVH 87.99.128.0/17,Hetzner 87.229.40.0/24,OVH 87.229.51.0/24,OVH 87.238.80.0/21,AWS
+	88.80.184.0/21,Linode 88.96.0.0/17,Oracle 88.99.0.0/16,Hetzner 88.104.0.0/13,AWS 88.198.0.0/16,Hetzner 88.216.221.0/24,OVH
+	88.218.34.0/24,OVH 89.19.44.0/24,OVH 89.40.83.0/24,OVH 89.48.0.0/13,AWS 89.60.0.0/15,AWS 89.125.172.0/24,OVH
+	89.167.0.0/17,Hetzner 89.168.0.0/21,Oracle 89.168.16.0-89.168.127.255,Oracle 91.90.88.0/21,OVH 91.98.0.0/15,Hetzner 91.107.128.0/17,Hetzner
+	91.121.0.0/16,OVH 91.124.192.0/24,OVH 91.134.0.0/16,OVH 91.190.240.0/21,Hetzner 91.198.19.0/24,OVH 91.199.32.0/24,OVH
+	91.199.83.0/24,OVH 91.209.58.0/24,OVH 91.213.192.0/24,OVH 91.224.117.0/24,OVH 91.246.38.0/24,OVH 92.4.64.0/19,Oracle
+	92.4.128.0-92.4.199.255,Oracle 92.4.202.0/23,Oracle 92.4.228.0-92.4.233.255,Oracle 92.4.240.0-92.5.251.255,Oracle 92.5.254.0/23,Oracle 92.62.117.0/24,OVH
+	92.62.241.0/24,OVH 92.113.13.0/24,OVH 92.118.168.0/24,OVH 92.222.0.0/16,OVH 92.246.224.0/19,OVH 93.77.128.0/19,AWS
+	93.114.69.0/24,OVH 93.174.111.0/24,OVH 94.23.0.0/16,OVH 94.36.0.0/14,AWS 94.130.0.0/16,Hetzner 94.158.184.0/24,OVH
+	94.183.158.0/24,OVH 94.237.0.0/16,UpCloud 94.245.88.0/21,Azure 94.245.104.0/21,Azure 94.245.117.96/27,Azure 94.245.118.0/25,Azure
+	94.245.120.128/27,Azure 94.245.122.0/24,Azure 94.245.123.144/28,Azure 94.245.123.176/28,Azure 95.40.0.0/15,AWS 95.81.72.0/22,OVH
+	95.85.1.0-95.85.63.255,DigitalOcean 95.135.166.0/23,OVH 95.216.0.0/15,Hetzner 96.0.0.0-96.0.108.255,AWS 96.0.110.0-96.0.187.255,AWS 96.62.105.0/24,OVH
+	96.126.96.0/19,Linode 96.127.0.0/17,AWS 97.107.128.0-97.107.142.255,Linode 98.64.0.0-98.67.71.255,Azure 98.67.128.0/17,Azure 98.70.0.0/15,Azure
+	98.80.0.0/12,AWS 98.130.0.0/15,AWS 99.10.0.0/18,AWS 99.77.0.0/18,AWS 99.77.128.0/18,AWS 99.77.232.0-99.77.254.255,AWS
+	99.78.128.0-99.78.172.255,AWS 99.78.176.0-99.78.199.255,AWS 99.78.208.0-99.78.220.63,AWS 99.78.220.65-99.78.220.66,AWS 99.78.220.128/26,AWS 99.78.228.0-99.82.3.255,AWS
+	99.82.8.0/21,AWS 99.82.128.0/18,AWS 99.83.64.0-99.83.109.255,AWS 99.83.112.0-99.83.123.255,AWS 99.83.128.0-99.84.255.255,AWS 99.86.0.0-99.87.35.255,AWS
+	99.150.0.0/17,AWS 99.151.64.0-99.151.175.255,AWS 99.151.184.0/21,AWS 99.181.64.0/18,AWS 99.200.0.0/13,AWS 100.20.0.0-100.31.255.255,AWS
+	100.48.0.0/12,AWS 101.37.0.0/16,Alibaba 101.132.0.0/15,Alibaba 101.200.0.0/15,Alibaba 102.37.0.0-102.37.26.63,Azure 102.37.32.0-102.37.167.255,Azure
+	102.37.176.0-102.37.255.255,Azure 102.133.0.0-102.133.47.255,Azure 102.133.56.0-102.133.112.15,Azure 102.133.120.0-102.133.240.191,Azure 102.133.248.0/21,Azure 103.3.60.0/22,Linode
+	103.4.8.0/21,AWS 103.5.12.0/22,OVH 103.8.172.0/22,AWS 103.13.188.0/23,AWS 103.25.156.0/24,Azure 103.29.68.0/22,Linode
+	103.36.96.0/24,Azure 103.52.196.0/22,Alibaba 103.53.48.0/22,AWS 103.81.186.0/23,Alibaba 103.82.16.0/22,OVH 103.135.210.0/23,Alibaba
+	103.166.228.0/24,OVH 103.167.178.0/23,OVH 103.168.196.0/23,OVH 103.170.116.0/23,OVH 103.173.12.0/23,Alibaba 103.189.191.0/24,OVH
+	103.199.80.0/24,OVH 103.206.40.0/22,Alibaba 103.206.156.0/23,OVH 103.239.50.0/24,OVH 103.244.227.0/24,OVH 103.246.148.0/22,AWS
+	103.253.145.0-103.253.147.255,DigitalOcean 103.255.140.0/23,Azure 104.40.0.0/14,Azure 104.44.88.0/21,Azure 104.44.128.0/18,Azure 104.45.0.0-104.46.15.255,Azure
+	104.46.24.0-104.46.127.255,Azure 104.46.160.0-104.46.239.255,Azure 104.47.0.1-104.47.0.3,Azure 104.47.1.1-104.47.1.3,Azure 104.47.2.1-104.47.2.3,Azure 104.47.3.0/24,Azure
+	104.47.4.1-104.47.4.3,Azure 104.47.5.0-104.47.7.255,Azure 104.47.8.1-104.47.8.3,Azure 104.47.9.1-104.47.9.3,Azure 104.47.10.1-104.47.10.3,Azure 104.47.11.0/24,Azure
+	104.47.15.0-104.47.19.255,Azure 104.47.22.0-104.47.31.255,Azure 104.47.32.1-104.47.32.3,Azure 104.47.33.1-104.47.33.3,Azure 104.47.34.0/24,Azure 104.47.38.1-104.47.38.3,Azure
+	104.47.39.0/24,Azure 104.47.40.1-104.47.40.3,Azure 104.47.41.1-104.47.41.3,Azure 104.47.42.0/23,Azure 104.47.44.1-104.47.44.3,Azure 104.47.45.1-104.47.45.3,Azure
+	104.47.46.1-104.47.46.3,Azure 104.47.47.0/24,Azure 104.47.48.1-104.47.48.3,Azure 104.47.49.1-104.47.49.3,Azure 104.47.50.0/23,Azure 104.47.52.1-104.47.52.3,Azure
+	104.47.53.1-104.47.53.3,Azure 104.47.54.1-104.47.54.3,Azure 104.47.55.0-104.47.58.255,Azure 104.47.59.128/25,Azure 104.47.60.1-104.47.60.3,Azure 104.47.61.0-104.47.61.191,Azure
+	104.47.62.0-104.47.72.31,Azure 104.47.72.64-104.47.76.191,Azure 104.47.81.0-104.47.85.127,Azure 104.47.92.1-104.47.92.3,Azure 104.47.93.1-104.47.93.3,Azure 104.47.110.0/24,Azure
+	104.47.116.1-104.47.116.3,Azure 104.47.117.1-104.47.117.3,Azure 104.47.118.0/23,Azure 104.47.120.1-104.47.120.3,Azure 104.47.121.1-104.47.121.3,Azure 104.47.124.1-104.47.124.3,Azure
+	104.47.125.1-104.47.125.3,Azure 104.47.127.0-104.47.191.255,Azure 104.47.200.0-104.47.215.255,Azure 104.47.216.64/26,Azure 104.47.217.71/32,Azure 104.47.217.87/32,Azure
+	104.47.217.151/32,Azure 104.47.218.0-104.47.239.255,Azure 104.47.240.167/32,Azure 104.47.240.183/32,Azure 104.47.240.215/32,Azure 104.64.0.0/16,Linode
+	104.66.128.0-104.66.225.255,Linode 104.67.0.0-104.67.95.255,Linode 104.105.0.0/16,Linode 104.111.0.0/18,Linode 104.131.0.0/16,DigitalOcean 104.153.112.0-104.153.116.255,AWS
+	104.153.118.0/24,AWS 104.154.16.0-104.154.111.255,GoogleCloud 104.154.113.0-104.154.121.255,GoogleCloud 104.154.128.0-104.155.239.255,GoogleCloud 104.164.137.0/24,OVH 104.167.16.0/24,OVH
+	104.196.0.0/18,GoogleCloud 104.196.65.0-104.196.71.255,GoogleCloud 104.196.96.0-104.199.63.255,GoogleCloud 104.199.66.0-104.199.239.255,GoogleCloud 104.199.242.0-104.199.255.255,GoogleCloud 104.200.16.0/20,Linode
+	104.208.0.0-104.209.95.255,Azure 104.209.128.0-104.210.15.255,Azure 104.210.32.0-104.210.159.255,Azure 104.210.176.0-104.210.223.255,Azure 104.211.0.0/16,Azure 104.212.67.0-104.212.68.255,Azure
+	104.214.0.0/15,Azure 104.216.0.0/15,AWS 104.222.182.0/24,OVH 104.225.253.0/24,OVH 104.234.50.0/24,OVH 104.234.94.0/24,OVH
+	104.234.135.0/24,OVH 104.234.168.0/24,OVH 104.236.0.0/16,DigitalOcean 104.237.128.0/19,Linode 104.239.79.0/24,OVH 104.248.0.0/16,DigitalOcean
+	104.255.56.0/32,AWS 104.255.56.3/32,AWS 104.255.56.11-104.255.56.12,AWS 104.255.56.15-104.255.56.20,AWS 104.255.56.23-104.255.56.29,AWS 104.255.56.49-104.255.56.52,AWS
+	104.255.56.55-104.255.56.57,AWS 104.255.56.60/32,AWS 104.255.56.63-104.255.56.70,AWS 104.255.57.0/32,AWS 104.255.57.41/32,AWS 104.255.57.98/32,AWS
+	104.255.57.100/30,AWS 104.255.57.164-104.255.57.179,AWS 104.255.58.0/32,AWS 104.255.58.43-104.255.58.44,AWS 104.255.58.63/32,AWS 104.255.58.84/32,AWS
+	104.255.58.91/32,AWS 104.255.58.236/30,AWS 104.255.59.81-104.255.59.83,AWS 104.255.59.85-104.255.59.88,AWS 104.255.59.91/32,AWS 104.255.59.101-104.255.59.106,AWS
+	104.255.59.114/31,AWS 104.255.59.118/31,AWS 104.255.59.122-104.255.59.127,AWS 104.255.59.130-104.255.59.139,AWS 104.255.59.196-104.255.59.201,AWS 104.255.59.206-104.255.59.217,AWS
+	104.255.59.238-104.255.59.243,AWS 104.255.61.0/31,AWS 106.11.0.0/16,Alibaba 106.14.0.0/15,Alibaba 107.20.0.0/14,AWS 107.167.160.0/19,GoogleCloud
+	107.170.0.0/16,DigitalOcean 107.176.0.0/15,AWS 107.178.208.0/20,GoogleCloud 107.178.240.0/20,GoogleCloud 107.189.64.0/18,OVH 108.59.80.0/20,GoogleCloud
+	108.61.0.0/16,Vultr 108.128.0.0-108.139.255.255,AWS 108.140.0.0/14,Azure 108.156.0.0/14,AWS 108.166.224.0-108.166.244.63,AWS 108.166.248.0/21,AWS
+	108.174.65.0/24,OVH 108.175.48.0/20,AWS 109.74.192.0-109.74.206.255,Linode 109.105.195.0/24,OVH 109.110.184.0/24,OVH 109.122.15.0/24,OVH
+	109.122.20.0/24,OVH 109.122.58.0/24,OVH 109.176.40.0-109.176.55.255,OVH 109.176.153.0/24,OVH 109.232.89.0/24,OVH 109.237.24.0/22,Linode
+	110.75.0.0-110.76.63.255,Alibaba 110.173.192.0/19,Alibaba 110.238.2.0/23,AWS 111.13.171.128/25,AWS 111.13.185.32-111.13.185.95,AWS 111.221.28.0/22,Azure
+	111.221.80.0-111.221.111.255,Azure 112.74.0.0/16,Alibaba 112.124.0.0/14,Alibaba 114.55.0.0/16,Alibaba 114.129.44.0/24,OVH 114.215.0.0/16,Alibaba
+	115.28.0.0/15,Alibaba 115.124.16.0/20,Alibaba 116.62.0.0/16,Alibaba 116.129.226.0-116.129.226.191,AWS 116.202.0.0/15,Hetzner 116.251.64.0/18,Alibaba
+	117.18.104.0/24,OVH 118.31.0.0/16,Alibaba 118.178.0.0/16,Alibaba 118.190.0.0/16,Alibaba 118.193.97.64-118.193.97.255,AWS 119.8.0.0/16,HuaweiCloud
+	119.23.0.0/16,Alibaba 119.28.0.0/16,TencentCloud 119.38.208.0/20,Alibaba 119.42.224.0/19,Alibaba 119.147.182.0-119.147.182.191,AWS 120.24.0.0/14,Alibaba
 	120.52.12.64/26,AWS 120.52.22.96/27,AWS 120.52.39.128/27,AWS 120.52.153.192/26,AWS 120.55.0.0/16,Alibaba 120.76.0.0/14,Alibaba
-	120.232.236.0/25,AWS 120.232.236.128/26,AWS 120.253.240.192/26,AWS 120.253.241.160/27,AWS 120.253.245.128/26,AWS 120.253.245.192/27,AWS
-	121.0.16.0/20,Alibaba 121.40.0.0/14,Alibaba 121.89.0.0/16,Alibaba 121.91.98.0/23,AWS 121.196.0.0/14,Alibaba 122.200.61.0/24,AWS
-	122.248.192.0/18,AWS 123.56.0.0/15,Alibaba 123.100.227.0/24,OVH 128.0.118.0/24,OVH 128.24.0.0/16,Azure 128.85.0.0/16,Azure
-	128.140.0.0/17,Hetzner 128.199.0.0/16,DigitalOcean 128.203.0.0/16,Azure 128.251.0.0/16,Azure 129.80.0.0/16,Oracle 129.91.0.0/22,Oracle
-	129.91.4.0/23,Oracle 129.91.16.0/21,Oracle 129.144.0.0/16,Oracle 129.145.16.0/21,Oracle 129.145.74.0/23,Oracle 129.145.76.0/22,Oracle
-	129.146.0.0/21,Oracle 129.146.8.0/22,Oracle 129.146.12.128/25,Oracle 129.146.13.128/25,Oracle 129.146.14.128/25,Oracle 129.146.16.0/20,Oracle
-	129.146.32.0/19,Oracle 129.146.64.0/18,Oracle 129.146.128.0/17,Oracle 129.148.0.0/18,Oracle 129.148.128.0/23,Oracle 129.148.132.0/22,Oracle
-	129.148.144.0/23,Oracle 129.148.148.0/25,Oracle 129.148.148.192/26,Oracle 129.148.150.0/23,Oracle 129.148.152.0/23,Oracle 129.148.156.0/22,Oracle
-	129.148.160.0/23,Oracle 129.148.164.0/25,Oracle 129.148.166.0/23,Oracle 129.148.168.0/21,Oracle 129.148.176.0/22,Oracle 129.148.180.0/25,Oracle
-	129.148.184.0/22,Oracle 129.148.200.0/21,Oracle 129.148.208.0/22,Oracle 129.148.212.0/23,Oracle 129.148.214.0/24,Oracle 129.148.215.0/25,Oracle
-	129.148.218.0/24,Oracle 129.148.219.192/26,Oracle 129.148.220.0/22,Oracle 129.149.0.0/22,Oracle 129.149.6.0/25,Oracle 129.149.16.0/23,Oracle
-	129.149.20.0/23,Oracle 129.149.22.0/25,Oracle 129.149.22.192/26,Oracle 129.149.23.0/24,Oracle 129.149.28.0/22,Oracle 129.149.32.0/23,Oracle
-	129.149.36.0/22,Oracle 129.149.48.0/22,Oracle 129.149.52.0/25,Oracle 129.149.56.0/22,Oracle 129.149.63.192/26,Oracle 129.149.64.0/22,Oracle
-	129.149.68.0/25,Oracle 129.149.80.0/22,Oracle 129.149.84.0/25,Oracle 129.149.96.0/22,Oracle 129.149.100.0/25,Oracle 129.149.112.0/22,Oracle
-	129.149.118.0/25,Oracle 129.149.120.0/22,Oracle 129.149.126.0/25,Oracle 129.150.32.0/19,Oracle 129.150.64.0/18,Oracle 129.150.128.0/17,Oracle
-	129.151.0.0/16,Oracle 129.152.0.0/19,Oracle 129.152.40.0/22,Oracle 129.152.128.0/19,Oracle 129.153.0.0/17,Oracle 129.153.128.0/18,Oracle
-	129.153.192.0/19,Oracle 129.153.224.0/20,Oracle 129.153.240.0/23,Oracle 129.153.243.192/26,Oracle 129.154.32.0/19,Oracle 129.154.64.0/18,Oracle
-	129.154.168.0/22,Oracle 129.154.192.0/18,Oracle 129.156.0.0/20,Oracle 129.157.224.0/21,Oracle 129.158.32.0/19,Oracle 129.158.64.0/18,Oracle
-	129.158.128.0/17,Oracle 129.159.0.0/16,Oracle 129.191.0.0/17,Oracle 129.212.132.0/22,DigitalOcean 129.212.136.0/21,DigitalOcean 129.212.144.0/20,DigitalOcean
-	129.212.160.0/21,DigitalOcean 129.212.168.0/22,DigitalOcean 129.212.172.0/23,DigitalOcean 129.212.176.0/20,DigitalOcean 129.212.192.0/18,DigitalOcean 129.213.0.128/25,Oracle
-	129.213.2.128/25,Oracle 129.213.4.128/25,Oracle 129.213.8.0/21,Oracle 129.213.16.0/20,Oracle 129.213.32.0/19,Oracle 129.213.64.0/18,Oracle
-	129.213.128.0/18,Oracle 129.213.192.0/20,Oracle 129.213.208.0/21,Oracle 129.225.0.0/20,Oracle 129.225.32.0/19,Oracle 129.225.128.0/17,Oracle
-	130.33.0.0/16,Azure 130.35.0.0/22,Oracle 130.35.16.0/22,Oracle 130.35.96.0/21,Oracle 130.35.112.0/22,Oracle 130.35.116.0/25,Oracle
-	130.35.128.0/22,Oracle 130.35.144.0/22,Oracle 130.35.200.0/22,Oracle 130.35.228.0/22,Oracle 130.61.0.128/25,Oracle 130.61.2.128/25,Oracle
-	130.61.4.128/25,Oracle 130.61.8.0/21,Oracle 130.61.16.0/20,Oracle 130.61.32.0/19,Oracle 130.61.64.0/18,Oracle 130.61.128.0/17,Oracle
-	130.107.0.0/16,Azure 130.110.0.0/20,Oracle 130.110.16.0/21,Oracle 130.110.24.0/22,Oracle 130.110.28.0/23,Oracle 130.110.31.0/24,Oracle
-	130.110.32.0/19,Oracle 130.110.64.0/18,Oracle 130.110.224.0/21,Oracle 130.110.232.0/22,Oracle 130.110.238.0/23,Oracle 130.110.240.0/20,Oracle
-	130.131.0.0/16,Azure 130.162.32.0/19,Oracle 130.162.64.0/18,Oracle 130.162.128.0/18,Oracle 130.162.192.0/21,Oracle 130.162.208.0/20,Oracle
-	130.162.224.0/19,Oracle 130.176.0.0/17,AWS 130.176.128.0/18,AWS 130.176.192.0/19,AWS 130.176.224.0/20,AWS 130.176.254.0/23,AWS
-	130.210.0.0/17,Oracle 130.211.4.0/22,GoogleCloud 130.211.8.0/21,GoogleCloud 130.211.16.0/20,GoogleCloud 130.211.32.0/19,GoogleCloud 130.211.64.0/18,GoogleCloud
-	130.211.128.0/17,GoogleCloud 130.213.0.0/16,Azure 131.145.0.0/17,Azure 131.145.128.0/18,Azure 131.163.0.0/17,Azure 131.186.0.0/21,Oracle
-	131.186.8.0/22,Oracle 131.186.12.0/25,Oracle 131.186.16.0/20,Oracle 131.186.32.0/20,Oracle 131.186.56.0/21,Oracle 131.189.0.0/16,Azure
-	131.253.3.0/24,Azure 131.253.12.0/29,Azure 131.253.12.16/28,Azure 131.253.12.40/29,Azure 131.253.12.48/29,Azure 131.253.12.80/28,Azure
-	131.253.12.160/28,Azure 131.253.12.192/27,Azure 131.253.12.224/29,Azure 131.253.12.240/28,Azure 131.253.13.0/26,Azure 131.253.13.72/29,Azure
-	131.253.13.80/29,Azure 131.253.13.88/30,Azure 131.253.13.96/29,Azure 131.253.13.104/30,Azure 131.253.13.128/27,Azure 131.253.14.4/30,Azure
-	131.253.14.8/31,Azure 131.253.14.16/28,Azure 131.253.14.32/27,Azure 131.253.14.96/27,Azure 131.253.14.128/26,Azure 131.253.14.192/29,Azure
-	131.253.14.208/28,Azure 131.253.14.224/28,Azure 131.253.14.248/29,Azure 131.253.15.8/29,Azure 131.253.15.16/28,Azure 131.253.15.32/27,Azure
-	131.253.15.192/26,Azure 131.253.21.0/24,Azure 131.253.24.0/28,Azure 131.253.24.160/27,Azure 131.253.24.192/26,Azure 131.253.25.0/24,Azure
-	131.253.27.0/24,Azure 131.253.33.0/24,Azure 131.253.34.224/27,Azure 131.253.35.128/25,Azure 131.253.36.128/26,Azure 131.253.36.224/27,Azure
-	131.253.38.0/26,Azure 131.253.38.128/26,Azure 131.253.38.224/27,Azure 131.253.40.0/27,Azure 131.253.40.32/28,Azure 131.253.40.64/26,Azure
-	131.253.40.128/27,Azure 131.253.40.160/28,Azure 131.253.40.192/26,Azure 131.253.41.0/24,Azure 132.145.0.128/25,Oracle 132.145.2.128/25,Oracle
-	132.145.4.128/25,Oracle 132.145.8.0/21,Oracle 132.145.16.0/20,Oracle 132.145.32.0/19,Oracle 132.145.64.0/18,Oracle 132.145.128.0/17,Oracle
-	132.164.0.0/16,Azure 132.196.0.0/16,Azure 132.220.0.0/16,Azure 132.226.0.0/17,Oracle 132.226.128.0/21,Oracle 132.226.144.0/20,Oracle
-	132.226.160.0/19,Oracle 132.226.192.0/18,Oracle 132.243.197.0/24,OVH 132.245.230.0/23,Azure 134.33.0.0/16,Azure 134.65.16.0/20,Oracle
-	134.65.48.0/22,Oracle 134.65.52.128/25,Oracle 134.65.56.0/21,Oracle 134.65.208.0/20,Oracle 134.65.224.0/19,Oracle 134.70.8.0/21,Oracle
-	134.70.16.0/22,Oracle 134.70.24.0/21,Oracle 134.70.32.0/22,Oracle 134.70.40.0/21,Oracle 134.70.48.0/22,Oracle 134.70.56.0/21,Oracle
-	134.70.64.0/22,Oracle 134.70.72.0/21,Oracle 134.70.80.0/20,Oracle 134.70.96.0/19,Oracle 134.70.128.0/18,Oracle 134.70.192.0/19,Oracle
-	134.70.224.0/23,Oracle 134.70.230.0/23,Oracle 134.70.232.0/23,Oracle 134.98.128.0/19,Oracle 134.98.192.0/19,Oracle 134.98.248.0/26,Oracle
-	134.98.248.128/25,Oracle 134.98.249.192/26,Oracle 134.98.250.0/23,Oracle 134.98.252.0/22,Oracle 134.112.0.0/16,Azure 134.122.0.0/17,DigitalOcean
-	134.138.0.0/16,Azure 134.149.0.0/16,Azure 134.170.176.0/24,Azure 134.170.192.0/21,Azure 134.170.220.0/23,Azure 134.170.222.0/24,Azure
-	134.185.64.0/22,Oracle 134.185.72.0/22,Oracle 134.185.76.0/24,Oracle 134.185.80.0/20,Oracle 134.185.96.0/19,Oracle 134.195.148.0/23,OVH
-	134.199.130.0/23,DigitalOcean 134.199.132.0/22,DigitalOcean 134.199.136.0/21,DigitalOcean 134.199.144.0/20,DigitalOcean 134.199.160.0/19,DigitalOcean 134.199.192.0/18,DigitalOcean
-	134.209.0.0/16,DigitalOcean 135.13.0.0/16,Azure 135.18.0.0/16,Azure 135.116.0.0/16,Azure 135.119.0.0/16,Azure 135.125.0.0/16,OVH
-	135.130.0.0/16,Azure 135.148.0.0/16,OVH 135.149.0.0/16,Azure 135.171.0.0/16,Azure 135.181.0.0/16,Hetzner 135.220.0.0/16,Azure
-	135.222.0.0/16,Azure 135.224.0.0/15,Azure 135.232.0.0/14,Azure 135.236.0.0/15,Azure 136.0.95.0/24,OVH 136.8.0.0/15,AWS
-	136.18.0.0/21,AWS 136.18.18.0/23,AWS 136.18.20.0/22,AWS 136.18.32.0/23,AWS 136.18.34.0/24,AWS 136.18.56.0/21,AWS
-	136.18.128.0/19,AWS 136.18.160.0/22,AWS 136.18.164.0/23,AWS 136.18.168.0/21,AWS 136.18.254.0/23,AWS 136.23.64.0/18,GoogleCloud
-	136.64.0.0/13,GoogleCloud 136.72.0.0/14,GoogleCloud 136.77.0.0/16,GoogleCloud 136.79.0.0/16,GoogleCloud 136.80.0.0/12,GoogleCloud 136.107.0.0/16,GoogleCloud
-	136.108.0.0/14,GoogleCloud 136.112.0.0/13,GoogleCloud 136.143.200.0/24,OVH 136.243.0.0/16,Hetzner 136.248.64.0/18,Oracle 136.248.128.0/19,Oracle
-	136.248.192.0/19,Oracle 136.248.224.0/26,Oracle 136.248.224.128/25,Oracle 136.248.225.192/26,Oracle 136.248.226.0/23,Oracle 136.248.228.0/22,Oracle
-	136.248.232.0/26,Oracle 136.248.232.128/25,Oracle 136.248.233.192/26,Oracle 136.248.234.0/23,Oracle 136.248.236.0/22,Oracle 136.248.240.0/21,Oracle
-	137.23.0.0/18,Oracle 137.74.0.0/16,OVH 137.83.50.0/24,OVH 137.116.0.0/18,Azure 137.116.64.0/19,Azure 137.116.96.0/22,Azure
-	137.116.112.0/20,Azure 137.116.128.0/17,Azure 137.117.0.0/16,Azure 137.131.0.0/18,Oracle 137.131.128.0/17,Oracle 137.135.0.0/16,Azure
-	137.184.0.0/17,DigitalOcean 137.184.128.0/18,DigitalOcean 137.184.192.0/19,DigitalOcean 137.184.224.0/20,DigitalOcean 137.184.240.0/21,DigitalOcean 137.184.248.0/22,DigitalOcean
-	137.184.254.0/23,DigitalOcean 138.1.0.0/22,Oracle 138.1.16.0/22,Oracle 138.1.32.0/20,Oracle 138.1.48.0/21,Oracle 138.1.64.0/22,Oracle
-	138.1.80.0/22,Oracle 138.1.108.0/25,Oracle 138.1.112.0/20,Oracle 138.2.0.0/17,Oracle 138.2.128.0/18,Oracle 138.2.208.0/20,Oracle
-	138.2.224.0/20,Oracle 138.2.240.0/21,Oracle 138.3.208.0/20,Oracle 138.3.240.0/20,Oracle 138.68.0.0/19,DigitalOcean 138.68.36.0/22,DigitalOcean
-	138.68.40.0/21,DigitalOcean 138.68.48.0/20,DigitalOcean 138.68.64.0/18,DigitalOcean 138.68.128.0/17,DigitalOcean 138.91.0.0/16,Azure 138.197.0.0/17,DigitalOcean
-	138.197.128.0/18,DigitalOcean 138.197.192.0/19,DigitalOcean 138.197.224.0/20,DigitalOcean 138.197.240.0/22,DigitalOcean 138.197.252.0/22,DigitalOcean 138.199.128.0/17,Hetzner
-	138.201.0.0/16,Hetzner 139.56.16.0/20,AWS 139.56.32.0/23,AWS 139.56.34.0/24,AWS 139.59.0.0/16,DigitalOcean 139.95.0.0/20,Alibaba
-	139.95.16.0/22,Alibaba 139.95.22.0/23,Alibaba 139.95.24.0/21,Alibaba 139.95.32.0/21,Alibaba 139.95.40.0/23,Alibaba 139.95.64.0/22,Alibaba
-	139.95.96.0/22,Alibaba 139.95.128.0/21,Alibaba 139.95.144.0/20,Alibaba 139.95.160.0/19,Alibaba 139.95.192.0/18,Alibaba 139.99.0.0/16,OVH
-	139.129.0.0/16,Alibaba 139.144.0.0/17,Linode 139.144.132.0/22,Linode 139.144.143.0/24,Linode 139.144.144.0/20,Linode 139.144.160.0/19,Linode
-	139.144.192.0/19,Linode 139.144.224.0/20,Linode 139.144.240.0/22,Linode 139.144.247.0/24,Linode 139.144.248.0/21,Linode 139.162.1.0/24,Linode
-	139.162.2.0/23,Linode 139.162.4.0/22,Linode 139.162.8.0/21,Linode 139.162.16.0/20,Linode 139.162.32.0/19,Linode 139.162.65.0/24,Linode
-	139.162.66.0/23,Linode 139.162.68.0/22,Linode 139.162.72.0/21,Linode 139.162.80.0/20,Linode 139.162.96.0/19,Linode 139.162.128.0/24,Linode
-	139.162.130.0/23,Linode 139.162.132.0/22,Linode 139.162.136.0/21,Linode 139.162.144.0/20,Linode 139.162.160.0/19,Linode 139.162.192.0/18,Linode
-	139.177.96.0/21,Oracle 139.177.104.0/22,Oracle 139.177.108.0/25,Oracle 139.177.176.0/20,Linode 139.177.192.0/20,Linode 139.185.32.0/19,Oracle
-	139.196.0.0/16,Alibaba 139.224.0.0/16,Alibaba 140.83.32.0/21,Oracle 140.83.44.0/22,Oracle 140.83.48.0/20,Oracle 140.83.80.0/21,Oracle
-	140.84.160.0/19,Oracle 140.86.0.0/20,Oracle 140.86.32.0/20,Oracle 140.86.48.0/21,Oracle 140.86.62.0/23,Oracle 140.86.64.0/20,Oracle
-	140.86.96.0/23,Oracle 140.86.156.0/23,Oracle 140.86.192.0/19,Oracle 140.91.4.0/22,Oracle 140.91.8.0/21,Oracle 140.91.16.0/20,Oracle
-	140.91.32.0/19,Oracle 140.91.64.0/19,Oracle 140.91.96.0/21,Oracle 140.91.104.0/23,Oracle 140.91.108.0/23,Oracle 140.179.0.0/16,AWS
-	140.204.0.128/25,Oracle 140.204.4.128/25,Oracle 140.204.8.128/25,Oracle 140.204.12.128/25,Oracle 140.204.16.128/25,Oracle 140.204.20.128/25,Oracle
-	140.204.24.128/25,Oracle 140.204.30.128/25,Oracle 140.204.34.128/25,Oracle 140.204.36.128/25,Oracle 140.204.38.128/25,Oracle 140.204.40.128/25,Oracle
-	140.204.42.128/25,Oracle 140.204.46.128/25,Oracle 140.204.50.128/25,Oracle 140.204.52.128/25,Oracle 140.204.54.128/25,Oracle 140.204.58.128/25,Oracle
-	140.204.66.128/25,Oracle 140.204.70.128/25,Oracle 140.204.76.128/25,Oracle 140.204.80.128/25,Oracle 140.204.84.0/23,Oracle 140.204.86.128/25,Oracle
-	140.204.92.128/25,Oracle 140.204.96.128/25,Oracle 140.204.100.128/25,Oracle 140.204.104.128/25,Oracle 140.204.108.128/25,Oracle 140.204.112.128/25,Oracle
-	140.204.116.128/25,Oracle 140.204.120.128/25,Oracle 140.204.122.128/25,Oracle 140.204.124.128/25,Oracle 140.204.126.128/25,Oracle 140.204.132.128/25,Oracle
-	140.204.136.128/25,Oracle 140.204.142.128/25,Oracle 140.204.146.128/25,Oracle 140.204.150.128/25,Oracle 140.204.154.128/25,Oracle 140.204.158.128/25,Oracle
-	140.204.166.128/25,Oracle 140.205.0.0/16,Alibaba 140.233.177.0/24,OVH 140.238.0.0/16,Oracle 140.245.0.0/17,Oracle 140.245.192.0/18,Oracle
-	141.0.169.0/24,DigitalOcean 141.0.170.0/24,DigitalOcean 141.8.242.0/24,OVH 141.11.1.0/24,OVH 141.11.18.0/24,OVH 141.11.21.0/24,OVH
-	141.11.32.0/24,OVH 141.11.39.0/24,OVH 141.11.40.0/24,OVH 141.11.45.0/24,OVH 141.11.74.0/23,OVH 141.11.107.0/24,OVH
-	141.11.187.0/24,OVH 141.11.250.0/24,OVH 141.94.0.0/15,OVH 141.144.32.0/19,Oracle 141.144.84.0/22,Oracle 141.144.96.0/19,Oracle
-	141.144.192.0/18,Oracle 141.145.40.0/22,Oracle 141.145.112.0/20,Oracle 141.145.144.0/20,Oracle 141.145.192.0/19,Oracle 141.147.0.0/17,Oracle
-	141.147.128.0/18,Oracle 141.147.240.0/20,Oracle 141.148.0.0/18,Oracle 141.148.64.0/19,Oracle 141.148.128.0/17,Oracle 141.227.128.0/21,OVH
-	141.227.136.0/22,OVH 141.227.140.0/24,OVH 141.227.142.0/24,OVH 141.227.148.0/22,OVH 141.227.152.0/24,OVH 141.227.154.0/24,OVH
-	141.227.156.0/24,OVH 141.227.158.0/24,OVH 141.227.160.0/24,OVH 141.227.162.0/24,OVH 141.227.164.0/23,OVH 141.227.166.0/24,OVH
-	141.227.168.0/24,OVH 141.227.170.0/24,OVH 141.227.172.0/24,OVH 141.227.174.0/24,OVH 141.227.176.0/24,OVH 141.227.178.0/24,OVH
-	141.227.180.0/24,OVH 141.227.186.0/24,OVH 141.227.188.0/23,OVH 141.227.190.0/24,OVH 141.230.0.0/15,AWS 141.253.96.0/19,Oracle
-	141.253.192.0/19,Oracle 142.0.160.0/21,Oracle 142.4.177.0/24,AWS 142.4.178.0/23,AWS 142.4.180.0/24,AWS 142.4.192.0/19,OVH
-	142.44.128.0/17,OVH 142.93.0.0/16,DigitalOcean 142.111.85.0/24,OVH 142.132.128.0/17,Hetzner 142.249.92.0/24,OVH 142.252.51.0/24,OVH
-	142.252.115.0/24,OVH 142.252.127.0/24,OVH 143.14.59.0/24,OVH 143.14.231.0/24,OVH 143.20.66.0/24,OVH 143.20.195.0/24,OVH
-	143.20.215.0/24,OVH 143.42.0.0/17,Linode 143.42.128.0/19,Linode 143.42.160.0/21,Linode 143.42.168.0/22,Linode 143.42.172.0/23,Linode
-	143.42.178.0/23,Linode 143.42.182.0/23,Linode 143.42.184.0/21,Linode 143.42.192.0/18,Linode 143.47.32.0/19,Oracle 143.47.96.0/19,Oracle
-	143.47.176.0/20,Oracle 143.47.224.0/19,Oracle 143.109.54.0/24,OVH 143.110.128.0/17,DigitalOcean 143.198.0.0/17,DigitalOcean 143.198.128.0/18,DigitalOcean
-	143.198.192.0/19,DigitalOcean 143.198.224.0/20,DigitalOcean 143.198.240.0/21,DigitalOcean 143.198.248.0/22,DigitalOcean 143.204.0.0/16,AWS 143.244.128.0/18,DigitalOcean
-	143.244.196.0/22,DigitalOcean 143.244.200.0/21,DigitalOcean 143.244.208.0/21,DigitalOcean 143.244.218.0/24,DigitalOcean 143.244.220.0/22,DigitalOcean 144.2.32.0/19,OVH
-	144.21.32.0/19,Oracle 144.21.64.0/18,Oracle 144.22.32.0/19,Oracle 144.22.64.0/18,Oracle 144.22.128.0/17,Oracle 144.24.0.0/16,Oracle
-	144.31.149.0/24,OVH 144.33.0.0/19,Oracle 144.76.0.0/16,Hetzner 144.126.192.0/18,DigitalOcean 144.217.0.0/16,OVH 144.220.0.0/16,AWS
-	144.225.52.0/24,OVH 144.225.123.0/24,OVH 144.225.127.0/24,OVH 144.225.162.0/24,OVH 144.225.178.0/24,OVH 145.79.151.0/24,OVH
-	145.132.0.0/16,Azure 145.133.0.0/17,Azure 145.190.0.0/18,Azure 145.190.64.0/20,Azure 145.190.80.0/22,Azure 145.190.84.0/23,Azure
-	145.190.128.0/17,Azure 145.191.0.0/16,Azure 145.239.0.0/16,OVH 145.241.96.0/19,Oracle 145.241.144.0/20,Oracle 145.241.160.0/20,Oracle
-	145.241.178.0/23,Oracle 145.241.180.0/22,Oracle 145.241.184.0/21,Oracle 145.241.192.0/18,Oracle 146.56.32.0/20,Oracle 146.56.48.0/21,Oracle
-	146.56.61.192/26,Oracle 146.56.96.0/20,Oracle 146.56.112.0/21,Oracle 146.56.120.0/22,Oracle 146.56.124.64/26,Oracle 146.56.124.128/25,Oracle
-	146.56.125.0/24,Oracle 146.56.126.0/23,Oracle 146.56.128.0/18,Oracle 146.59.0.0/16,OVH 146.103.10.0/24,OVH 146.148.2.0/23,GoogleCloud
-	146.148.4.0/22,GoogleCloud 146.148.8.0/21,GoogleCloud 146.148.16.0/20,GoogleCloud 146.148.32.0/19,GoogleCloud 146.148.64.0/18,GoogleCloud 146.181.16.0/20,Oracle
-	146.181.32.0/19,Oracle 146.185.128.0/18,DigitalOcean 146.190.0.0/17,DigitalOcean 146.190.128.0/19,DigitalOcean 146.190.160.0/20,DigitalOcean 146.190.176.0/22,DigitalOcean
-	146.190.184.0/21,DigitalOcean 146.190.192.0/18,DigitalOcean 146.235.0.0/18,Oracle 146.235.192.0/19,Oracle 146.235.224.0/20,Oracle 146.235.240.0/21,Oracle
-	146.235.251.192/26,Oracle 146.235.252.64/26,Oracle 146.235.252.128/25,Oracle 146.235.253.0/24,Oracle 146.235.254.0/23,Oracle 147.5.20.0/22,Oracle
-	147.5.28.0/22,Oracle 147.5.64.0/18,Oracle 147.5.128.0/17,Oracle 147.15.0.0/17,Oracle 147.15.128.0/19,Oracle 147.15.176.0/23,Oracle
-	147.15.184.0/21,Oracle 147.15.192.0/18,Oracle 147.135.0.0/16,OVH 147.139.0.0/16,Alibaba 147.154.0.0/19,Oracle 147.154.32.0/25,Oracle
-	147.154.36.0/22,Oracle 147.154.40.0/21,Oracle 147.154.48.0/21,Oracle 147.154.56.0/22,Oracle 147.154.96.0/20,Oracle 147.154.112.0/21,Oracle
-	147.154.120.0/22,Oracle 147.154.128.0/19,Oracle 147.154.160.0/20,Oracle 147.154.176.0/21,Oracle 147.154.184.0/22,Oracle 147.154.189.128/25,Oracle
-	147.154.224.0/20,Oracle 147.154.240.0/21,Oracle 147.154.255.128/25,Oracle 147.182.128.0/17,DigitalOcean 147.224.8.0/21,Oracle 147.224.32.0/19,Oracle
-	147.224.64.0/24,Oracle 147.224.66.0/23,Oracle 147.224.68.0/22,Oracle 147.224.128.0/17,Oracle 147.243.0.0/16,Azure 148.113.0.0/18,OVH
-	148.113.70.0/24,OVH 148.113.72.0/24,OVH 148.113.128.0/17,OVH 148.116.0.0/19,Oracle 148.116.32.0/26,Oracle 148.116.32.128/25,Oracle
-	148.116.33.192/26,Oracle 148.116.34.0/23,Oracle 148.116.36.0/22,Oracle 148.116.40.0/22,Oracle 148.116.44.128/25,Oracle 148.116.48.0/23,Oracle
-	148.116.64.0/19,Oracle 148.116.104.0/21,Oracle 148.116.112.0/22,Oracle 148.135.192.0/24,OVH 148.222.40.0/22,OVH 148.222.120.0/22,OVH
-	148.251.0.0/16,Hetzner 149.5.230.0/24,OVH 149.18.101.0/24,OVH 149.56.0.0/16,OVH 149.118.32.0/19,Oracle 149.118.64.0/20,Oracle
-	149.118.92.0/22,Oracle 149.118.240.0/23,Oracle 149.128.64.0/18,AWS 149.129.0.0/20,Alibaba 149.129.16.0/21,Alibaba 149.129.32.0/19,Alibaba
-	149.129.64.0/18,Alibaba 149.129.128.0/17,Alibaba 149.130.136.0/23,Oracle 149.130.160.0/19,Oracle 149.130.208.0/20,Oracle 149.130.224.0/19,Oracle
-	149.134.179.0/24,Alibaba 149.202.0.0/16,OVH 150.102.0.0/15,AWS 150.136.0.0/16,Oracle 150.171.1.16/28,Azure 150.171.22.0/23,Azure
-	150.171.24.0/22,Azure 150.171.28.0/23,Azure 150.171.30.0/24,Azure 150.171.32.0/19,Azure 150.171.65.0/24,Azure 150.171.66.0/23,Azure
-	150.171.69.0/24,Azure 150.171.70.0/23,Azure 150.171.72.0/21,Azure 150.171.82.0/23,Azure 150.171.84.0/22,Azure 150.171.88.0/23,Azure
-	150.171.97.0/24,Azure 150.171.98.0/23,Azure 150.171.100.0/22,Azure 150.171.104.0/21,Azure 150.171.112.0/22,Azure 150.222.0.0/21,AWS
-	150.222.8.0/22,AWS 150.222.12.0/23,AWS 150.222.14.0/24,AWS 150.222.15.124/30,AWS 150.222.15.128/30,AWS 150.222.15.132/31,AWS
-	150.222.24.32/29,AWS 150.222.24.64/29,AWS 150.222.25.32/29,AWS 150.222.26.0/23,AWS 150.222.28.0/22,AWS 150.222.32.0/21,AWS
-	150.222.40.0/22,AWS 150.222.44.0/24,AWS 150.222.45.0/26,AWS 150.222.45.64/27,AWS 150.222.45.128/25,AWS 150.222.46.0/23,AWS
-	150.222.48.0/21,AWS 150.222.56.0/27,AWS 150.222.64.0/22,AWS 150.222.68.116/31,AWS 150.222.69.0/24,AWS 150.222.70.0/23,AWS
-	150.222.72.0/21,AWS 150.222.80.0/20,AWS 150.222.96.0/20,AWS 150.222.112.0/21,AWS 150.222.120.0/22,AWS 150.222.129.0/24,AWS
-	150.222.133.0/24,AWS 150.222.134.0/23,AWS 150.222.136.0/23,AWS 150.222.138.0/24,AWS 150.222.139.116/30,AWS 150.222.139.120/29,AWS
-	150.222.140.0/22,AWS 150.222.144.32/29,AWS 150.222.144.64/29,AWS 150.222.144.96/29,AWS 150.222.152.32/29,AWS 150.222.152.64/29,AWS
-	150.222.152.96/29,AWS 150.222.160.32/29,AWS 150.222.164.208/29,AWS 150.222.164.220/31,AWS 150.222.164.222/32,AWS 150.222.168.32/29,AWS
-	150.222.176.0/22,AWS 150.222.180.0/24,AWS 150.222.182.14/31,AWS 150.222.182.16/31,AWS 150.222.196.0/24,AWS 150.222.199.0/25,AWS
-	150.222.200.60/31,AWS 150.222.202.0/23,AWS 150.222.204.0/22,AWS 150.222.208.0/24,AWS 150.222.210.0/23,AWS 150.222.212.0/22,AWS
-	150.222.216.0/21,AWS 150.222.224.0/24,AWS 150.222.226.0/23,AWS 150.222.228.0/22,AWS 150.222.232.0/23,AWS 150.222.234.0/26,AWS
-	150.222.234.64/28,AWS 150.222.234.80/29,AWS 150.222.234.96/27,AWS 150.222.234.128/28,AWS 150.222.235.0/24,AWS 150.222.236.0/22,AWS
-	150.222.242.84/31,AWS 150.222.242.214/31,AWS 150.222.245.122/31,AWS 150.222.252.244/30,AWS 150.222.252.248/30,AWS 150.230.0.0/20,Oracle
-	150.230.20.0/22,Oracle 150.230.24.0/21,Oracle 150.230.32.0/19,Oracle 150.230.64.0/18,Oracle 150.230.128.0/17,Oracle 150.247.32.0/22,AWS
-	150.247.36.0/23,AWS 150.247.39.0/24,AWS 150.247.40.0/21,AWS 151.80.0.0/16,OVH 151.106.160.0/19,Oracle 151.145.32.0/19,Oracle
-	151.145.64.0/19,Oracle 151.148.8.0/21,AWS 151.148.16.0/30,AWS 151.148.16.4/31,AWS 151.148.16.6/32,AWS 151.148.16.8/30,AWS
-	151.148.17.0/24,AWS 151.148.18.0/23,AWS 151.148.20.0/24,AWS 151.148.32.0/21,AWS 151.148.40.0/23,AWS 151.206.64.0/19,Azure
-	151.206.96.0/20,Azure 151.206.112.0/23,Azure 151.206.128.0/20,Azure 151.206.144.0/21,Azure 151.206.152.0/22,Azure 151.206.156.0/23,Azure
-	151.206.159.0/24,Azure 151.206.160.0/24,Azure 151.206.162.0/24,Azure 151.206.164.0/22,Azure 151.206.168.0/21,Azure 151.206.176.0/20,Azure
-	151.206.192.0/23,Azure 151.206.194.0/24,Azure 151.236.216.0/22,Linode 151.236.220.0/23,Linode 151.236.222.0/24,Linode 151.240.1.0/24,OVH
+	120.232.236.0-120.232.236.191,AWS 120.253.240.192/26,AWS 120.253.241.160/27,AWS 120.253.245.128-120.253.245.223,AWS 121.0.16.0/20,Alibaba 121.40.0.0/14,Alibaba
+	121.89.0.0/16,Alibaba 121.91.98.0/23,AWS 121.196.0.0/14,Alibaba 122.200.61.0/24,AWS 122.248.192.0/18,AWS 123.56.0.0/15,Alibaba
+	123.100.227.0/24,OVH 128.0.118.0/24,OVH 128.24.0.0/16,Azure 128.85.0.0/16,Azure 128.140.0.0/17,Hetzner 128.199.0.0/16,DigitalOcean
+	128.203.0.0/16,Azure 128.251.0.0/16,Azure 129.80.0.0/16,Oracle 129.91.0.0-129.91.5.255,Oracle 129.91.16.0/21,Oracle 129.144.0.0/16,Oracle
+	129.145.16.0/21,Oracle 129.145.74.0-129.145.79.255,Oracle 129.146.0.0-129.146.11.255,Oracle 129.146.12.128/25,Oracle 129.146.13.128/25,Oracle 129.146.14.128/25,Oracle
+	129.146.16.0-129.146.255.255,Oracle 129.148.0.0/18,Oracle 129.148.128.0/23,Oracle 129.148.132.0/22,Oracle 129.148.144.0/23,Oracle 129.148.148.0/25,Oracle
+	129.148.148.192/26,Oracle 129.148.150.0-129.148.153.255,Oracle 129.148.156.0-129.148.161.255,Oracle 129.148.164.0/25,Oracle 129.148.166.0-129.148.180.127,Oracle 129.148.184.0/22,Oracle
+	129.148.200.0-129.148.215.127,Oracle 129.148.218.0/24,Oracle 129.148.219.192-129.148.223.255,Oracle 129.149.0.0/22,Oracle 129.149.6.0/25,Oracle 129.149.16.0/23,Oracle
+	129.149.20.0-129.149.22.127,Oracle 129.149.22.192-129.149.23.255,Oracle 129.149.28.0-129.149.33.255,Oracle 129.149.36.0/22,Oracle 129.149.48.0-129.149.52.127,Oracle 129.149.56.0/22,Oracle
+	129.149.63.192-129.149.68.127,Oracle 129.149.80.0-129.149.84.127,Oracle 129.149.96.0-129.149.100.127,Oracle 129.149.112.0/22,Oracle 129.149.118.0/25,Oracle 129.149.120.0/22,Oracle
+	129.149.126.0/25,Oracle 129.150.32.0-129.152.31.255,Oracle 129.152.40.0/22,Oracle 129.152.128.0/19,Oracle 129.153.0.0-129.153.241.255,Oracle 129.153.243.192/26,Oracle
+	129.154.32.0-129.154.127.255,Oracle 129.154.168.0/22,Oracle 129.154.192.0/18,Oracle 129.156.0.0/20,Oracle 129.157.224.0/21,Oracle 129.158.32.0-129.159.255.255,Oracle
+	129.191.0.0/17,Oracle 129.212.132.0-129.212.173.255,DigitalOcean 129.212.176.0-129.212.255.255,DigitalOcean 129.213.0.128/25,Oracle 129.213.2.128/25,Oracle 129.213.4.128/25,Oracle
+	129.213.8.0-129.213.215.255,Oracle 129.225.0.0/20,Oracle 129.225.32.0/19,Oracle 129.225.128.0/17,Oracle 129.226.0.0/16,TencentCloud 130.33.0.0/16,Azure
+	130.35.0.0/22,Oracle 130.35.16.0/22,Oracle 130.35.96.0/21,Oracle 130.35.112.0-130.35.116.127,Oracle 130.35.128.0/22,Oracle 130.35.144.0/22,Oracle
+	130.35.200.0/22,Oracle 130.35.228.0/22,Oracle 130.61.0.128/25,Oracle 130.61.2.128/25,Oracle 130.61.4.128/25,Oracle 130.61.8.0-130.61.255.255,Oracle
+	130.107.0.0/16,Azure 130.110.0.0-130.110.29.255,Oracle 130.110.31.0-130.110.127.255,Oracle 130.110.224.0-130.110.235.255,Oracle 130.110.238.0-130.110.255.255,Oracle 130.131.0.0/16,Azure
+	130.162.32.0-130.162.199.255,Oracle 130.162.208.0-130.162.255.255,Oracle 130.176.0.0-130.176.239.255,AWS 130.176.254.0/23,AWS 130.210.0.0/17,Oracle 130.211.4.0-130.211.255.255,GoogleCloud
+	130.213.0.0/16,Azure 131.145.0.0-131.145.191.255,Azure 131.163.0.0/17,Azure 131.186.0.0-131.186.12.127,Oracle 131.186.16.0-131.186.47.255,Oracle 131.186.56.0/21,Oracle
+	131.189.0.0/16,Azure 131.253.3.0/24,Azure 131.253.12.0/29,Azure 131.253.12.16/28,Azure 131.253.12.40-131.253.12.55,Azure 131.253.12.80/28,Azure
+	131.253.12.160/28,Azure 131.253.12.192-131.253.12.231,Azure 131.253.12.240-131.253.13.63,Azure 131.253.13.72-131.253.13.91,Azure 131.253.13.96-131.253.13.107,Azure 131.253.13.128/27,Azure
+	131.253.14.4-131.253.14.9,Azure 131.253.14.16-131.253.14.63,Azure 131.253.14.96-131.253.14.199,Azure 131.253.14.208-131.253.14.239,Azure 131.253.14.248/29,Azure 131.253.15.8-131.253.15.63,Azure
+	131.253.15.192/26,Azure 131.253.21.0/24,Azure 131.253.24.0/28,Azure 131.253.24.160-131.253.25.255,Azure 131.253.27.0/24,Azure 131.253.33.0/24,Azure
+	131.253.34.224/27,Azure 131.253.35.128/25,Azure 131.253.36.128/26,Azure 131.253.36.224/27,Azure 131.253.38.0/26,Azure 131.253.38.128/26,Azure
+	131.253.38.224/27,Azure 131.253.40.0-131.253.40.47,Azure 131.253.40.64-131.253.40.175,Azure 131.253.40.192-131.253.41.255,Azure 132.145.0.128/25,Oracle 132.145.2.128/25,Oracle
+	132.145.4.128/25,Oracle 132.145.8.0-132.145.255.255,Oracle 132.164.0.0/16,Azure 132.196.0.0/16,Azure 132.220.0.0/16,Azure 132.226.0.0-132.226.135.255,Oracle
+	132.226.144.0-132.226.255.255,Oracle 132.243.197.0/24,OVH 132.245.230.0/23,Azure 134.33.0.0/16,Azure 134.65.16.0/20,Oracle 134.65.48.0/22,Oracle
+	134.65.52.128/25,Oracle 134.65.56.0/21,Oracle 134.65.208.0-134.65.255.255,Oracle 134.70.8.0-134.70.19.255,Oracle 134.70.24.0-134.70.35.255,Oracle 134.70.40.0-134.70.51.255,Oracle
+	134.70.56.0-134.70.67.255,Oracle 134.70.72.0-134.70.225.255,Oracle 134.70.230.0-134.70.233.255,Oracle 134.98.128.0/19,Oracle 134.98.192.0/19,Oracle 134.98.248.0/26,Oracle
+	134.98.248.128/25,Oracle 134.98.249.192-134.98.255.255,Oracle 134.112.0.0/16,Azure 134.122.0.0/17,DigitalOcean 134.138.0.0/16,Azure 134.149.0.0/16,Azure
+	134.170.176.0/24,Azure 134.170.192.0/21,Azure 134.170.220.0-134.170.222.255,Azure 134.185.64.0/22,Oracle 134.185.72.0-134.185.76.255,Oracle 134.185.80.0-134.185.127.255,Oracle
+	134.195.148.0/23,OVH 134.199.130.0-134.199.255.255,DigitalOcean 134.209.0.0/16,DigitalOcean 135.13.0.0/16,Azure 135.18.0.0/16,Azure 135.116.0.0/16,Azure
+	135.119.0.0/16,Azure 135.125.0.0/16,OVH 135.130.0.0/16,Azure 135.148.0.0/16,OVH 135.149.0.0/16,Azure 135.171.0.0/16,Azure
+	135.181.0.0/16,Hetzner 135.220.0.0/16,Azure 135.222.0.0/16,Azure 135.224.0.0/15,Azure 135.232.0.0-135.237.255.255,Azure 136.0.95.0/24,OVH
+	136.8.0.0/15,AWS 136.18.0.0/21,AWS 136.18.18.0-136.18.23.255,AWS 136.18.32.0-136.18.34.255,AWS 136.18.56.0/21,AWS 136.18.128.0-136.18.165.255,AWS
+	136.18.168.0/21,AWS 136.18.254.0/23,AWS 136.23.64.0/18,GoogleCloud 136.64.0.0-136.75.255.255,GoogleCloud 136.77.0.0/16,GoogleCloud 136.79.0.0-136.95.255.255,GoogleCloud
+	136.107.0.0-136.119.255.255,GoogleCloud 136.143.200.0/24,OVH 136.243.0.0/16,Hetzner 136.248.64.0-136.248.159.255,Oracle 136.248.192.0-136.248.224.63,Oracle 136.248.224.128/25,Oracle
+	136.248.225.192-136.248.232.63,Oracle 136.248.232.128/25,Oracle 136.248.233.192-136.248.247.255,Oracle 137.23.0.0/18,Oracle 137.66.0.0/17,FlyIO 137.74.0.0/16,OVH
+	137.83.50.0/24,OVH 137.116.0.0-137.116.99.255,Azure 137.116.112.0-137.117.255.255,Azure 137.131.0.0/18,Oracle 137.131.128.0/17,Oracle 137.135.0.0/16,Azure
+	137.184.0.0-137.184.251.255,DigitalOcean 137.184.254.0/23,DigitalOcean 138.1.0.0/22,Oracle 138.1.16.0/22,Oracle 138.1.32.0-138.1.55.255,Oracle 138.1.64.0/22,Oracle
+	138.1.80.0/22,Oracle 138.1.108.0/25,Oracle 138.1.112.0/20,Oracle 138.2.0.0-138.2.191.255,Oracle 138.2.208.0-138.2.247.255,Oracle 138.3.208.0/20,Oracle
+	138.3.240.0/20,Oracle 138.68.0.0/19,DigitalOcean 138.68.36.0-138.68.255.255,DigitalOcean 138.91.0.0/16,Azure 138.197.0.0-138.197.243.255,DigitalOcean 138.197.252.0/22,DigitalOcean
+	138.199.128.0/17,Hetzner 138.201.0.0/16,Hetzner 139.56.16.0-139.56.34.255,AWS 139.59.0.0/16,DigitalOcean 139.95.0.0-139.95.19.255,Alibaba 139.95.22.0-139.95.41.255,Alibaba
+	139.95.64.0/22,Alibaba 139.95.96.0/22,Alibaba 139.95.128.0/21,Alibaba 139.95.144.0-139.95.255.255,Alibaba 139.99.0.0/16,OVH 139.129.0.0/16,Alibaba
+	139.144.0.0/17,Linode 139.144.132.0/22,Linode 139.144.143.0-139.144.243.255,Linode 139.144.247.0-139.144.255.255,Linode 139.162.1.0-139.162.63.255,Linode 139.162.65.0-139.162.128.255,Linode
+	139.162.130.0-139.162.255.255,Linode 139.177.96.0-139.177.108.127,Oracle 139.177.176.0-139.177.207.255,Linode 139.185.32.0/19,Oracle 139.196.0.0/16,Alibaba 139.224.0.0/16,Alibaba
+	140.83.32.0/21,Oracle 140.83.44.0-140.83.63.255,Oracle 140.83.80.0/21,Oracle 140.84.160.0/19,Oracle 140.86.0.0/20,Oracle 140.86.32.0-140.86.55.255,Oracle
+	140.86.62.0-140.86.79.255,Oracle 140.86.96.0/23,Oracle 140.86.156.0/23,Oracle 140.86.192.0/19,Oracle 140.91.4.0-140.91.105.255,Oracle 140.91.108.0/23,Oracle
+	140.179.0.0/16,AWS 140.204.0.128/25,Oracle 140.204.4.128/25,Oracle 140.204.8.128/25,Oracle 140.204.12.128/25,Oracle 140.204.16.128/25,Oracle
+	140.204.20.128/25,Oracle 140.204.24.128/25,Oracle 140.204.30.128/25,Oracle 140.204.34.128/25,Oracle 140.204.36.128/25,Oracle 140.204.38.128/25,Oracle
+	140.204.40.128/25,Oracle 140.204.42.128/25,Oracle 140.204.46.128/25,Oracle 140.204.50.128/25,Oracle 140.204.52.128/25,Oracle 140.204.54.128/25,Oracle
+	140.204.58.128/25,Oracle 140.204.66.128/25,Oracle 140.204.70.128/25,Oracle 140.204.76.128/25,Oracle 140.204.80.128/25,Oracle 140.204.84.0/23,Oracle
+	140.204.86.128/25,Oracle 140.204.92.128/25,Oracle 140.204.96.128/25,Oracle 140.204.100.128/25,Oracle 140.204.104.128/25,Oracle 140.204.108.128/25,Oracle
+	140.204.112.128/25,Oracle 140.204.116.128/25,Oracle 140.204.120.128/25,Oracle 140.204.122.128/25,Oracle 140.204.124.128/25,Oracle 140.204.126.128/25,Oracle
+	140.204.132.128/25,Oracle 140.204.136.128/25,Oracle 140.204.142.128/25,Oracle 140.204.146.128/25,Oracle 140.204.150.128/25,Oracle 140.204.154.128/25,Oracle
+	140.204.158.128/25,Oracle 140.204.166.128/25,Oracle 140.205.0.0/16,Alibaba 140.233.177.0/24,OVH 140.238.0.0/16,Oracle 140.245.0.0/17,Oracle
+	140.245.192.0/18,Oracle 141.0.169.0-141.0.170.255,DigitalOcean 141.8.242.0/24,OVH 141.11.1.0/24,OVH 141.11.18.0/24,OVH 141.11.21.0/24,OVH
+	141.11.32.0/24,OVH 141.11.39.0-141.11.40.255,OVH 141.11.45.0/24,OVH 141.11.74.0/23,OVH 141.11.107.0/24,OVH 141.11.187.0/24,OVH
+	141.11.250.0/24,OVH 141.94.0.0/15,OVH 141.144.32.0/19,Oracle 141.144.84.0/22,Oracle 141.144.96.0/19,Oracle 141.144.192.0/18,Oracle
+	141.145.40.0/22,Oracle 141.145.112.0/20,Oracle 141.145.144.0/20,Oracle 141.145.192.0/19,Oracle 141.147.0.0-141.147.191.255,Oracle 141.147.240.0-141.148.95.255,Oracle
+	141.148.128.0/17,Oracle 141.227.128.0-141.227.140.255,OVH 141.227.142.0/24,OVH 141.227.148.0-141.227.152.255,OVH 141.227.154.0/24,OVH 141.227.156.0/24,OVH
+	141.227.158.0/24,OVH 141.227.160.0/24,OVH 141.227.162.0/24,OVH 141.227.164.0-141.227.166.255,OVH 141.227.168.0/24,OVH 141.227.170.0/24,OVH
+	141.227.172.0/24,OVH 141.227.174.0/24,OVH 141.227.176.0/24,OVH 141.227.178.0/24,OVH 141.227.180.0/24,OVH 141.227.186.0/24,OVH
+	141.227.188.0-141.227.190.255,OVH 141.230.0.0/15,AWS 141.253.96.0/19,Oracle 141.253.192.0/19,Oracle 142.0.160.0/21,Oracle 142.4.177.0-142.4.180.255,AWS
+	142.4.192.0/19,OVH 142.44.128.0/17,OVH 142.93.0.0/16,DigitalOcean 142.111.85.0/24,OVH 142.132.128.0/17,Hetzner 142.249.92.0/24,OVH
+	142.252.51.0/24,OVH 142.252.115.0/24,OVH 142.252.127.0/24,OVH 143.14.59.0/24,OVH 143.14.231.0/24,OVH 143.20.66.0/24,OVH
+	143.20.195.0/24,OVH 143.20.215.0/24,OVH 143.42.0.0-143.42.173.255,Linode 143.42.178.0/23,Linode 143.42.182.0-143.42.255.255,Linode 143.47.32.0/19,Oracle
+	143.47.96.0/19,Oracle 143.47.176.0/20,Oracle 143.47.224.0/19,Oracle 143.109.54.0/24,OVH 143.110.128.0/17,DigitalOcean 143.198.0.0-143.198.251.255,DigitalOcean
+	143.204.0.0/16,AWS 143.244.128.0/18,DigitalOcean 143.244.196.0-143.244.215.255,DigitalOcean 143.244.218.0/24,DigitalOcean 143.244.220.0/22,DigitalOcean 144.2.32.0/19,OVH
+	144.21.32.0-144.21.127.255,Oracle 144.22.32.0-144.22.255.255,Oracle 144.24.0.0/16,Oracle 144.31.149.0/24,OVH 144.33.0.0/19,Oracle 144.76.0.0/16,Hetzner
+	144.91.64.0/18,Contabo 144.126.192.0/18,DigitalOcean 144.202.0.0/16,Vultr 144.217.0.0/16,OVH 144.220.0.0/16,AWS 144.225.52.0/24,OVH
+	144.225.123.0/24,OVH 144.225.127.0/24,OVH 144.225.162.0/24,OVH 144.225.178.0/24,OVH 145.79.151.0/24,OVH 145.132.0.0-145.133.127.255,Azure
+	145.190.0.0-145.190.85.255,Azure 145.190.128.0-145.191.255.255,Azure 145.239.0.0/16,OVH 145.241.96.0/19,Oracle 145.241.144.0-145.241.175.255,Oracle 145.241.178.0-145.241.255.255,Oracle
+	146.56.32.0-146.56.55.255,Oracle 146.56.61.192/26,Oracle 146.56.96.0-146.56.123.255,Oracle 146.56.124.64-146.56.191.255,Oracle 146.59.0.0/16,OVH 146.103.10.0/24,OVH
+	146.148.2.0-146.148.127.255,GoogleCloud 146.181.16.0-146.181.63.255,Oracle 146.185.128.0/18,DigitalOcean 146.190.0.0-146.190.179.255,DigitalOcean 146.190.184.0-146.190.255.255,DigitalOcean 146.235.0.0/18,Oracle
+	146.235.192.0-146.235.247.255,Oracle 146.235.251.192/26,Oracle 146.235.252.64-146.235.255.255,Oracle 147.5.20.0/22,Oracle 147.5.28.0/22,Oracle 147.5.64.0-147.5.255.255,Oracle
+	147.15.0.0-147.15.159.255,Oracle 147.15.176.0/23,Oracle 147.15.184.0-147.15.255.255,Oracle 147.135.0.0/16,OVH 147.139.0.0/16,Alibaba 147.154.0.0-147.154.32.127,Oracle
+	147.154.36.0-147.154.59.255,Oracle 147.154.96.0-147.154.123.255,Oracle 147.154.128.0-147.154.187.255,Oracle 147.154.189.128/25,Oracle 147.154.224.0-147.154.247.255,Oracle 147.154.255.128/25,Oracle
+	147.182.128.0/17,DigitalOcean 147.224.8.0/21,Oracle 147.224.32.0-147.224.64.255,Oracle 147.224.66.0-147.224.71.255,Oracle 147.224.128.0/17,Oracle 147.243.0.0/16,Azure
+	148.113.0.0/18,OVH 148.113.70.0/24,OVH 148.113.72.0/24,OVH 148.113.128.0/17,OVH 148.116.0.0-148.116.32.63,Oracle 148.116.32.128/25,Oracle
+	148.116.33.192-148.116.43.255,Oracle 148.116.44.128/25,Oracle 148.116.48.0/23,Oracle 148.116.64.0/19,Oracle 148.116.104.0-148.116.115.255,Oracle 148.135.192.0/24,OVH
+	148.222.40.0/22,OVH 148.222.120.0/22,OVH 148.251.0.0/16,Hetzner 149.5.230.0/24,OVH 149.18.101.0/24,OVH 149.28.0.0/16,Vultr
+	149.56.0.0/16,OVH 149.118.32.0-149.118.79.255,Oracle 149.118.92.0/22,Oracle 149.118.240.0/23,Oracle 149.128.64.0/18,AWS 149.129.0.0-149.129.23.255,Alibaba
+	149.129.32.0-149.129.255.255,Alibaba 149.130.136.0/23,Oracle 149.130.160.0/19,Oracle 149.130.208.0-149.130.255.255,Oracle 149.134.179.0/24,Alibaba 149.202.0.0/16,OVH
+	150.102.0.0/15,AWS 150.109.0.0/16,TencentCloud 150.136.0.0/16,Oracle 150.171.1.16/28,Azure 150.171.22.0-150.171.30.255,Azure 150.171.32.0/19,Azure
+	150.171.65.0-150.171.67.255,Azure 150.171.69.0-150.171.79.255,Azure 150.171.82.0-150.171.89.255,Azure 150.171.97.0-150.171.115.255,Azure 150.222.0.0-150.222.14.255,AWS 150.222.15.124-150.222.15.133,AWS
+	150.222.24.32/29,AWS 150.222.24.64/29,AWS 150.222.25.32/29,AWS 150.222.26.0-150.222.45.95,AWS 150.222.45.128-150.222.56.31,AWS 150.222.64.0/22,AWS
+	150.222.68.116/31,AWS 150.222.69.0-150.222.123.255,AWS 150.222.129.0/24,AWS 150.222.133.0-150.222.138.255,AWS 150.222.139.116-150.222.139.127,AWS 150.222.140.0/22,AWS
+	150.222.144.32/29,AWS 150.222.144.64/29,AWS 150.222.144.96/29,AWS 150.222.152.32/29,AWS 150.222.152.64/29,AWS 150.222.152.96/29,AWS
+	150.222.160.32/29,AWS 150.222.164.208/29,AWS 150.222.164.220-150.222.164.222,AWS 150.222.168.32/29,AWS 150.222.176.0-150.222.180.255,AWS 150.222.182.14-150.222.182.17,AWS
+	150.222.196.0/24,AWS 150.222.199.0/25,AWS 150.222.200.60/31,AWS 150.222.202.0-150.222.208.255,AWS 150.222.210.0-150.222.224.255,AWS 150.222.226.0-150.222.234.87,AWS
+	150.222.234.96-150.222.234.143,AWS 150.222.235.0-150.222.239.255,AWS 150.222.242.84/31,AWS 150.222.242.214/31,AWS 150.222.245.122/31,AWS 150.222.252.244-150.222.252.251,AWS
+	150.230.0.0/20,Oracle 150.230.20.0-150.230.255.255,Oracle 150.247.32.0-150.247.37.255,AWS 150.247.39.0-150.247.47.255,AWS 151.80.0.0/16,OVH 151.106.160.0/19,Oracle
+	151.145.32.0-151.145.95.255,Oracle 151.148.8.0-151.148.16.6,AWS 151.148.16.8/30,AWS 151.148.17.0-151.148.20.255,AWS 151.148.32.0-151.148.41.255,AWS 151.206.64.0-151.206.113.255,Azure
+	151.206.128.0-151.206.157.255,Azure 151.206.159.0-151.206.160.255,Azure 151.206.162.0/24,Azure 151.206.164.0-151.206.194.255,Azure 151.236.216.0-151.236.222.255,Linode 151.240.1.0/24,OVH
 	151.241.68.0/24,OVH 151.242.76.0/24,OVH 151.242.103.0/24,OVH 151.242.139.0/24,OVH 151.243.160.0/22,OVH 151.243.236.0/24,OVH
 	151.244.78.0/24,OVH 151.245.54.0/24,OVH 151.245.111.0/24,OVH 151.245.238.0/24,OVH 151.246.177.0/24,OVH 151.246.190.0/24,OVH
 	151.247.37.0/24,OVH 151.247.192.0/24,OVH 151.247.205.0/24,OVH 151.247.217.0/24,OVH 152.42.128.0/17,DigitalOcean 152.67.0.0/16,Oracle
-	152.69.160.0/19,Oracle 152.69.192.0/19,Oracle 152.69.224.0/20,Oracle 152.70.0.0/16,Oracle 152.228.128.0/17,OVH 153.52.116.0/24,OVH
-	155.102.71.0/24,Alibaba 155.102.102.0/24,Alibaba 155.102.105.0/24,Alibaba 155.117.5.0/24,OVH 155.117.6.0/24,OVH 155.117.13.0/24,OVH
-	155.117.127.0/24,OVH 155.117.197.0/24,OVH 155.117.233.0/24,OVH 155.117.234.0/24,OVH 155.117.248.0/24,OVH 155.146.0.0/16,AWS
-	155.248.128.0/22,Oracle 155.248.132.0/23,Oracle 155.248.135.128/25,Oracle 155.248.136.0/22,Oracle 155.248.140.0/25,Oracle 155.248.144.0/22,Oracle
-	155.248.148.0/25,Oracle 155.248.160.0/19,Oracle 155.248.192.0/18,Oracle 156.4.0.0/15,AWS 156.227.20.0/24,Alibaba 156.236.17.0/24,Alibaba
-	157.55.2.128/26,Azure 157.55.7.128/26,Azure 157.55.8.64/26,Azure 157.55.8.144/28,Azure 157.55.10.160/29,Azure 157.55.10.176/28,Azure
-	157.55.10.192/26,Azure 157.55.11.128/25,Azure 157.55.12.64/26,Azure 157.55.12.128/26,Azure 157.55.13.64/26,Azure 157.55.13.128/26,Azure
-	157.55.37.0/24,Azure 157.55.38.0/23,Azure 157.55.48.0/24,Azure 157.55.50.0/25,Azure 157.55.55.0/27,Azure 157.55.55.32/28,Azure
-	157.55.55.100/30,Azure 157.55.55.104/29,Azure 157.55.55.136/29,Azure 157.55.55.144/28,Azure 157.55.55.160/28,Azure 157.55.55.176/29,Azure
-	157.55.55.200/29,Azure 157.55.55.216/29,Azure 157.55.55.228/30,Azure 157.55.55.232/29,Azure 157.55.55.240/28,Azure 157.55.60.224/27,Azure
-	157.55.64.0/19,Azure 157.55.103.32/27,Azure 157.55.103.128/25,Azure 157.55.106.0/26,Azure 157.55.106.128/25,Azure 157.55.107.0/24,Azure
-	157.55.108.0/22,Azure 157.55.136.0/21,Azure 157.55.153.224/28,Azure 157.55.154.128/25,Azure 157.55.160.0/19,Azure 157.55.192.0/21,Azure
-	157.55.200.0/22,Azure 157.55.204.1/32,Azure 157.55.204.2/31,Azure 157.55.204.33/32,Azure 157.55.204.34/31,Azure 157.55.204.128/25,Azure
-	157.55.208.0/21,Azure 157.55.248.0/21,Azure 157.56.2.0/23,Azure 157.56.8.0/21,Azure 157.56.24.160/27,Azure 157.56.24.192/27,Azure
-	157.56.28.0/22,Azure 157.56.80.0/25,Azure 157.56.160.0/21,Azure 157.56.176.0/21,Azure 157.56.216.0/26,Azure 157.90.0.0/16,Hetzner
-	157.137.0.0/19,Oracle 157.137.32.0/26,Oracle 157.137.32.128/25,Oracle 157.137.33.192/26,Oracle 157.137.34.0/23,Oracle 157.137.36.0/22,Oracle
-	157.137.40.0/22,Oracle 157.137.44.128/25,Oracle 157.137.50.0/23,Oracle 157.137.128.0/22,Oracle 157.137.160.0/19,Oracle 157.137.192.0/19,Oracle
-	157.137.224.0/20,Oracle 157.137.253.0/24,Oracle 157.137.254.0/24,Oracle 157.151.0.0/19,Oracle 157.151.32.0/21,Oracle 157.151.40.0/22,Oracle
-	157.151.44.0/23,Oracle 157.151.64.0/18,Oracle 157.151.128.0/17,Oracle 157.175.0.0/16,AWS 157.180.0.0/17,Hetzner 157.230.0.0/16,DigitalOcean
-	157.241.0.0/16,AWS 157.245.0.0/16,DigitalOcean 157.254.0.0/24,OVH 157.254.30.0/24,OVH 158.23.0.0/16,Azure 158.62.199.0/24,OVH
-	158.69.0.0/16,OVH 158.94.170.0/24,OVH 158.101.0.0/16,Oracle 158.158.0.0/16,Azure 158.173.221.0/24,OVH 158.178.128.0/22,Oracle
-	158.178.136.0/21,Oracle 158.178.144.0/20,Oracle 158.178.192.0/19,Oracle 158.178.224.0/20,Oracle 158.178.240.0/21,Oracle 158.178.248.0/22,Oracle
-	158.179.4.0/22,Oracle 158.179.8.0/22,Oracle 158.179.12.64/26,Oracle 158.179.12.128/25,Oracle 158.179.13.0/24,Oracle 158.179.15.0/24,Oracle
-	158.179.16.0/20,Oracle 158.179.160.0/19,Oracle 158.179.192.0/22,Oracle 158.179.196.0/24,Oracle 158.179.198.0/23,Oracle 158.179.200.0/21,Oracle
-	158.179.208.0/20,Oracle 158.180.4.0/22,Oracle 158.180.8.0/21,Oracle 158.180.16.0/20,Oracle 158.180.32.0/19,Oracle 158.180.64.0/19,Oracle
-	158.180.226.0/23,Oracle 158.180.228.0/22,Oracle 158.180.232.0/21,Oracle 158.247.96.0/22,Oracle 158.247.100.0/25,Oracle 158.247.104.0/22,Oracle
-	158.247.112.0/23,Oracle 158.247.114.128/25,Oracle 158.247.120.0/21,Oracle 158.252.0.0/15,AWS 159.13.0.0/22,Oracle 159.13.4.0/25,Oracle
-	159.13.14.0/23,Oracle 159.13.32.0/19,Oracle 159.54.128.0/18,Oracle 159.65.0.0/16,DigitalOcean 159.69.0.0/16,Hetzner 159.89.0.0/19,DigitalOcean
-	159.89.32.0/20,DigitalOcean 159.89.48.0/21,DigitalOcean 159.89.64.0/18,DigitalOcean 159.89.128.0/17,DigitalOcean 159.112.128.0/20,Oracle 159.112.144.0/21,Oracle
-	159.112.162.0/23,Oracle 159.112.166.0/24,Oracle 159.112.168.0/21,Oracle 159.112.176.0/20,Oracle 159.203.0.0/16,DigitalOcean 159.223.0.0/17,DigitalOcean
-	159.223.128.0/18,DigitalOcean 159.223.192.0/19,DigitalOcean 159.223.224.0/20,DigitalOcean 159.223.240.0/21,DigitalOcean 159.223.248.0/22,DigitalOcean 159.248.133.0/24,AWS
-	159.248.200.0/21,AWS 159.248.216.0/21,AWS 159.248.224.0/20,AWS 159.248.240.0/21,AWS 160.1.0.0/16,AWS 160.20.158.0/23,OVH
-	160.34.6.0/23,Oracle 160.34.8.0/22,Oracle 160.34.208.0/20,Oracle 161.33.0.0/18,Oracle 161.33.64.0/19,Oracle 161.33.96.0/20,Oracle
-	161.33.128.0/18,Oracle 161.33.192.0/19,Oracle 161.33.224.0/20,Oracle 161.33.240.0/21,Oracle 161.33.252.0/22,Oracle 161.35.0.0/16,DigitalOcean
-	161.117.0.0/16,Alibaba 161.118.8.0/21,Oracle 161.118.16.0/20,Oracle 161.118.64.0/18,Oracle 161.118.128.0/17,Oracle 161.153.0.0/17,Oracle
-	161.153.128.0/18,Oracle 161.153.192.0/20,Oracle 161.153.212.0/22,Oracle 161.153.216.0/21,Oracle 161.153.224.0/19,Oracle 161.178.0.0/18,AWS
-	161.178.128.0/18,AWS 161.188.0.0/18,AWS 161.188.64.0/19,AWS 161.188.112.0/21,AWS 161.188.120.0/22,AWS 161.188.127.0/24,AWS
-	161.189.0.0/16,AWS 161.193.0.0/18,AWS 161.193.128.0/18,AWS 162.19.0.0/16,OVH 162.55.0.0/16,Hetzner 162.141.71.0/24,OVH
-	162.208.121.0/24,AWS 162.212.35.0/24,OVH 162.213.232.0/22,AWS 162.216.16.0/22,Linode 162.216.148.0/22,GoogleCloud 162.222.148.0/22,AWS
-	162.222.176.0/21,GoogleCloud 162.223.195.0/24,OVH 162.243.0.0/17,DigitalOcean 162.243.128.0/19,DigitalOcean 162.243.160.0/20,DigitalOcean 162.243.177.0/24,DigitalOcean
+	152.69.160.0-152.69.239.255,Oracle 152.70.0.0/16,Oracle 152.228.128.0/17,OVH 153.52.116.0/24,OVH 155.102.71.0/24,Alibaba 155.102.102.0/24,Alibaba
+	155.102.105.0/24,Alibaba 155.117.5.0-155.117.6.255,OVH 155.117.13.0/24,OVH 155.117.127.0/24,OVH 155.117.197.0/24,OVH 155.117.233.0-155.117.234.255,OVH
+	155.117.248.0/24,OVH 155.146.0.0/16,AWS 155.248.128.0-155.248.133.255,Oracle 155.248.135.128-155.248.140.127,Oracle 155.248.144.0-155.248.148.127,Oracle 155.248.160.0-155.248.255.255,Oracle
+	156.4.0.0/15,AWS 156.227.20.0/24,Alibaba 156.236.17.0/24,Alibaba 157.55.2.128/26,Azure 157.55.7.128/26,Azure 157.55.8.64/26,Azure
+	157.55.8.144/28,Azure 157.55.10.160/29,Azure 157.55.10.176-157.55.10.255,Azure 157.55.11.128/25,Azure 157.55.12.64-157.55.12.191,Azure 157.55.13.64-157.55.13.191,Azure
+	157.55.37.0-157.55.39.255,Azure 157.55.48.0/24,Azure 157.55.50.0/25,Azure 157.55.55.0-157.55.55.47,Azure 157.55.55.100-157.55.55.111,Azure 157.55.55.136-157.55.55.183,Azure
+	157.55.55.200/29,Azure 157.55.55.216/29,Azure 157.55.55.228-157.55.55.255,Azure 157.55.60.224/27,Azure 157.55.64.0/19,Azure 157.55.103.32/27,Azure
+	157.55.103.128/25,Azure 157.55.106.0/26,Azure 157.55.106.128-157.55.111.255,Azure 157.55.136.0/21,Azure 157.55.153.224/28,Azure 157.55.154.128/25,Azure
+	157.55.160.0-157.55.203.255,Azure 157.55.204.1-157.55.204.3,Azure 157.55.204.33-157.55.204.35,Azure 157.55.204.128/25,Azure 157.55.208.0/21,Azure 157.55.248.0/21,Azure
+	157.56.2.0/23,Azure 157.56.8.0/21,Azure 157.56.24.160-157.56.24.223,Azure 157.56.28.0/22,Azure 157.56.80.0/25,Azure 157.56.160.0/21,Azure
+	157.56.176.0/21,Azure 157.56.216.0/26,Azure 157.90.0.0/16,Hetzner 157.137.0.0-157.137.32.63,Oracle 157.137.32.128/25,Oracle 157.137.33.192-157.137.43.255,Oracle
+	157.137.44.128/25,Oracle 157.137.50.0/23,Oracle 157.137.128.0/22,Oracle 157.137.160.0-157.137.239.255,Oracle 157.137.253.0-157.137.254.255,Oracle 157.151.0.0-157.151.45.255,Oracle
+	157.151.64.0-157.151.255.255,Oracle 157.175.0.0/16,AWS 157.180.0.0/17,Hetzner 157.230.0.0/16,DigitalOcean 157.241.0.0/16,AWS 157.245.0.0/16,DigitalOcean
+	157.254.0.0/24,OVH 157.254.30.0/24,OVH 158.23.0.0/16,Azure 158.62.199.0/24,OVH 158.69.0.0/16,OVH 158.94.170.0/24,OVH
+	158.101.0.0/16,Oracle 158.158.0.0/16,Azure 158.173.221.0/24,OVH 158.178.128.0/22,Oracle 158.178.136.0-158.178.159.255,Oracle 158.178.192.0-158.178.251.255,Oracle
+	158.179.4.0-158.179.11.255,Oracle 158.179.12.64-158.179.13.255,Oracle 158.179.15.0-158.179.31.255,Oracle 158.179.160.0-158.179.196.255,Oracle 158.179.198.0-158.179.223.255,Oracle 158.180.4.0-158.180.95.255,Oracle
+	158.180.226.0-158.180.239.255,Oracle 158.247.96.0-158.247.100.127,Oracle 158.247.104.0/22,Oracle 158.247.112.0/23,Oracle 158.247.114.128/25,Oracle 158.247.120.0/21,Oracle
+	158.252.0.0/15,AWS 159.13.0.0-159.13.4.127,Oracle 159.13.14.0/23,Oracle 159.13.32.0/19,Oracle 159.54.128.0/18,Oracle 159.65.0.0/16,DigitalOcean
+	159.69.0.0/16,Hetzner 159.89.0.0-159.89.55.255,DigitalOcean 159.89.64.0-159.89.255.255,DigitalOcean 159.112.128.0-159.112.151.255,Oracle 159.112.162.0/23,Oracle 159.112.166.0/24,Oracle
+	159.112.168.0-159.112.191.255,Oracle 159.138.0.0/16,HuaweiCloud 159.203.0.0/16,DigitalOcean 159.223.0.0-159.223.251.255,DigitalOcean 159.248.133.0/24,AWS 159.248.200.0/21,AWS
+	159.248.216.0-159.248.247.255,AWS 160.1.0.0/16,AWS 160.20.158.0/23,OVH 160.34.6.0-160.34.11.255,Oracle 160.34.208.0/20,Oracle 161.33.0.0-161.33.111.255,Oracle
+	161.33.128.0-161.33.247.255,Oracle 161.33.252.0/22,Oracle 161.35.0.0/16,DigitalOcean 161.97.64.0/18,Contabo 161.117.0.0/16,Alibaba 161.118.8.0-161.118.31.255,Oracle
+	161.118.64.0-161.118.255.255,Oracle 161.153.0.0-161.153.207.255,Oracle 161.153.212.0-161.153.255.255,Oracle 161.178.0.0/18,AWS 161.178.128.0/18,AWS 161.188.0.0-161.188.95.255,AWS
+	161.188.112.0-161.188.123.255,AWS 161.188.127.0/24,AWS 161.189.0.0/16,AWS 161.193.0.0/18,AWS 161.193.128.0/18,AWS 162.19.0.0/16,OVH
+	162.55.0.0/16,Hetzner 162.141.71.0/24,OVH 162.208.121.0/24,AWS 162.212.35.0/24,OVH 162.213.232.0/22,AWS 162.216.16.0/22,Linode
+	162.216.148.0/22,GoogleCloud 162.222.148.0/22,AWS 162.222.176.0/21,GoogleCloud 162.223.195.0/24,OVH 162.243.0.0-162.243.175.255,DigitalOcean 162.243.177.0/24,DigitalOcean
 	162.243.184.0/22,DigitalOcean 162.243.192.0/18,DigitalOcean 162.250.236.0/22,AWS 163.5.62.0/24,OVH 163.5.71.0/24,OVH 163.5.132.0/24,OVH
-	163.5.149.0/24,OVH 163.5.187.0/24,OVH 163.47.8.0/22,DigitalOcean 163.176.0.0/16,Oracle 163.181.41.0/24,Alibaba 163.181.208.0/24,Alibaba
-	163.192.0.0/17,Oracle 163.192.128.0/19,Oracle 163.192.192.0/19,Oracle 163.192.240.0/20,Oracle 163.223.88.0/24,OVH 164.37.39.0/24,OVH
-	164.90.128.0/18,DigitalOcean 164.90.192.0/19,DigitalOcean 164.90.224.0/20,DigitalOcean 164.90.240.0/21,DigitalOcean 164.90.252.0/22,DigitalOcean 164.92.64.0/18,DigitalOcean
-	164.92.128.0/17,DigitalOcean 164.132.0.0/16,OVH 164.152.16.0/20,Oracle 164.152.32.0/19,Oracle 164.152.96.0/22,Oracle 164.152.104.0/21,Oracle
-	164.152.192.0/21,Oracle 164.152.240.0/20,Oracle 165.1.64.0/20,Oracle 165.1.96.0/22,Oracle 165.1.100.0/25,Oracle 165.1.104.0/22,Oracle
-	165.1.112.0/23,Oracle 165.1.114.128/25,Oracle 165.1.120.0/21,Oracle 165.22.0.0/16,DigitalOcean 165.227.0.0/16,DigitalOcean 165.232.32.0/19,DigitalOcean
-	165.232.64.0/18,DigitalOcean 165.232.128.0/18,DigitalOcean 165.245.128.0/17,DigitalOcean 166.0.112.0/24,OVH 166.1.85.0/24,OVH 166.1.88.0/24,OVH
-	166.1.90.0/24,OVH 166.117.0.0/16,AWS 167.71.0.0/16,DigitalOcean 167.99.0.0/16,DigitalOcean 167.105.0.0/16,Azure 167.114.0.0/16,OVH
-	167.126.0.0/19,Oracle 167.126.32.0/20,Oracle 167.148.125.0/24,OVH 167.148.193.0/24,OVH 167.172.0.0/16,DigitalOcean 167.233.0.0/16,Hetzner
-	167.234.38.0/24,OVH 167.234.208.0/20,Oracle 167.234.224.0/19,Oracle 167.235.0.0/16,Hetzner 167.253.62.0/24,OVH 168.61.0.0/17,Azure
-	168.61.128.0/25,Azure 168.61.128.128/28,Azure 168.61.128.160/27,Azure 168.61.128.192/26,Azure 168.61.129.0/25,Azure 168.61.129.128/26,Azure
-	168.61.129.208/28,Azure 168.61.129.224/27,Azure 168.61.130.64/26,Azure 168.61.130.128/25,Azure 168.61.131.0/26,Azure 168.61.131.128/25,Azure
-	168.61.132.0/26,Azure 168.61.136.0/21,Azure 168.61.144.0/20,Azure 168.61.160.0/19,Azure 168.61.208.0/20,Azure 168.61.224.0/19,Azure
-	168.62.0.0/16,Azure 168.63.0.0/18,Azure 168.63.64.0/20,Azure 168.63.80.0/21,Azure 168.63.88.0/23,Azure 168.63.90.0/24,Azure
-	168.63.91.0/26,Azure 168.63.92.0/22,Azure 168.63.96.0/19,Azure 168.63.128.0/24,Azure 168.63.129.0/28,Azure 168.63.129.32/27,Azure
-	168.63.129.64/26,Azure 168.63.129.128/25,Azure 168.63.130.0/23,Azure 168.63.132.0/22,Azure 168.63.136.0/21,Azure 168.63.148.0/22,Azure
-	168.63.152.0/22,Azure 168.63.156.0/24,Azure 168.63.160.0/19,Azure 168.63.192.0/18,Azure 168.75.64.0/19,Oracle 168.75.96.0/20,Oracle
-	168.100.142.0/23,OVH 168.107.0.0/18,Oracle 168.107.64.0/19,Oracle 168.107.192.0/19,Oracle 168.107.248.0/26,Oracle 168.107.248.128/25,Oracle
-	168.107.249.192/26,Oracle 168.107.250.0/23,Oracle 168.107.252.0/22,Oracle 168.110.0.0/17,Oracle 168.110.192.0/19,Oracle 168.110.224.0/20,Oracle
-	168.110.240.0/21,Oracle 168.110.248.0/26,Oracle 168.110.248.128/25,Oracle 168.110.249.192/26,Oracle 168.110.250.0/23,Oracle 168.110.252.0/22,Oracle
-	168.119.0.0/16,Hetzner 168.129.128.0/19,Oracle 168.129.160.0/20,Oracle 168.129.176.0/21,Oracle 168.129.184.0/26,Oracle 168.129.184.128/25,Oracle
-	168.129.185.192/26,Oracle 168.129.186.0/23,Oracle 168.129.188.0/22,Oracle 168.129.192.0/19,Oracle 168.129.224.0/22,Oracle 168.129.232.0/23,Oracle
-	168.129.234.128/25,Oracle 168.129.240.0/22,Oracle 168.129.244.0/24,Oracle 168.129.246.0/23,Oracle 168.129.248.0/26,Oracle 168.129.248.128/25,Oracle
-	168.129.249.192/26,Oracle 168.129.250.0/23,Oracle 168.129.252.0/22,Oracle 168.138.0.0/16,Oracle 168.144.0.0/17,DigitalOcean 168.144.128.0/18,DigitalOcean
-	168.144.192.0/22,DigitalOcean 168.144.208.0/20,DigitalOcean 168.144.240.0/20,DigitalOcean 168.185.4.0/22,AWS 168.192.0.0/15,AWS 168.222.43.0/24,OVH
-	168.222.49.0/24,OVH 168.222.183.0/24,OVH 168.222.243.0/24,OVH 168.245.185.0/24,OVH 169.40.32.0/24,OVH 169.155.128.0/19,Oracle
-	169.224.224.0/21,Oracle 170.9.0.0/18,Oracle 170.9.64.0/19,Oracle 170.9.192.0/18,Oracle 170.33.0.0/22,Alibaba 170.33.8.0/21,Alibaba
-	170.33.16.0/20,Alibaba 170.33.32.0/21,Alibaba 170.33.40.0/23,Alibaba 170.33.44.0/22,Alibaba 170.33.64.0/23,Alibaba 170.33.66.0/24,Alibaba
-	170.33.68.0/23,Alibaba 170.33.72.0/23,Alibaba 170.33.75.0/24,Alibaba 170.33.76.0/22,Alibaba 170.33.80.0/22,Alibaba 170.33.84.0/24,Alibaba
-	170.33.86.0/23,Alibaba 170.33.88.0/22,Alibaba 170.33.92.0/23,Alibaba 170.33.94.0/24,Alibaba 170.33.96.0/22,Alibaba 170.33.101.0/24,Alibaba
-	170.33.103.0/24,Alibaba 170.33.104.0/22,Alibaba 170.33.112.0/23,Alibaba 170.33.114.0/24,Alibaba 170.33.128.0/21,Alibaba 170.33.136.0/22,Alibaba
-	170.33.144.0/24,Alibaba 170.33.152.0/24,Alibaba 170.33.160.0/24,Alibaba 170.33.168.0/23,Alibaba 170.33.192.0/23,Alibaba 170.33.200.0/22,Alibaba
-	170.33.216.0/22,Alibaba 170.64.128.0/17,DigitalOcean 170.187.131.0/24,Linode 170.187.132.0/24,Linode 170.187.134.0/23,Linode 170.187.136.0/21,Linode
-	170.187.144.0/20,Linode 170.187.160.0/19,Linode 170.187.192.0/18,Linode 171.25.225.0/24,Hetzner 172.83.201.0/24,OVH 172.96.97.0/24,AWS
-	172.96.98.0/24,AWS 172.96.110.0/24,AWS 172.104.4.0/22,Linode 172.104.8.0/21,Linode 172.104.16.0/20,Linode 172.104.32.0/19,Linode
-	172.104.64.0/18,Linode 172.104.128.0/18,Linode 172.104.192.0/21,Linode 172.104.202.0/23,Linode 172.104.205.0/24,Linode 172.104.206.0/23,Linode
-	172.104.208.0/21,Linode 172.104.216.0/22,Linode 172.104.220.0/24,Linode 172.104.223.0/24,Linode 172.104.224.0/19,Linode 172.105.0.0/20,Linode
-	172.105.16.0/21,Linode 172.105.24.0/22,Linode 172.105.28.0/23,Linode 172.105.30.0/24,Linode 172.105.33.0/24,Linode 172.105.34.0/23,Linode
-	172.105.36.0/22,Linode 172.105.40.0/21,Linode 172.105.48.0/20,Linode 172.105.64.0/18,Linode 172.105.128.0/20,Linode 172.105.146.0/23,Linode
-	172.105.148.0/22,Linode 172.105.152.0/21,Linode 172.105.161.0/24,Linode 172.105.162.0/23,Linode 172.105.164.0/22,Linode 172.105.168.0/21,Linode
-	172.105.176.0/20,Linode 172.105.192.0/18,Linode 172.106.0.0/15,AWS 172.128.0.0/17,Azure 172.129.0.0/16,Azure 172.130.0.0/17,Azure
-	172.131.0.0/17,Azure 172.160.0.0/12,Azure 172.176.0.0/13,Azure 172.184.0.0/14,Azure 172.188.0.0/16,Azure 172.189.0.0/17,Azure
-	172.189.128.0/18,Azure 172.190.0.0/15,Azure 172.192.0.0/12,Azure 172.208.0.0/14,Azure 172.212.0.0/16,Azure 172.213.0.0/19,Azure
-	172.213.64.0/18,Azure 172.213.128.0/17,Azure 172.214.0.0/15,Azure 172.232.0.0/18,Linode 172.232.64.0/20,Linode 172.232.80.0/21,Linode
-	172.232.96.0/19,Linode 172.232.128.0/17,Linode 172.233.0.0/16,Linode 172.234.2.0/23,Linode 172.234.4.0/23,Linode 172.234.8.0/21,Linode
-	172.234.16.0/20,Linode 172.234.32.0/19,Linode 172.234.64.0/18,Linode 172.234.128.0/17,Linode 172.235.0.0/18,Linode 172.235.96.0/22,Linode
-	172.235.102.0/23,Linode 172.235.104.0/23,Linode 172.235.110.0/23,Linode 172.235.112.0/20,Linode 172.235.128.0/18,Linode 172.235.192.0/20,Linode
-	172.235.208.0/21,Linode 172.235.216.0/22,Linode 172.235.224.0/19,Linode 172.236.0.0/14,Linode 173.83.192.0/19,AWS 173.83.224.0/21,AWS
-	173.83.232.0/22,AWS 173.230.128.0/20,Linode 173.230.144.0/21,Linode 173.230.152.0/22,Linode 173.230.156.0/23,Linode 173.230.158.0/24,Linode
-	173.255.112.0/20,GoogleCloud 173.255.192.0/21,Linode 173.255.200.0/22,Linode 173.255.204.0/23,Linode 173.255.206.0/24,Linode 173.255.208.0/21,Linode
-	173.255.216.0/22,Linode 173.255.220.0/23,Linode 173.255.223.0/24,Linode 173.255.224.0/21,Linode 173.255.232.0/22,Linode 173.255.236.0/23,Linode
-	173.255.238.0/24,Linode 173.255.240.0/20,Linode 174.129.0.0/16,AWS 174.138.0.0/17,DigitalOcean 175.41.128.0/17,AWS 176.9.0.0/16,Hetzner
-	176.31.0.0/16,OVH 176.32.64.0/19,AWS 176.32.96.0/20,AWS 176.32.112.0/21,AWS 176.32.120.0/22,AWS 176.32.124.128/25,AWS
-	176.32.125.0/24,AWS 176.34.0.0/16,AWS 176.58.96.0/19,Linode 176.105.231.0/24,OVH 177.71.128.0/17,AWS 177.72.240.0/21,AWS
-	177.111.0.0/19,OVH 177.111.111.0/24,OVH 178.32.0.0/15,OVH 178.62.0.0/16,DigitalOcean 178.63.0.0/16,Hetzner 178.79.128.0/20,Linode
-	178.79.144.0/22,Linode 178.79.148.0/23,Linode 178.79.150.0/24,Linode 178.79.152.0/21,Linode 178.79.160.0/22,Linode 178.79.164.0/23,Linode
-	178.79.166.0/24,Linode 178.79.168.0/21,Linode 178.79.176.0/20,Linode 178.83.45.0/24,OVH 178.83.46.0/24,OVH 178.92.111.0/24,OVH
-	178.92.120.0/24,OVH 178.93.247.0/24,OVH 178.95.83.0/24,OVH 178.104.0.0/15,Hetzner 178.128.0.0/16,DigitalOcean 178.156.128.0/17,Hetzner
-	178.212.75.0/24,Hetzner 178.236.0.0/20,AWS 180.131.145.0/24,OVH 180.163.57.0/25,AWS 180.163.57.128/26,AWS 181.41.194.0/24,OVH
-	182.24.0.0/14,AWS 182.28.0.0/15,AWS 182.30.0.0/16,AWS 182.92.0.0/16,Alibaba 184.32.0.0/12,AWS 184.72.0.0/15,AWS
-	184.76.0.0/14,AWS 184.169.128.0/17,AWS 184.174.96.0/23,OVH 184.192.0.0/11,AWS 185.2.49.0/24,OVH 185.3.73.0/24,OVH
+	163.5.149.0/24,OVH 163.5.187.0/24,OVH 163.47.8.0/22,DigitalOcean 163.172.0.0/16,Scaleway 163.176.0.0/16,Oracle 163.181.41.0/24,Alibaba
+	163.181.208.0/24,Alibaba 163.192.0.0-163.192.159.255,Oracle 163.192.192.0/19,Oracle 163.192.240.0/20,Oracle 163.223.88.0/24,OVH 164.37.39.0/24,OVH
+	164.90.128.0-164.90.247.255,DigitalOcean 164.90.252.0/22,DigitalOcean 164.92.64.0-164.92.255.255,DigitalOcean 164.132.0.0/16,OVH 164.152.16.0-164.152.63.255,Oracle 164.152.96.0/22,Oracle
+	164.152.104.0/21,Oracle 164.152.192.0/21,Oracle 164.152.240.0/20,Oracle 165.1.64.0/20,Oracle 165.1.96.0-165.1.100.127,Oracle 165.1.104.0/22,Oracle
+	165.1.112.0/23,Oracle 165.1.114.128/25,Oracle 165.1.120.0/21,Oracle 165.22.0.0/16,DigitalOcean 165.227.0.0/16,DigitalOcean 165.232.32.0-165.232.191.255,DigitalOcean
+	165.245.128.0/17,DigitalOcean 166.0.112.0/24,OVH 166.1.85.0/24,OVH 166.1.88.0/24,OVH 166.1.90.0/24,OVH 166.117.0.0/16,AWS
+	167.71.0.0/16,DigitalOcean 167.86.64.0/18,Contabo 167.99.0.0/16,DigitalOcean 167.105.0.0/16,Azure 167.114.0.0/16,OVH 167.126.0.0-167.126.47.255,Oracle
+	167.148.125.0/24,OVH 167.148.193.0/24,OVH 167.172.0.0/16,DigitalOcean 167.233.0.0/16,Hetzner 167.234.38.0/24,OVH 167.234.208.0-167.234.255.255,Oracle
+	167.235.0.0/16,Hetzner 167.253.62.0/24,OVH 168.61.0.0-168.61.128.143,Azure 168.61.128.160-168.61.129.191,Azure 168.61.129.208-168.61.129.255,Azure 168.61.130.64-168.61.131.63,Azure
+	168.61.131.128-168.61.132.63,Azure 168.61.136.0-168.61.191.255,Azure 168.61.208.0-168.63.91.63,Azure 168.63.92.0-168.63.129.15,Azure 168.63.129.32-168.63.143.255,Azure 168.63.148.0-168.63.156.255,Azure
+	168.63.160.0-168.63.255.255,Azure 168.75.64.0-168.75.111.255,Oracle 168.100.142.0/23,OVH 168.107.0.0-168.107.95.255,Oracle 168.107.192.0/19,Oracle 168.107.248.0/26,Oracle
+	168.107.248.128/25,Oracle 168.107.249.192-168.107.255.255,Oracle 168.110.0.0/17,Oracle 168.110.192.0-168.110.248.63,Oracle 168.110.248.128/25,Oracle 168.110.249.192-168.110.255.255,Oracle
+	168.119.0.0/16,Hetzner 168.129.128.0-168.129.184.63,Oracle 168.129.184.128/25,Oracle 168.129.185.192-168.129.227.255,Oracle 168.129.232.0/23,Oracle 168.129.234.128/25,Oracle
+	168.129.240.0-168.129.244.255,Oracle 168.129.246.0-168.129.248.63,Oracle 168.129.248.128/25,Oracle 168.129.249.192-168.129.255.255,Oracle 168.138.0.0/16,Oracle 168.144.0.0-168.144.195.255,DigitalOcean
+	168.144.208.0/20,DigitalOcean 168.144.240.0/20,DigitalOcean 168.185.4.0/22,AWS 168.192.0.0/15,AWS 168.222.43.0/24,OVH 168.222.49.0/24,OVH
+	168.222.183.0/24,OVH 168.222.243.0/24,OVH 168.245.185.0/24,OVH 169.40.32.0/24,OVH 169.44.0.0/14,IBMCloud 169.60.0.0/14,IBMCloud
+	169.155.128.0/19,Oracle 169.224.224.0/21,Oracle 170.9.0.0-170.9.95.255,Oracle 170.9.192.0/18,Oracle 170.33.0.0/22,Alibaba 170.33.8.0-170.33.41.255,Alibaba
+	170.33.44.0/22,Alibaba 170.33.64.0-170.33.66.255,Alibaba 170.33.68.0/23,Alibaba 170.33.72.0/23,Alibaba 170.33.75.0-170.33.84.255,Alibaba 170.33.86.0-170.33.94.255,Alibaba
+	170.33.96.0/22,Alibaba 170.33.101.0/24,Alibaba 170.33.103.0-170.33.107.255,Alibaba 170.33.112.0-170.33.114.255,Alibaba 170.33.128.0-170.33.139.255,Alibaba 170.33.144.0/24,Alibaba
+	170.33.152.0/24,Alibaba 170.33.160.0/24,Alibaba 170.33.168.0/23,Alibaba 170.33.192.0/23,Alibaba 170.33.200.0/22,Alibaba 170.33.216.0/22,Alibaba
+	170.64.128.0/17,DigitalOcean 170.106.0.0/16,TencentCloud 170.187.131.0-170.187.132.255,Linode 170.187.134.0-170.187.255.255,Linode 171.25.225.0/24,Hetzner 172.83.201.0/24,OVH
+	172.96.97.0-172.96.98.255,AWS 172.96.110.0/24,AWS 172.104.4.0-172.104.199.255,Linode 172.104.202.0/23,Linode 172.104.205.0-172.104.220.255,Linode 172.104.223.0-172.105.30.255,Linode
+	172.105.33.0-172.105.143.255,Linode 172.105.146.0-172.105.159.255,Linode 172.105.161.0-172.105.255.255,Linode 172.106.0.0/15,AWS 172.128.0.0/17,Azure 172.129.0.0-172.130.127.255,Azure
+	172.131.0.0/17,Azure 172.160.0.0-172.189.191.255,Azure 172.190.0.0-172.213.31.255,Azure 172.213.64.0-172.215.255.255,Azure 172.232.0.0-172.232.87.255,Linode 172.232.96.0-172.233.255.255,Linode
+	172.234.2.0-172.234.5.255,Linode 172.234.8.0-172.235.63.255,Linode 172.235.96.0/22,Linode 172.235.102.0-172.235.105.255,Linode 172.235.110.0-172.235.219.255,Linode 172.235.224.0-172.239.255.255,Linode
+	173.83.192.0-173.83.235.255,AWS 173.192.0.0/15,IBMCloud 173.230.128.0-173.230.158.255,Linode 173.249.0.0/18,Contabo 173.255.112.0/20,GoogleCloud 173.255.192.0-173.255.206.255,Linode
+	173.255.208.0-173.255.221.255,Linode 173.255.223.0-173.255.238.255,Linode 173.255.240.0/20,Linode 174.36.0.0/15,IBMCloud 174.129.0.0/16,AWS 174.138.0.0/17,DigitalOcean
+	175.41.128.0/17,AWS 176.9.0.0/16,Hetzner 176.31.0.0/16,OVH 176.32.64.0-176.32.123.255,AWS 176.32.124.128-176.32.125.255,AWS 176.34.0.0/16,AWS
+	176.58.96.0/19,Linode 176.105.231.0/24,OVH 177.71.128.0/17,AWS 177.72.240.0/21,AWS 177.111.0.0/19,OVH 177.111.111.0/24,OVH
+	178.32.0.0/15,OVH 178.62.0.0/16,DigitalOcean 178.63.0.0/16,Hetzner 178.79.128.0-178.79.150.255,Linode 178.79.152.0-178.79.166.255,Linode 178.79.168.0-178.79.191.255,Linode
+	178.83.45.0-178.83.46.255,OVH 178.92.111.0/24,OVH 178.92.120.0/24,OVH 178.93.247.0/24,OVH 178.95.83.0/24,OVH 178.104.0.0/15,Hetzner
+	178.128.0.0/16,DigitalOcean 178.156.128.0/17,Hetzner 178.212.75.0/24,Hetzner 178.236.0.0/20,AWS 180.131.145.0/24,OVH 180.163.57.0-180.163.57.191,AWS
+	181.41.194.0/24,OVH 182.24.0.0-182.30.255.255,AWS 182.92.0.0/16,Alibaba 184.32.0.0/12,AWS 184.72.0.0/15,AWS 184.76.0.0/14,AWS
+	184.169.128.0/17,AWS 184.172.0.0/15,IBMCloud 184.174.96.0/23,OVH 184.192.0.0/11,AWS 185.2.49.0/24,OVH 185.3.73.0/24,OVH
 	185.3.92.0/22,Linode 185.5.39.0/24,OVH 185.6.28.0/24,OVH 185.10.200.0/22,OVH 185.12.32.0/23,OVH 185.12.65.0/24,Hetzner
 	185.14.184.0/22,DigitalOcean 185.15.68.0/22,OVH 185.30.212.0/23,OVH 185.42.204.0/22,AWS 185.45.160.0/22,OVH 185.48.120.0/22,AWS
 	185.50.120.0/23,Hetzner 185.68.137.0/24,OVH 185.95.157.0/24,OVH 185.101.104.0/24,OVH 185.107.52.0/22,Hetzner 185.113.138.0/24,OVH
@@ -1109,226 +561,194 @@ var ranges4 = `
 	185.250.41.0/24,OVH 185.251.234.0/24,OVH 185.255.28.0/24,OVH 188.34.128.0/17,Hetzner 188.40.0.0/16,Hetzner 188.68.164.0/22,OVH
 	188.165.0.0/16,OVH 188.166.0.0/16,DigitalOcean 188.220.22.0/24,OVH 188.220.38.0/24,OVH 188.220.150.0/24,OVH 188.226.128.0/17,DigitalOcean
 	188.245.0.0/16,Hetzner 188.255.193.0/24,OVH 191.44.99.0/24,OVH 191.44.124.0/24,OVH 191.96.140.0/23,OVH 191.101.150.0/24,OVH
-	191.101.210.0/24,OVH 191.101.218.0/24,OVH 191.232.16.0/21,Azure 191.232.32.0/19,Azure 191.232.64.0/20,Azure 191.232.160.0/19,Azure
-	191.232.192.0/18,Azure 191.233.0.0/19,Azure 191.233.32.0/20,Azure 191.233.48.0/21,Azure 191.233.64.0/18,Azure 191.233.128.0/17,Azure
-	191.234.2.0/23,Azure 191.234.16.0/20,Azure 191.234.32.0/19,Azure 191.234.128.0/17,Azure 191.235.32.0/19,Azure 191.235.64.0/18,Azure
-	191.235.128.0/18,Azure 191.235.192.0/19,Azure 191.235.224.0/20,Azure 191.235.240.0/21,Azure 191.235.248.0/23,Azure 191.235.250.0/25,Azure
-	191.235.255.0/24,Azure 191.236.0.0/16,Azure 191.237.0.0/17,Azure 191.237.128.0/18,Azure 191.237.192.0/22,Azure 191.237.196.0/24,Azure
-	191.237.200.0/21,Azure 191.237.208.0/20,Azure 191.237.224.0/21,Azure 191.237.232.0/22,Azure 191.237.236.0/24,Azure 191.237.238.0/24,Azure
-	191.237.240.0/23,Azure 191.237.248.0/21,Azure 191.238.0.0/18,Azure 191.238.64.0/22,Azure 191.238.68.0/24,Azure 191.238.70.0/23,Azure
-	191.238.72.0/21,Azure 191.238.80.0/21,Azure 191.238.88.0/22,Azure 191.238.92.0/23,Azure 191.238.96.0/19,Azure 191.238.128.0/21,Azure
-	191.238.144.0/20,Azure 191.238.160.0/19,Azure 191.238.192.0/18,Azure 191.239.0.0/17,Azure 191.239.160.0/19,Azure 191.239.192.0/22,Azure
-	191.239.200.0/21,Azure 191.239.208.0/20,Azure 191.239.224.0/19,Azure 192.9.128.0/18,Oracle 192.9.224.0/19,Oracle 192.16.64.0/21,AWS
-	192.18.128.0/19,Oracle 192.18.200.0/21,Oracle 192.22.0.0/19,Oracle 192.22.32.0/26,Oracle 192.22.32.128/25,Oracle 192.22.33.192/26,Oracle
-	192.22.34.0/23,Oracle 192.22.36.0/22,Oracle 192.22.40.0/22,Oracle 192.22.44.128/25,Oracle 192.22.48.0/23,Oracle 192.22.64.0/19,Oracle
-	192.22.96.0/26,Oracle 192.22.96.128/25,Oracle 192.22.97.192/26,Oracle 192.22.98.0/23,Oracle 192.22.100.0/22,Oracle 192.22.104.0/22,Oracle
-	192.22.108.128/25,Oracle 192.22.112.0/23,Oracle 192.22.128.0/19,Oracle 192.22.160.0/26,Oracle 192.22.160.128/25,Oracle 192.22.161.192/26,Oracle
-	192.22.162.0/23,Oracle 192.22.164.0/22,Oracle 192.22.168.0/22,Oracle 192.22.172.128/25,Oracle 192.22.176.0/23,Oracle 192.22.192.0/19,Oracle
-	192.22.224.0/26,Oracle 192.22.224.128/25,Oracle 192.22.225.192/26,Oracle 192.22.226.0/23,Oracle 192.22.228.0/22,Oracle 192.22.232.0/22,Oracle
-	192.22.236.128/25,Oracle 192.22.240.0/23,Oracle 192.29.8.0/21,Oracle 192.29.20.0/22,Oracle 192.29.24.0/21,Oracle 192.29.36.0/22,Oracle
-	192.29.40.0/21,Oracle 192.29.48.0/22,Oracle 192.29.56.0/23,Oracle 192.29.60.0/23,Oracle 192.29.64.0/21,Oracle 192.29.72.0/25,Oracle
-	192.29.80.0/22,Oracle 192.29.88.0/21,Oracle 192.29.96.0/19,Oracle 192.29.128.0/23,Oracle 192.29.130.0/24,Oracle 192.29.134.0/23,Oracle
-	192.29.137.192/26,Oracle 192.29.138.0/23,Oracle 192.29.140.0/22,Oracle 192.29.144.0/23,Oracle 192.29.148.0/23,Oracle 192.29.151.0/24,Oracle
-	192.29.152.0/22,Oracle 192.29.158.0/23,Oracle 192.29.160.0/21,Oracle 192.29.168.0/22,Oracle 192.29.172.0/24,Oracle 192.29.178.0/23,Oracle
-	192.29.180.0/22,Oracle 192.29.192.0/22,Oracle 192.29.200.0/21,Oracle 192.29.208.0/22,Oracle 192.29.216.0/21,Oracle 192.29.224.0/22,Oracle
-	192.29.232.0/25,Oracle 192.29.232.192/26,Oracle 192.29.240.0/22,Oracle 192.29.248.0/21,Oracle 192.30.124.0/24,OVH 192.31.212.0/23,AWS
-	192.34.56.0/21,DigitalOcean 192.43.175.0/24,AWS 192.43.184.0/24,AWS 192.46.208.0/20,Linode 192.46.224.0/20,Linode 192.48.205.0/24,OVH
-	192.53.112.0/20,Linode 192.53.160.0/20,Linode 192.65.20.0/22,OVH 192.70.246.0/23,OVH 192.81.128.0/21,Linode 192.81.208.0/20,DigitalOcean
-	192.95.0.0/18,OVH 192.99.0.0/16,OVH 192.108.239.0/24,AWS 192.109.11.0/24,OVH 192.124.170.0/24,OVH 192.152.126.0/24,OVH
-	192.155.80.0/20,Linode 192.157.36.0/24,AWS 192.157.72.0/23,AWS 192.158.28.0/22,GoogleCloud 192.177.90.0/24,OVH 192.189.197.0/24,AWS
-	192.207.105.0/24,OVH 192.240.152.0/21,OVH 192.241.128.0/19,DigitalOcean 192.241.160.0/22,DigitalOcean 192.241.165.0/24,DigitalOcean 192.241.166.0/23,DigitalOcean
-	192.241.168.0/21,DigitalOcean 192.241.176.0/20,DigitalOcean 192.241.192.0/18,DigitalOcean 193.17.223.0/24,OVH 193.19.76.0/23,OVH 193.33.176.0/23,OVH
-	193.70.0.0/17,OVH 193.93.254.0/24,OVH 193.105.82.0/24,Hetzner 193.110.6.0/23,Hetzner 193.122.0.0/15,Oracle 193.149.28.0/22,OVH
-	193.149.64.0/19,Azure 193.151.166.0/24,OVH 193.163.198.0/24,Hetzner 193.219.99.0/24,OVH 193.221.202.0/24,OVH 193.227.135.0/24,Oracle
-	193.243.147.0/24,OVH 194.42.180.0/22,Hetzner 194.42.184.0/22,Hetzner 194.61.44.0/23,OVH 194.62.106.0/24,Hetzner 194.76.36.0/23,OVH
-	194.76.173.0/24,OVH 194.77.220.0/24,OVH 194.87.205.0/24,OVH 194.88.232.0/24,OVH 194.104.138.0/24,OVH 194.110.171.0/24,OVH
-	194.147.159.0/24,OVH 194.156.227.0/24,OVH 194.164.156.0/22,Oracle 194.164.230.0/24,OVH 194.164.248.0/21,Oracle 194.195.112.0/20,Linode
-	194.195.208.0/20,Linode 194.195.240.0/20,Linode 194.233.160.0/19,Linode 195.17.0.0/24,AWS 195.20.146.0/24,OVH 195.38.19.0/24,OVH
-	195.60.226.0/24,Hetzner 195.62.72.0/23,OVH 195.66.30.0/23,OVH 195.123.189.0/24,OVH 195.123.191.0/24,OVH 195.144.5.0/24,OVH
-	195.201.0.0/16,Hetzner 195.248.224.0/24,Hetzner 197.242.84.0/22,Hetzner 198.11.128.0/18,Alibaba 198.17.79.0/24,OVH 198.27.64.0/18,OVH
-	198.41.96.0/21,AWS 198.41.104.0/22,AWS 198.49.103.0/24,OVH 198.50.128.0/17,OVH 198.58.96.0/21,Linode 198.58.104.0/22,Linode
-	198.58.109.0/24,Linode 198.58.110.0/23,Linode 198.58.112.0/20,Linode 198.74.48.0/21,Linode 198.74.56.0/22,Linode 198.74.60.0/23,Linode
-	198.74.62.0/24,Linode 198.99.2.0/24,AWS 198.100.144.0/20,OVH 198.101.27.0/24,OVH 198.180.97.0/25,Azure 198.180.97.128/26,Azure
-	198.180.97.192/27,Azure 198.180.97.224/30,Azure 198.180.97.228/31,Azure 198.199.64.0/19,DigitalOcean 198.199.96.0/23,DigitalOcean 198.199.98.0/24,DigitalOcean
-	198.199.100.0/22,DigitalOcean 198.199.104.0/21,DigitalOcean 198.199.112.0/20,DigitalOcean 198.211.96.0/21,DigitalOcean 198.211.104.0/22,DigitalOcean 198.211.108.0/23,DigitalOcean
-	198.211.110.0/24,DigitalOcean 198.211.112.0/20,DigitalOcean 198.244.128.0/17,OVH 198.245.48.0/20,OVH 199.7.141.0/24,OVH 199.9.248.0/21,AWS
-	199.30.16.0/24,Azure 199.30.18.0/23,Azure 199.30.20.0/24,Azure 199.30.22.0/24,Azure 199.30.24.0/23,Azure 199.30.27.0/25,Azure
-	199.30.27.144/28,Azure 199.30.27.160/27,Azure 199.30.28.64/26,Azure 199.30.28.128/25,Azure 199.30.29.0/24,Azure 199.30.31.0/25,Azure
-	199.30.31.192/26,Azure 199.48.178.0/24,OVH 199.127.232.0/22,AWS 199.168.192.0/22,OVH 199.192.115.0/24,GoogleCloud 199.193.138.0/24,OVH
-	199.195.140.0/23,OVH 199.223.232.0/22,GoogleCloud 199.223.236.0/24,GoogleCloud 201.79.0.0/20,DigitalOcean 201.131.3.0/24,Hetzner 202.2.60.0/22,OVH
-	202.89.233.64/27,Azure 202.89.233.96/28,Azure 202.89.235.128/25,Azure 202.92.214.0/23,OVH 202.144.199.0/24,Alibaba 202.174.132.0/22,AWS
-	202.181.0.0/24,OVH 203.5.184.0/24,OVH 203.27.201.0/24,OVH 203.83.220.0/22,AWS 203.107.1.0/24,Alibaba 203.107.2.0/23,Alibaba
-	203.107.6.0/24,Alibaba 203.107.13.0/24,Alibaba 203.107.20.0/22,Alibaba 203.107.26.0/23,Alibaba 203.107.28.0/22,Alibaba 203.107.32.0/19,Alibaba
-	203.107.64.0/22,Alibaba 203.107.68.0/24,Alibaba 203.107.72.0/21,Alibaba 203.107.80.0/21,Alibaba 203.107.96.0/23,Alibaba 203.107.112.0/21,Alibaba
-	203.107.120.0/22,Alibaba 203.107.124.0/23,Alibaba 203.119.128.0/19,Alibaba 203.119.160.0/24,Alibaba 203.119.168.0/21,Alibaba 203.119.176.0/20,Alibaba
-	203.119.192.0/18,Alibaba 203.209.224.0/19,Alibaba 204.29.146.0/24,Hetzner 204.48.16.0/20,DigitalOcean 204.79.180.0/24,Azure 204.79.197.0/24,Azure
-	204.87.185.0/24,AWS 204.152.18.0/31,Azure 204.152.18.8/29,Azure 204.152.18.32/27,Azure 204.152.18.64/26,Azure 204.152.19.0/24,Azure
-	204.168.128.0/17,Hetzner 204.216.104.0/21,Oracle 204.216.112.0/23,Oracle 204.216.119.192/26,Oracle 204.216.120.0/22,Oracle 204.216.127.192/26,Oracle
-	204.216.128.0/18,Oracle 204.216.192.0/19,Oracle 204.236.128.0/17,AWS 204.246.160.0/19,AWS 205.147.88.0/23,Oracle 205.198.40.0/23,Alibaba
-	205.198.43.0/24,Alibaba 205.198.44.0/22,Alibaba 205.204.96.0/19,Alibaba 205.234.176.0/24,OVH 205.251.192.0/19,AWS 205.251.225.0/24,AWS
-	205.251.226.0/24,AWS 205.251.228.0/22,AWS 205.251.232.0/21,AWS 205.251.240.0/21,AWS 205.251.248.0/22,AWS 205.251.252.0/23,AWS
-	205.251.254.0/24,AWS 206.72.209.0/24,AWS 206.81.0.0/19,DigitalOcean 206.168.95.0/24,OVH 206.168.174.0/23,OVH 206.189.0.0/16,DigitalOcean
-	206.206.126.0/24,OVH 207.46.13.0/24,Azure 207.46.50.129/32,Azure 207.46.50.130/31,Azure 207.46.50.138/31,Azure 207.46.50.140/31,Azure
-	207.46.59.64/27,Azure 207.46.63.64/27,Azure 207.46.63.128/25,Azure 207.46.72.0/27,Azure 207.46.77.225/32,Azure 207.46.77.226/31,Azure
-	207.46.77.228/32,Azure 207.46.87.0/24,Azure 207.46.89.16/28,Azure 207.46.95.32/27,Azure 207.46.126.0/24,Azure 207.46.128.0/19,Azure
-	207.46.193.192/28,Azure 207.46.200.96/27,Azure 207.46.200.176/28,Azure 207.46.202.128/28,Azure 207.46.205.0/24,Azure 207.46.224.0/20,Azure
-	207.68.174.8/29,Azure 207.68.174.40/29,Azure 207.68.174.48/29,Azure 207.68.174.184/29,Azure 207.68.174.208/28,Azure 207.127.66.128/25,Oracle
-	207.127.68.0/22,Oracle 207.127.72.0/22,Oracle 207.127.80.0/23,Oracle 207.127.84.0/23,Oracle 207.127.86.0/25,Oracle 207.127.88.0/21,Oracle
-	207.127.96.0/21,Oracle 207.127.107.192/26,Oracle 207.127.109.192/26,Oracle 207.127.124.0/22,Oracle 207.135.0.0/20,Oracle 207.135.16.0/22,Oracle
-	207.135.20.0/23,Oracle 207.135.25.0/24,Oracle 207.135.26.0/23,Oracle 207.135.28.0/22,Oracle 207.154.192.0/18,DigitalOcean 207.166.205.0/24,OVH
-	207.166.206.0/24,OVH 207.171.160.0/19,AWS 207.175.0.0/16,GoogleCloud 207.180.13.0/24,OVH 207.180.21.0/24,OVH 207.211.128.0/22,Oracle
-	207.211.132.0/25,Oracle 207.211.140.0/22,Oracle 207.211.144.0/20,Oracle 207.211.160.0/19,Oracle 207.230.127.0/24,OVH 207.244.209.0/24,OVH
+	191.101.210.0/24,OVH 191.101.218.0/24,OVH 191.232.16.0/21,Azure 191.232.32.0-191.232.79.255,Azure 191.232.160.0-191.233.55.255,Azure 191.233.64.0-191.233.255.255,Azure
+	191.234.2.0/23,Azure 191.234.16.0-191.234.63.255,Azure 191.234.128.0/17,Azure 191.235.32.0-191.235.250.127,Azure 191.235.255.0-191.237.196.255,Azure 191.237.200.0-191.237.236.255,Azure
+	191.237.238.0/24,Azure 191.237.240.0/23,Azure 191.237.248.0-191.238.68.255,Azure 191.238.70.0-191.238.93.255,Azure 191.238.96.0-191.238.135.255,Azure 191.238.144.0-191.239.127.255,Azure
+	191.239.160.0-191.239.195.255,Azure 191.239.200.0-191.239.255.255,Azure 192.9.128.0/18,Oracle 192.9.224.0/19,Oracle 192.16.64.0/21,AWS 192.18.128.0/19,Oracle
+	192.18.200.0/21,Oracle 192.22.0.0-192.22.32.63,Oracle 192.22.32.128/25,Oracle 192.22.33.192-192.22.43.255,Oracle 192.22.44.128/25,Oracle 192.22.48.0/23,Oracle
+	192.22.64.0-192.22.96.63,Oracle 192.22.96.128/25,Oracle 192.22.97.192-192.22.107.255,Oracle 192.22.108.128/25,Oracle 192.22.112.0/23,Oracle 192.22.128.0-192.22.160.63,Oracle
+	192.22.160.128/25,Oracle 192.22.161.192-192.22.171.255,Oracle 192.22.172.128/25,Oracle 192.22.176.0/23,Oracle 192.22.192.0-192.22.224.63,Oracle 192.22.224.128/25,Oracle
+	192.22.225.192-192.22.235.255,Oracle 192.22.236.128/25,Oracle 192.22.240.0/23,Oracle 192.29.8.0/21,Oracle 192.29.20.0-192.29.31.255,Oracle 192.29.36.0-192.29.51.255,Oracle
+	192.29.56.0/23,Oracle 192.29.60.0/23,Oracle 192.29.64.0-192.29.72.127,Oracle 192.29.80.0/22,Oracle 192.29.88.0-192.29.130.255,Oracle 192.29.134.0/23,Oracle
+	192.29.137.192-192.29.145.255,Oracle 192.29.148.0/23,Oracle 192.29.151.0-192.29.155.255,Oracle 192.29.158.0-192.29.172.255,Oracle 192.29.178.0-192.29.183.255,Oracle 192.29.192.0/22,Oracle
+	192.29.200.0-192.29.211.255,Oracle 192.29.216.0-192.29.227.255,Oracle 192.29.232.0/25,Oracle 192.29.232.192/26,Oracle 192.29.240.0/22,Oracle 192.29.248.0/21,Oracle
+	192.30.124.0/24,OVH 192.31.212.0/23,AWS 192.34.56.0/21,DigitalOcean 192.43.175.0/24,AWS 192.43.184.0/24,AWS 192.46.208.0-192.46.239.255,Linode
+	192.48.205.0/24,OVH 192.53.112.0/20,Linode 192.53.160.0/20,Linode 192.65.20.0/22,OVH 192.70.246.0/23,OVH 192.81.128.0/21,Linode
+	192.81.208.0/20,DigitalOcean 192.95.0.0/18,OVH 192.99.0.0/16,OVH 192.108.239.0/24,AWS 192.109.11.0/24,OVH 192.124.170.0/24,OVH
+	192.152.126.0/24,OVH 192.155.80.0/20,Linode 192.157.36.0/24,AWS 192.157.72.0/23,AWS 192.158.28.0/22,GoogleCloud 192.177.90.0/24,OVH
+	192.189.197.0/24,AWS 192.207.105.0/24,OVH 192.240.152.0/21,OVH 192.241.128.0-192.241.163.255,DigitalOcean 192.241.165.0-192.241.255.255,DigitalOcean 193.17.223.0/24,OVH
+	193.19.76.0/23,OVH 193.33.176.0/23,OVH 193.70.0.0/17,OVH 193.93.254.0/24,OVH 193.105.82.0/24,Hetzner 193.110.6.0/23,Hetzner
+	193.122.0.0/15,Oracle 193.149.28.0/22,OVH 193.149.64.0/19,Azure 193.151.166.0/24,OVH 193.163.198.0/24,Hetzner 193.219.99.0/24,OVH
+	193.221.202.0/24,OVH 193.227.135.0/24,Oracle 193.243.147.0/24,OVH 194.42.180.0-194.42.187.255,Hetzner 194.61.44.0/23,OVH 194.62.106.0/24,Hetzner
+	194.76.36.0/23,OVH 194.76.173.0/24,OVH 194.77.220.0/24,OVH 194.87.205.0/24,OVH 194.88.232.0/24,OVH 194.104.138.0/24,OVH
+	194.110.171.0/24,OVH 194.147.159.0/24,OVH 194.156.227.0/24,OVH 194.164.156.0/22,Oracle 194.164.230.0/24,OVH 194.164.248.0/21,Oracle
+	194.195.112.0/20,Linode 194.195.208.0/20,Linode 194.195.240.0/20,Linode 194.233.160.0/19,Linode 195.17.0.0/24,AWS 195.20.146.0/24,OVH
+	195.38.19.0/24,OVH 195.60.226.0/24,Hetzner 195.62.72.0/23,OVH 195.66.30.0/23,OVH 195.123.189.0/24,OVH 195.123.191.0/24,OVH
+	195.144.5.0/24,OVH 195.154.0.0/16,Scaleway 195.201.0.0/16,Hetzner 195.248.224.0/24,Hetzner 197.242.84.0/22,Hetzner 198.11.128.0/18,Alibaba
+	198.17.79.0/24,OVH 198.27.64.0/18,OVH 198.41.96.0-198.41.107.255,AWS 198.49.103.0/24,OVH 198.50.128.0/17,OVH 198.58.96.0-198.58.107.255,Linode
+	198.58.109.0-198.58.127.255,Linode 198.74.48.0-198.74.62.255,Linode 198.99.2.0/24,AWS 198.100.144.0/20,OVH 198.101.27.0/24,OVH 198.180.97.0-198.180.97.229,Azure
+	198.199.64.0-198.199.98.255,DigitalOcean 198.199.100.0-198.199.127.255,DigitalOcean 198.211.96.0-198.211.110.255,DigitalOcean 198.211.112.0/20,DigitalOcean 198.244.128.0/17,OVH 198.245.48.0/20,OVH
+	199.7.141.0/24,OVH 199.9.248.0/21,AWS 199.30.16.0/24,Azure 199.30.18.0-199.30.20.255,Azure 199.30.22.0/24,Azure 199.30.24.0/23,Azure
+	199.30.27.0/25,Azure 199.30.27.144-199.30.27.191,Azure 199.30.28.64-199.30.29.255,Azure 199.30.31.0/25,Azure 199.30.31.192/26,Azure 199.48.178.0/24,OVH
+	199.127.232.0/22,AWS 199.168.192.0/22,OVH 199.192.115.0/24,GoogleCloud 199.193.138.0/24,OVH 199.195.140.0/23,OVH 199.223.232.0-199.223.236.255,GoogleCloud
+	201.79.0.0/20,DigitalOcean 201.131.3.0/24,Hetzner 202.2.60.0/22,OVH 202.89.233.64-202.89.233.111,Azure 202.89.235.128/25,Azure 202.92.214.0/23,OVH
+	202.144.199.0/24,Alibaba 202.174.132.0/22,AWS 202.181.0.0/24,OVH 203.5.184.0/24,OVH 203.27.201.0/24,OVH 203.83.220.0/22,AWS
+	203.107.1.0-203.107.3.255,Alibaba 203.107.6.0/24,Alibaba 203.107.13.0/24,Alibaba 203.107.20.0/22,Alibaba 203.107.26.0-203.107.68.255,Alibaba 203.107.72.0-203.107.87.255,Alibaba
+	203.107.96.0/23,Alibaba 203.107.112.0-203.107.125.255,Alibaba 203.119.128.0-203.119.160.255,Alibaba 203.119.168.0-203.119.255.255,Alibaba 203.209.224.0/19,Alibaba 204.29.146.0/24,Hetzner
+	204.48.16.0/20,DigitalOcean 204.79.180.0/24,Azure 204.79.197.0/24,Azure 204.87.185.0/24,AWS 204.152.18.0/31,Azure 204.152.18.8/29,Azure
+	204.152.18.32-204.152.18.127,Azure 204.152.19.0/24,Azure 204.168.128.0/17,Hetzner 204.216.104.0-204.216.113.255,Oracle 204.216.119.192-204.216.123.255,Oracle 204.216.127.192-204.216.223.255,Oracle
+	204.236.128.0/17,AWS 204.246.160.0/19,AWS 205.147.88.0/23,Oracle 205.198.40.0/23,Alibaba 205.198.43.0-205.198.47.255,Alibaba 205.204.96.0/19,Alibaba
+	205.234.176.0/24,OVH 205.251.192.0/19,AWS 205.251.225.0-205.251.226.255,AWS 205.251.228.0-205.251.254.255,AWS 206.72.209.0/24,AWS 206.81.0.0/19,DigitalOcean
+	206.168.95.0/24,OVH 206.168.174.0/23,OVH 206.189.0.0/16,DigitalOcean 206.206.126.0/24,OVH 207.46.13.0/24,Azure 207.46.50.129-207.46.50.131,Azure
+	207.46.50.138-207.46.50.141,Azure 207.46.59.64/27,Azure 207.46.63.64/27,Azure 207.46.63.128/25,Azure 207.46.72.0/27,Azure 207.46.77.225-207.46.77.228,Azure
+	207.46.87.0/24,Azure 207.46.89.16/28,Azure 207.46.95.32/27,Azure 207.46.126.0/24,Azure 207.46.128.0/19,Azure 207.46.193.192/28,Azure
+	207.46.200.96/27,Azure 207.46.200.176/28,Azure 207.46.202.128/28,Azure 207.46.205.0/24,Azure 207.46.224.0/20,Azure 207.68.174.8/29,Azure
+	207.68.174.40-207.68.174.55,Azure 207.68.174.184/29,Azure 207.68.174.208/28,Azure 207.127.66.128/25,Oracle 207.127.68.0-207.127.75.255,Oracle 207.127.80.0/23,Oracle
+	207.127.84.0-207.127.86.127,Oracle 207.127.88.0-207.127.103.255,Oracle 207.127.107.192/26,Oracle 207.127.109.192/26,Oracle 207.127.124.0/22,Oracle 207.135.0.0-207.135.21.255,Oracle
+	207.135.25.0-207.135.31.255,Oracle 207.154.192.0/18,DigitalOcean 207.166.205.0-207.166.206.255,OVH 207.171.160.0/19,AWS 207.175.0.0/16,GoogleCloud 207.180.13.0/24,OVH
+	207.180.21.0/24,OVH 207.180.192.0/18,Contabo 207.211.128.0-207.211.132.127,Oracle 207.211.140.0-207.211.191.255,Oracle 207.230.127.0/24,OVH 207.244.209.0/24,OVH
 	208.64.136.0/21,OVH 208.68.36.0/22,DigitalOcean 208.78.128.0/21,AWS 208.86.88.0/22,AWS 208.110.48.0/20,AWS 208.122.8.0/24,OVH
 	209.17.60.0/22,Oracle 209.38.0.0/16,DigitalOcean 209.54.176.0/20,AWS 209.71.36.0/24,OVH 209.97.128.0/18,DigitalOcean 209.112.80.0/22,OVH
-	209.126.71.0/24,OVH 209.146.118.0/23,Alibaba 209.151.124.0/24,OVH 209.199.16.0/20,Azure 209.199.32.0/20,Azure 209.199.128.0/23,Azure
-	209.240.212.0/23,Azure 209.251.24.0/24,OVH 212.69.8.0/24,OVH 212.71.232.0/21,Linode 212.71.244.0/22,Linode 212.71.248.0/21,Linode
-	212.74.39.0/24,OVH 212.100.171.0/24,OVH 212.111.40.0/22,Linode 212.134.90.0/24,OVH 212.135.160.0/24,OVH 212.192.253.0/24,OVH
-	213.19.198.156/31,Oracle 213.19.198.164/31,Oracle 213.32.0.0/17,OVH 213.35.96.0/19,Oracle 213.52.128.0/23,Linode 213.52.130.0/24,Linode
-	213.130.130.0/24,OVH 213.130.149.0/24,OVH 213.133.96.0/19,Hetzner 213.168.248.0/22,Linode 213.177.172.0/24,OVH 213.186.32.0/19,OVH
-	213.199.128.0/20,Azure 213.199.169.0/24,Azure 213.199.180.32/28,Azure 213.199.180.96/27,Azure 213.199.180.192/27,Azure 213.199.183.0/24,Azure
-	213.218.214.0/24,OVH 213.219.36.0/22,Linode 213.239.192.0/18,Hetzner 213.251.128.0/18,OVH 216.39.136.0/21,AWS 216.39.152.0/21,AWS
-	216.39.160.0/20,AWS 216.55.108.0/22,Hetzner 216.122.123.0/24,OVH 216.137.32.0/19,AWS 216.182.224.0/20,AWS 216.183.120.0/24,OVH
-	216.198.192.0/23,AWS 216.198.194.0/24,AWS 216.198.196.0/22,AWS 216.198.200.0/21,AWS 216.198.208.0/20,AWS 216.198.224.0/19,AWS
-	216.203.15.0/24,OVH 216.211.218.0/24,OVH 216.216.0.0/15,AWS 216.220.208.0/22,Azure 216.220.212.0/24,Azure 216.244.0.0/18,AWS
-	216.247.96.0/24,OVH 217.11.174.0/24,OVH 217.60.196.0/24,OVH 217.65.73.0/24,OVH 217.142.128.0/19,Oracle 217.142.160.0/21,Oracle
-	217.142.168.0/22,Oracle 217.142.176.0/23,Oracle 217.142.178.128/25,Oracle 217.142.184.0/21,Oracle 217.142.224.0/19,Oracle 217.182.0.0/16,OVH
-	217.217.26.0/24,OVH 218.244.128.0/19,Alibaba 220.158.64.0/20,Oracle 222.167.237.0/24,OVH 223.4.0.0/14,Alibaba
+	209.126.71.0/24,OVH 209.146.118.0/23,Alibaba 209.151.124.0/24,OVH 209.199.16.0-209.199.47.255,Azure 209.199.128.0/23,Azure 209.240.212.0/23,Azure
+	209.251.24.0/24,OVH 212.47.224.0/19,Scaleway 212.69.8.0/24,OVH 212.71.232.0/21,Linode 212.71.244.0-212.71.255.255,Linode 212.74.39.0/24,OVH
+	212.100.171.0/24,OVH 212.111.40.0/22,Linode 212.134.90.0/24,OVH 212.135.160.0/24,OVH 212.192.253.0/24,OVH 213.19.198.156/31,Oracle
+	213.19.198.164/31,Oracle 213.32.0.0/17,OVH 213.35.96.0/19,Oracle 213.52.128.0-213.52.130.255,Linode 213.130.130.0/24,OVH 213.130.149.0/24,OVH
+	213.133.96.0/19,Hetzner 213.168.248.0/22,Linode 213.177.172.0/24,OVH 213.186.32.0/19,OVH 213.199.128.0/20,Azure 213.199.169.0/24,Azure
+	213.199.180.32/28,Azure 213.199.180.96/27,Azure 213.199.180.192/27,Azure 213.199.183.0/24,Azure 213.218.214.0/24,OVH 213.219.36.0/22,Linode
+	213.239.192.0/18,Hetzner 213.251.128.0/18,OVH 216.39.136.0/21,AWS 216.39.152.0-216.39.175.255,AWS 216.55.108.0/22,Hetzner 216.122.123.0/24,OVH
+	216.137.32.0/19,AWS 216.182.224.0/20,AWS 216.183.120.0/24,OVH 216.198.192.0-216.198.194.255,AWS 216.198.196.0-216.198.255.255,AWS 216.203.15.0/24,OVH
+	216.211.218.0/24,OVH 216.216.0.0/15,AWS 216.220.208.0-216.220.212.255,Azure 216.244.0.0/18,AWS 216.247.96.0/24,OVH 217.11.174.0/24,OVH
+	217.60.196.0/24,OVH 217.65.73.0/24,OVH 217.142.128.0-217.142.171.255,Oracle 217.142.176.0/23,Oracle 217.142.178.128/25,Oracle 217.142.184.0/21,Oracle
+	217.142.224.0/19,Oracle 217.182.0.0/16,OVH 217.217.26.0/24,OVH 218.244.128.0/19,Alibaba 220.158.64.0/20,Oracle 222.167.237.0/24,OVH
+	223.4.0.0/14,Alibaba
 `
 
 var ranges6 = `
-	2001:3fc0:800::/40,AWS 2001:3fc1:8000::/36,AWS 2001:3fc2:8000::/36,AWS 2001:3fc3:800::/40,AWS 2001:3fc3:2800::/40,AWS 2001:3fc3:3000::/36,AWS
-	2001:3fc3:6800::/40,AWS 2001:3fc3:8800::/40,AWS 2001:3fc3:a800::/40,AWS 2001:3fc4:800::/40,AWS 2001:3fc5:800::/40,AWS 2001:3fc5:1000::/40,AWS
-	2001:3fc5:2000::/40,AWS 2001:3fc5:8800::/40,AWS 2001:3fc6::/55,AWS 2001:3fc6:1::/48,AWS 2001:3fc6:2::/48,AWS 2001:3fc6:8::/45,AWS
-	2001:3fc6:20::/43,AWS 2001:3fc6:100::/48,AWS 2001:3fc6:200::/40,AWS 2001:3fc7:800::/40,AWS 2001:3fc7:1800::/40,AWS 2001:3fc7:2800::/40,AWS
-	2001:3fc7:3000::/40,AWS 2001:3fc7:4800::/40,AWS 2001:3fc7:5800::/40,AWS 2001:3fc7:6800::/40,AWS 2001:3fc7:8800::/40,AWS 2001:3fc7:9800::/40,AWS
-	2001:3fc7:a800::/40,AWS 2001:3fc7:c800::/40,AWS 2001:3fc7:e800::/40,AWS 2001:3fc7:f400::/40,AWS 2001:3fc7:f800::/40,AWS 2001:41d0::/32,OVH
-	2400:3200::/32,Alibaba 2400:6180:0:d0::/62,DigitalOcean 2400:6180:10:200::/64,DigitalOcean 2400:6180:100:d0::/64,DigitalOcean 2400:6500:0:3::/64,AWS 2400:6500:0:9::1/128,AWS
-	2400:6500:0:9::2/127,AWS 2400:6500:0:9::4/128,AWS 2400:6500:0:7000::/52,AWS 2400:6500:0:b000::/56,AWS 2400:6500:100:7200::/56,AWS 2400:6500:ff00::/48,AWS
-	2400:6700::/48,AWS 2400:6700:ff00::/48,AWS 2400:7fc0::/40,AWS 2400:7fc0:110::/47,AWS 2400:7fc0:112::/48,AWS 2400:7fc0:200::/40,AWS
-	2400:7fc0:500::/40,AWS 2400:7fc0:2100::/40,AWS 2400:7fc0:2200::/40,AWS 2400:7fc0:2400::/40,AWS 2400:7fc0:2800::/40,AWS 2400:7fc0:2a00::/40,AWS
-	2400:7fc0:2c00::/40,AWS 2400:7fc0:2e80::/48,AWS 2400:7fc0:2f00::/40,AWS 2400:7fc0:3000::/40,AWS 2400:7fc0:4000::/40,AWS 2400:7fc0:4100::/48,AWS
-	2400:7fc0:6000::/40,AWS 2400:7fc0:8000::/36,AWS 2400:7fc0:a000::/36,AWS 2400:7fc0:bb00::/40,AWS 2400:7fc0:c000::/36,AWS 2400:7fc0:e800::/40,AWS
-	2400:7fc0:ea00::/39,AWS 2400:7fc0:ef00::/40,AWS 2400:7fc0:f300::/40,AWS 2400:8901::/32,Linode 2400:8902::/32,Linode 2400:8904::/31,Linode
-	2400:8907::/32,Linode 2400:b200::/32,Alibaba 2401:2e00::/32,Alibaba 2401:8680:4004::/46,Alibaba 2401:8680:4100::/47,Alibaba 2401:8680:4102::/48,Alibaba
-	2401:b180::/32,Alibaba 2402:1f00::/32,OVH 2403:b300::/48,AWS 2403:b300:ff00::/48,AWS 2404:2280:1000::/36,Alibaba 2404:2280:2000::/35,Alibaba
-	2404:2280:4000::/36,Alibaba 2404:c2c0::/40,AWS 2404:c2c0:110::/47,AWS 2404:c2c0:112::/48,AWS 2404:c2c0:200::/40,AWS 2404:c2c0:500::/40,AWS
-	2404:c2c0:2100::/40,AWS 2404:c2c0:2200::/40,AWS 2404:c2c0:2400::/40,AWS 2404:c2c0:2600::/40,AWS 2404:c2c0:2800::/40,AWS 2404:c2c0:2a00::/40,AWS
-	2404:c2c0:2c00::/40,AWS 2404:c2c0:2e80::/48,AWS 2404:c2c0:2f00::/40,AWS 2404:c2c0:3000::/39,AWS 2404:c2c0:4000::/40,AWS 2404:c2c0:4100::/48,AWS
-	2404:c2c0:6000::/40,AWS 2404:c2c0:8000::/36,AWS 2404:c2c0:bb00::/40,AWS 2404:c2c0:c000::/36,AWS 2404:c2c0:e800::/40,AWS 2404:c2c0:ea00::/39,AWS
-	2404:c2c0:ef00::/40,AWS 2404:c2c0:f300::/40,AWS 2405:e000::/32,Alibaba 2406:da00:800::/40,AWS 2406:da00:1000::/40,AWS 2406:da00:2000::/40,AWS
-	2406:da00:2800::/40,AWS 2406:da00:4000::/40,AWS 2406:da00:4800::/40,AWS 2406:da00:6000::/40,AWS 2406:da00:7000::/40,AWS 2406:da00:8000::/40,AWS
-	2406:da00:9000::/40,AWS 2406:da00:a000::/40,AWS 2406:da00:b000::/40,AWS 2406:da00:c000::/40,AWS 2406:da00:c800::/40,AWS 2406:da00:e000::/40,AWS
-	2406:da00:f000::/40,AWS 2406:da00:ff00::/48,AWS 2406:da10:8000::/36,AWS 2406:da11::/36,AWS 2406:da12::/36,AWS 2406:da12:8000::/36,AWS
-	2406:da13::/36,AWS 2406:da14::/35,AWS 2406:da14:8000::/36,AWS 2406:da15::/36,AWS 2406:da16::/36,AWS 2406:da17::/36,AWS
-	2406:da18::/35,AWS 2406:da18:8000::/36,AWS 2406:da19::/36,AWS 2406:da1a::/35,AWS 2406:da1a:8000::/36,AWS 2406:da1b::/36,AWS
-	2406:da1c::/35,AWS 2406:da1c:8000::/36,AWS 2406:da1d::/36,AWS 2406:da1e::/32,AWS 2406:da1f::/36,AWS 2406:da20:8000::/36,AWS
-	2406:da21::/36,AWS 2406:da22::/36,AWS 2406:da22:8000::/36,AWS 2406:da23::/36,AWS 2406:da24::/36,AWS 2406:da24:8000::/36,AWS
-	2406:da25::/36,AWS 2406:da26::/36,AWS 2406:da27::/36,AWS 2406:da28::/36,AWS 2406:da28:8000::/36,AWS 2406:da29::/36,AWS
-	2406:da2a::/36,AWS 2406:da2a:8000::/36,AWS 2406:da2b::/36,AWS 2406:da2c::/36,AWS 2406:da2c:8000::/36,AWS 2406:da2d::/36,AWS
-	2406:da2e::/36,AWS 2406:da2f::/36,AWS 2406:da30:800::/40,AWS 2406:da30:1000::/40,AWS 2406:da30:2000::/40,AWS 2406:da30:2800::/40,AWS
-	2406:da30:4000::/40,AWS 2406:da30:4800::/40,AWS 2406:da30:6000::/40,AWS 2406:da30:7000::/40,AWS 2406:da30:8000::/40,AWS 2406:da30:8800::/40,AWS
-	2406:da30:9000::/40,AWS 2406:da30:a000::/40,AWS 2406:da30:b000::/40,AWS 2406:da30:c000::/40,AWS 2406:da30:c800::/40,AWS 2406:da30:e000::/40,AWS
-	2406:da30:f000::/40,AWS 2406:da32:800::/40,AWS 2406:da32:1000::/40,AWS 2406:da32:2000::/40,AWS 2406:da32:2800::/40,AWS 2406:da32:4000::/40,AWS
-	2406:da32:4800::/40,AWS 2406:da32:6000::/40,AWS 2406:da32:7000::/40,AWS 2406:da32:8000::/40,AWS 2406:da32:8800::/40,AWS 2406:da32:9000::/40,AWS
-	2406:da32:a000::/40,AWS 2406:da32:b000::/40,AWS 2406:da32:c000::/40,AWS 2406:da32:c800::/40,AWS 2406:da32:e000::/40,AWS 2406:da32:f000::/40,AWS
-	2406:da33:800::/40,AWS 2406:da33:1000::/40,AWS 2406:da33:2000::/40,AWS 2406:da33:2800::/40,AWS 2406:da33:4000::/40,AWS 2406:da33:4800::/40,AWS
-	2406:da33:6000::/40,AWS 2406:da33:7000::/40,AWS 2406:da33:8000::/40,AWS 2406:da33:8800::/40,AWS 2406:da33:9000::/40,AWS 2406:da33:a000::/40,AWS
-	2406:da33:b000::/40,AWS 2406:da33:c000::/40,AWS 2406:da33:c800::/40,AWS 2406:da33:e000::/40,AWS 2406:da33:f000::/40,AWS 2406:da36:800::/40,AWS
-	2406:da36:1000::/40,AWS 2406:da36:2000::/40,AWS 2406:da36:2800::/40,AWS 2406:da36:4000::/40,AWS 2406:da36:4800::/40,AWS 2406:da36:6000::/40,AWS
-	2406:da36:7000::/40,AWS 2406:da36:8000::/40,AWS 2406:da36:8800::/40,AWS 2406:da36:9000::/40,AWS 2406:da36:a000::/40,AWS 2406:da36:b000::/40,AWS
-	2406:da36:c000::/40,AWS 2406:da36:c800::/40,AWS 2406:da36:e000::/40,AWS 2406:da36:f000::/40,AWS 2406:da38:800::/40,AWS 2406:da38:1000::/40,AWS
-	2406:da38:2000::/40,AWS 2406:da38:2800::/40,AWS 2406:da38:4000::/40,AWS 2406:da38:4800::/40,AWS 2406:da38:6000::/40,AWS 2406:da38:7000::/40,AWS
-	2406:da38:8000::/40,AWS 2406:da38:8800::/40,AWS 2406:da38:9000::/40,AWS 2406:da38:a000::/40,AWS 2406:da38:b000::/40,AWS 2406:da38:c000::/40,AWS
-	2406:da38:c800::/40,AWS 2406:da38:e000::/40,AWS 2406:da38:f000::/40,AWS 2406:da60:800::/40,AWS 2406:da60:1000::/40,AWS 2406:da60:2000::/40,AWS
-	2406:da60:2800::/40,AWS 2406:da60:4000::/40,AWS 2406:da60:4800::/40,AWS 2406:da60:6000::/40,AWS 2406:da60:7000::/40,AWS 2406:da60:8000::/40,AWS
-	2406:da60:8800::/40,AWS 2406:da60:9000::/40,AWS 2406:da60:a000::/40,AWS 2406:da60:b000::/40,AWS 2406:da60:c000::/40,AWS 2406:da60:c800::/40,AWS
-	2406:da60:e000::/40,AWS 2406:da60:f000::/40,AWS 2406:da61:800::/40,AWS 2406:da61:1000::/40,AWS 2406:da61:2000::/40,AWS 2406:da61:2800::/40,AWS
-	2406:da61:4000::/40,AWS 2406:da61:4800::/40,AWS 2406:da61:6000::/40,AWS 2406:da61:7000::/40,AWS 2406:da61:8000::/40,AWS 2406:da61:8800::/40,AWS
-	2406:da61:9000::/40,AWS 2406:da61:a000::/40,AWS 2406:da61:b000::/40,AWS 2406:da61:c000::/40,AWS 2406:da61:c800::/40,AWS 2406:da61:e000::/40,AWS
-	2406:da61:f000::/40,AWS 2406:da70:800::/40,AWS 2406:da70:1000::/40,AWS 2406:da70:2000::/40,AWS 2406:da70:2800::/40,AWS 2406:da70:4000::/40,AWS
-	2406:da70:4800::/40,AWS 2406:da70:6000::/40,AWS 2406:da70:7000::/40,AWS 2406:da70:8000::/40,AWS 2406:da70:8800::/40,AWS 2406:da70:9000::/40,AWS
-	2406:da70:a000::/40,AWS 2406:da70:b000::/40,AWS 2406:da70:c000::/40,AWS 2406:da70:c800::/40,AWS 2406:da70:e000::/40,AWS 2406:da70:f000::/40,AWS
-	2406:daa0:800::/40,AWS 2406:daa0:1000::/40,AWS 2406:daa0:2000::/40,AWS 2406:daa0:2800::/40,AWS 2406:daa0:4000::/40,AWS 2406:daa0:4800::/40,AWS
-	2406:daa0:6000::/40,AWS 2406:daa0:7000::/40,AWS 2406:daa0:8000::/40,AWS 2406:daa0:8800::/40,AWS 2406:daa0:9000::/40,AWS 2406:daa0:a000::/40,AWS
-	2406:daa0:a800::/40,AWS 2406:daa0:b000::/40,AWS 2406:daa0:c000::/40,AWS 2406:daa0:c800::/40,AWS 2406:daa0:e000::/40,AWS 2406:daa0:f000::/40,AWS
-	2406:dab9:800::/40,AWS 2406:dab9:1000::/40,AWS 2406:dab9:2000::/40,AWS 2406:dab9:2800::/40,AWS 2406:dab9:4000::/40,AWS 2406:dab9:4800::/40,AWS
-	2406:dab9:6000::/40,AWS 2406:dab9:7000::/40,AWS 2406:dab9:8000::/40,AWS 2406:dab9:8800::/40,AWS 2406:dab9:9000::/40,AWS 2406:dab9:a000::/40,AWS
-	2406:dab9:b000::/40,AWS 2406:dab9:c000::/40,AWS 2406:dab9:c800::/40,AWS 2406:dab9:e000::/40,AWS 2406:dab9:f000::/40,AWS 2406:daba:800::/40,AWS
-	2406:daba:1000::/40,AWS 2406:daba:2000::/40,AWS 2406:daba:2800::/40,AWS 2406:daba:4000::/40,AWS 2406:daba:4800::/40,AWS 2406:daba:6000::/40,AWS
-	2406:daba:7000::/40,AWS 2406:daba:8000::/40,AWS 2406:daba:8800::/40,AWS 2406:daba:9000::/40,AWS 2406:daba:a000::/40,AWS 2406:daba:b000::/40,AWS
-	2406:daba:c000::/40,AWS 2406:daba:c800::/40,AWS 2406:daba:e000::/40,AWS 2406:daba:f000::/40,AWS 2406:dabb:800::/40,AWS 2406:dabb:1000::/40,AWS
-	2406:dabb:2000::/40,AWS 2406:dabb:2800::/40,AWS 2406:dabb:4000::/40,AWS 2406:dabb:4800::/40,AWS 2406:dabb:6000::/40,AWS 2406:dabb:7000::/40,AWS
-	2406:dabb:8000::/40,AWS 2406:dabb:8800::/40,AWS 2406:dabb:9000::/40,AWS 2406:dabb:a000::/40,AWS 2406:dabb:b000::/40,AWS 2406:dabb:c000::/40,AWS
-	2406:dabb:c800::/40,AWS 2406:dabb:e000::/40,AWS 2406:dabb:f000::/40,AWS 2406:dae8:800::/40,AWS 2406:dae8:1000::/40,AWS 2406:dae8:2000::/40,AWS
-	2406:dae8:2800::/40,AWS 2406:dae8:4000::/40,AWS 2406:dae8:4800::/40,AWS 2406:dae8:6000::/40,AWS 2406:dae8:7000::/40,AWS 2406:dae8:8000::/40,AWS
-	2406:dae8:8800::/40,AWS 2406:dae8:9000::/40,AWS 2406:dae8:a000::/40,AWS 2406:dae8:b000::/40,AWS 2406:dae8:c000::/40,AWS 2406:dae8:c800::/40,AWS
-	2406:dae8:e000::/40,AWS 2406:dae8:f000::/40,AWS 2406:dae9:800::/40,AWS 2406:dae9:1000::/40,AWS 2406:dae9:2000::/40,AWS 2406:dae9:2800::/40,AWS
-	2406:dae9:4000::/40,AWS 2406:dae9:4800::/40,AWS 2406:dae9:6000::/40,AWS 2406:dae9:7000::/40,AWS 2406:dae9:8000::/40,AWS 2406:dae9:8800::/40,AWS
-	2406:dae9:9000::/40,AWS 2406:dae9:a000::/40,AWS 2406:dae9:b000::/40,AWS 2406:dae9:c000::/40,AWS 2406:dae9:c800::/40,AWS 2406:dae9:e000::/40,AWS
-	2406:dae9:f000::/40,AWS 2406:daea:800::/40,AWS 2406:daea:1000::/40,AWS 2406:daea:2000::/40,AWS 2406:daea:2800::/40,AWS 2406:daea:4000::/40,AWS
-	2406:daea:4800::/40,AWS 2406:daea:6000::/40,AWS 2406:daea:7000::/40,AWS 2406:daea:8000::/40,AWS 2406:daea:8800::/40,AWS 2406:daea:9000::/40,AWS
-	2406:daea:a000::/40,AWS 2406:daea:b000::/40,AWS 2406:daea:c000::/40,AWS 2406:daea:c800::/40,AWS 2406:daea:e000::/40,AWS 2406:daea:f000::/40,AWS
-	2406:daeb:800::/40,AWS 2406:daeb:1000::/40,AWS 2406:daeb:2000::/40,AWS 2406:daeb:2800::/40,AWS 2406:daeb:4000::/40,AWS 2406:daeb:4800::/40,AWS
-	2406:daeb:6000::/40,AWS 2406:daeb:7000::/40,AWS 2406:daeb:8000::/40,AWS 2406:daeb:8800::/40,AWS 2406:daeb:9000::/40,AWS 2406:daeb:a000::/40,AWS
-	2406:daeb:b000::/40,AWS 2406:daeb:c000::/40,AWS 2406:daeb:c800::/40,AWS 2406:daeb:e000::/40,AWS 2406:daeb:f000::/40,AWS 2406:daef:800::/40,AWS
-	2406:daef:1000::/40,AWS 2406:daef:2000::/40,AWS 2406:daef:2800::/40,AWS 2406:daef:4000::/40,AWS 2406:daef:4800::/40,AWS 2406:daef:6000::/40,AWS
-	2406:daef:7000::/40,AWS 2406:daef:8000::/40,AWS 2406:daef:8800::/40,AWS 2406:daef:9000::/40,AWS 2406:daef:a000::/40,AWS 2406:daef:b000::/40,AWS
-	2406:daef:c000::/40,AWS 2406:daef:c800::/40,AWS 2406:daef:e000::/40,AWS 2406:daef:f000::/40,AWS 2406:daf0:800::/40,AWS 2406:daf0:1000::/40,AWS
-	2406:daf0:2000::/40,AWS 2406:daf0:2800::/40,AWS 2406:daf0:4000::/40,AWS 2406:daf0:4800::/40,AWS 2406:daf0:6000::/40,AWS 2406:daf0:7000::/40,AWS
-	2406:daf0:8000::/40,AWS 2406:daf0:8800::/40,AWS 2406:daf0:9000::/40,AWS 2406:daf0:a000::/40,AWS 2406:daf0:b000::/40,AWS 2406:daf0:c000::/40,AWS
-	2406:daf0:c800::/40,AWS 2406:daf0:e000::/40,AWS 2406:daf0:f000::/40,AWS 2406:daf2:800::/40,AWS 2406:daf2:1000::/40,AWS 2406:daf2:2000::/40,AWS
-	2406:daf2:2800::/40,AWS 2406:daf2:4000::/40,AWS 2406:daf2:4800::/40,AWS 2406:daf2:6000::/40,AWS 2406:daf2:7000::/40,AWS 2406:daf2:8000::/40,AWS
-	2406:daf2:8800::/40,AWS 2406:daf2:9000::/40,AWS 2406:daf2:a000::/40,AWS 2406:daf2:b000::/40,AWS 2406:daf2:c000::/40,AWS 2406:daf2:c800::/40,AWS
-	2406:daf2:e000::/40,AWS 2406:daf2:f000::/40,AWS 2406:daf3:800::/40,AWS 2406:daf3:1000::/40,AWS 2406:daf3:2000::/40,AWS 2406:daf3:2800::/40,AWS
-	2406:daf3:4000::/40,AWS 2406:daf3:4800::/40,AWS 2406:daf3:6000::/40,AWS 2406:daf3:7000::/40,AWS 2406:daf3:8000::/40,AWS 2406:daf3:8800::/40,AWS
-	2406:daf3:9000::/40,AWS 2406:daf3:a000::/40,AWS 2406:daf3:b000::/40,AWS 2406:daf3:c000::/40,AWS 2406:daf3:c800::/40,AWS 2406:daf3:e000::/40,AWS
-	2406:daf3:f000::/40,AWS 2406:daf4:800::/40,AWS 2406:daf4:1000::/40,AWS 2406:daf4:2000::/40,AWS 2406:daf4:2800::/40,AWS 2406:daf4:4000::/40,AWS
-	2406:daf4:4800::/40,AWS 2406:daf4:6000::/40,AWS 2406:daf4:7000::/40,AWS 2406:daf4:8000::/40,AWS 2406:daf4:8800::/40,AWS 2406:daf4:9000::/40,AWS
-	2406:daf4:a000::/40,AWS 2406:daf4:b000::/40,AWS 2406:daf4:c000::/40,AWS 2406:daf4:c800::/40,AWS 2406:daf4:e000::/40,AWS 2406:daf4:f000::/40,AWS
-	2406:daf6:800::/40,AWS 2406:daf6:1000::/40,AWS 2406:daf6:2000::/40,AWS 2406:daf6:2800::/40,AWS 2406:daf6:4000::/40,AWS 2406:daf6:4800::/40,AWS
-	2406:daf6:6000::/40,AWS 2406:daf6:7000::/40,AWS 2406:daf6:8000::/40,AWS 2406:daf6:8800::/40,AWS 2406:daf6:9000::/40,AWS 2406:daf6:a000::/40,AWS
-	2406:daf6:b000::/40,AWS 2406:daf6:c000::/40,AWS 2406:daf6:c800::/40,AWS 2406:daf6:e000::/40,AWS 2406:daf6:f000::/40,AWS 2406:daf8:800::/40,AWS
-	2406:daf8:1000::/40,AWS 2406:daf8:2000::/40,AWS 2406:daf8:2800::/40,AWS 2406:daf8:4000::/40,AWS 2406:daf8:4800::/40,AWS 2406:daf8:6000::/40,AWS
-	2406:daf8:7000::/40,AWS 2406:daf8:8000::/40,AWS 2406:daf8:8800::/40,AWS 2406:daf8:9000::/40,AWS 2406:daf8:a000::/40,AWS 2406:daf8:a800::/40,AWS
-	2406:daf8:b000::/40,AWS 2406:daf8:c000::/40,AWS 2406:daf8:c800::/40,AWS 2406:daf8:e000::/40,AWS 2406:daf8:f000::/40,AWS 2406:daf9:800::/40,AWS
-	2406:daf9:1000::/40,AWS 2406:daf9:2000::/40,AWS 2406:daf9:2800::/40,AWS 2406:daf9:4000::/40,AWS 2406:daf9:4800::/40,AWS 2406:daf9:6000::/40,AWS
-	2406:daf9:7000::/40,AWS 2406:daf9:8000::/40,AWS 2406:daf9:8800::/40,AWS 2406:daf9:9000::/40,AWS 2406:daf9:a000::/40,AWS 2406:daf9:a800::/40,AWS
-	2406:daf9:b000::/40,AWS 2406:daf9:c000::/40,AWS 2406:daf9:c800::/40,AWS 2406:daf9:e000::/40,AWS 2406:daf9:f000::/40,AWS 2406:dafa:2000::/40,AWS
-	2406:dafa:4000::/40,AWS 2406:dafa:6000::/40,AWS 2406:dafa:8000::/40,AWS 2406:dafa:a000::/40,AWS 2406:dafa:c000::/40,AWS 2406:dafa:e000::/40,AWS
-	2406:dafc:800::/40,AWS 2406:dafc:1000::/40,AWS 2406:dafc:2000::/40,AWS 2406:dafc:4000::/40,AWS 2406:dafc:4800::/40,AWS 2406:dafc:6000::/40,AWS
-	2406:dafc:7000::/40,AWS 2406:dafc:8000::/40,AWS 2406:dafc:9000::/40,AWS 2406:dafc:a000::/40,AWS 2406:dafc:b000::/40,AWS 2406:dafc:c000::/40,AWS
-	2406:dafc:c800::/40,AWS 2406:dafc:e000::/40,AWS 2406:dafc:f000::/40,AWS 2406:dafc:ff60::/46,AWS 2406:dafc:ff80::/46,AWS 2406:dafc:ffa0::/46,AWS
-	2406:dafe:800::/40,AWS 2406:dafe:1000::/40,AWS 2406:dafe:2000::/40,AWS 2406:dafe:2800::/40,AWS 2406:dafe:4000::/40,AWS 2406:dafe:4800::/40,AWS
-	2406:dafe:6000::/40,AWS 2406:dafe:7000::/40,AWS 2406:dafe:8000::/40,AWS 2406:dafe:8800::/40,AWS 2406:dafe:9000::/40,AWS 2406:dafe:a000::/40,AWS
-	2406:dafe:a800::/40,AWS 2406:dafe:b000::/40,AWS 2406:dafe:c000::/40,AWS 2406:dafe:c800::/40,AWS 2406:dafe:e000::/40,AWS 2406:dafe:f000::/40,AWS
-	2406:daff:800::/40,AWS 2406:daff:1000::/40,AWS 2406:daff:2000::/40,AWS 2406:daff:2800::/40,AWS 2406:daff:4000::/40,AWS 2406:daff:4800::/40,AWS
-	2406:daff:6000::/40,AWS 2406:daff:7000::/40,AWS 2406:daff:8000::/40,AWS 2406:daff:8800::/40,AWS 2406:daff:9000::/40,AWS 2406:daff:a000::/40,AWS
-	2406:daff:a800::/40,AWS 2406:daff:b000::/40,AWS 2406:daff:c000::/40,AWS 2406:daff:c800::/40,AWS 2406:daff:e000::/40,AWS 2406:daff:f000::/40,AWS
-	2407:bc00::/32,Alibaba 2408:4000::/22,Alibaba 2409:8c00:2421:300::/56,AWS 2409:8c00:2421:400::/56,AWS 240b:4000::/31,Alibaba 240b:4002::/32,Alibaba
-	240b:4004::/31,Alibaba 240b:4006::/48,Alibaba 240b:4006:1000::/43,Alibaba 240b:4006:1020::/44,Alibaba 240b:4007::/32,Alibaba 240b:4009::/32,Alibaba
-	240b:400b::/32,Alibaba 240b:400c::/30,Alibaba 240b:4010:fffe::/47,Alibaba 240b:4011::/32,Alibaba 240b:4012::/31,Alibaba 240b:4014::/30,Alibaba
-	240b:4018::/30,Alibaba 240b:401f::/32,Alibaba 240f:8000:4000::/40,AWS 240f:8000:8000::/40,AWS 240f:8014::/36,AWS 240f:8018::/36,AWS
-	240f:80a0:4000::/40,AWS 240f:80a0:8000::/40,AWS 240f:80f8:4000::/40,AWS 240f:80f8:8000::/40,AWS 240f:80f9:4000::/40,AWS 240f:80f9:8000::/40,AWS
-	240f:80fa:4000::/40,AWS 240f:80fa:8000::/40,AWS 240f:80fc:4000::/40,AWS 240f:80fc:8000::/40,AWS 240f:80fe:4000::/40,AWS 240f:80fe:8000::/40,AWS
-	240f:80ff:4000::/40,AWS 240f:80ff:8000::/40,AWS 2600:1900:4000::/40,GoogleCloud 2600:1900:4120::/44,GoogleCloud 2600:1900:4140::/42,GoogleCloud 2600:1900:4180::/44,GoogleCloud
-	2600:1900:41a0::/43,GoogleCloud 2600:1900:41c0::/43,GoogleCloud 2600:1900:41e0::/44,GoogleCloud 2600:1900:4280::/43,GoogleCloud 2600:1900:42a0::/44,GoogleCloud 2600:1900:42c0::/44,GoogleCloud
-	2600:1900:42e0::/44,GoogleCloud 2600:1900:4334::/46,GoogleCloud 2600:1900:5400::/44,GoogleCloud 2600:1900:8000::/44,GoogleCloud 2600:1901::/48,GoogleCloud 2600:1901:4010::/44,GoogleCloud
-	2600:1901:8100::/41,GoogleCloud 2600:1901:8180::/44,GoogleCloud 2600:1901:81b0::/44,GoogleCloud 2600:1901:81c0::/44,GoogleCloud 2600:1901:81f0::/44,GoogleCloud 2600:1902::/39,GoogleCloud
-	2600:1902:200::/42,GoogleCloud 2600:1902:250::/44,GoogleCloud 2600:1902:260::/43,GoogleCloud 2600:1902:280::/42,GoogleCloud 2600:1902:2c0::/43,GoogleCloud 2600:1902:2e0::/44,GoogleCloud
-	2600:1f00:800::/64,AWS 2600:1f00:1000::/40,AWS 2600:1f00:2000::/40,AWS 2600:1f00:4000::/40,AWS 2600:1f00:5000::/40,AWS 2600:1f00:6000::/40,AWS
-	2600:1f00:7400::/40,AWS 2600:1f00:8000::/40,AWS 2600:1f00:a400::/40,AWS 2600:1f00:c000::/40,AWS 2600:1f00:e000::/40,AWS 2600:1f00:ec00::/40,AWS
-	2600:1f01:4800::/44,AWS 2600:1f01:4810::/46,AWS 2600:1f01:4814::/47,AWS 2600:1f01:481a::/47,AWS 2600:1f01:4820::/47,AWS 2600:1f01:4822::/56,AWS
-	2600:1f01:4830::/47,AWS 2600:1f01:4840::/47,AWS 2600:1f01:4844::/47,AWS 2600:1f01:4850::/47,AWS 2600:1f01:4860::/47,AWS 2600:1f01:4870::/47,AWS
-	2600:1f01:4874::/47,AWS 2600:1f01:4880::/47,AWS 2600:1f01:4890::/47,AWS 2600:1f01:48a0::/47,AWS 2600:1f01:48b0::/47,AWS 2600:1f01:48c0::/47,AWS
-	2600:1f01:48d0::/46,AWS 2600:1f01:48e0::/46,AWS 2600:1f01:48f0::/46,AWS 2600:1f01:48f4::/47,AWS 2600:1f01:4900:100::/56,AWS 2600:1f01:4900:200::/56,AWS
-	2600:1f01:4902::/62,AWS 2600:1f01:4902:100::/56,AWS 2600:1f01:4904::/46,AWS 2600:1f01:4908::/45,AWS 2600:1f01:4910::/44,AWS 2600:1f01:4920::/45,AWS
-	2600:1f01:4928::/47,AWS 2600:1f01:492a:2::/63,AWS 2600:1f01:492a:4::/63,AWS 2600:1f01:492e:100::/56,AWS 2600:1f01:4930::/44,AWS 2600:1f01:4940::/45,AWS
-	2600:1f01:4948::/46,AWS 2600:1f10:2000::/36,AWS 2600:1f10:4000::/36,AWS 2600:1f10:8000::/36,AWS 2600:1f10:c000::/36,AWS 2600:1f11::/36,AWS
-	2600:1f11:4000::/36,AWS 2600:1f11:8000::/36,AWS 2600:1f11:c000::/36,AWS 2600:1f12::/36,AWS 2600:1f12:2000::/36,AWS 2600:1f12:4000::/36,AWS
-	2600:1f12:8000::/36,AWS 2600:1f12:c000::/36,AWS 2600:1f13::/36,AWS 2600:1f13:8000::/36,AWS 2600:1f13:c000::/36,AWS 2600:1f14::/34,AWS
-	2600:1f14:4000::/36,AWS 2600:1f14:8000::/36,AWS 2600:1f14:c000::/36,AWS 2600:1f15::/36,AWS 2600:1f15:4000::/36,AWS 2600:1f15:8000::/36,AWS
-	2600:1f15:c000::/36,AWS 2600:1f16::/34,AWS 2600:1f16:8000::/36,AWS 2600:1f16:c000::/36,AWS 2600:1f17:4000::/36,AWS 2600:1f17:8000::/36,AWS
-	2600:1f17:c000::/36,AWS 2600:1f18::/33,AWS 2600:1f18:8000::/36,AWS 2600:1f18:c000::/36,AWS 2600:1f19:4000::/36,AWS 2600:1f19:8000::/36,AWS
+	2001:bc8::/32,Scaleway 2001:19f0::/32,Vultr 2001:3fc0:800::/40,AWS 2001:3fc1:8000::/36,AWS 2001:3fc2:8000::/36,AWS 2001:3fc3:800::/40,AWS
+	2001:3fc3:2800::/40,AWS 2001:3fc3:3000::/36,AWS 2001:3fc3:6800::/40,AWS 2001:3fc3:8800::/40,AWS 2001:3fc3:a800::/40,AWS 2001:3fc4:800::/40,AWS
+	2001:3fc5:800::/40,AWS 2001:3fc5:1000::/40,AWS 2001:3fc5:2000::/40,AWS 2001:3fc5:8800::/40,AWS 2001:3fc6::/55,AWS 2001:3fc6:1::-2001:3fc6:2:ffff:ffff:ffff:ffff:ffff,AWS
+	2001:3fc6:8::/45,AWS 2001:3fc6:20::/43,AWS 2001:3fc6:100::/48,AWS 2001:3fc6:200::/40,AWS 2001:3fc7:800::/40,AWS 2001:3fc7:1800::/40,AWS
+	2001:3fc7:2800::/40,AWS 2001:3fc7:3000::/40,AWS 2001:3fc7:4800::/40,AWS 2001:3fc7:5800::/40,AWS 2001:3fc7:6800::/40,AWS 2001:3fc7:8800::/40,AWS
+	2001:3fc7:9800::/40,AWS 2001:3fc7:a800::/40,AWS 2001:3fc7:c800::/40,AWS 2001:3fc7:e800::/40,AWS 2001:3fc7:f400::/40,AWS 2001:3fc7:f800::/40,AWS
+	2001:41d0::/32,OVH 2400:3200::/32,Alibaba 2400:6180:0:d0::/62,DigitalOcean 2400:6180:10:200::/64,DigitalOcean 2400:6180:100:d0::/64,DigitalOcean 2400:6500:0:3::/64,AWS
+	2400:6500:0:9::1-2400:6500:0:9::4,AWS 2400:6500:0:7000::/52,AWS 2400:6500:0:b000::/56,AWS 2400:6500:100:7200::/56,AWS 2400:6500:ff00::/48,AWS 2400:6700::/48,AWS
+	2400:6700:ff00::/48,AWS 2400:7fc0::/40,AWS 2400:7fc0:110::-2400:7fc0:112:ffff:ffff:ffff:ffff:ffff,AWS 2400:7fc0:200::/40,AWS 2400:7fc0:500::/40,AWS 2400:7fc0:2100::-2400:7fc0:22ff:ffff:ffff:ffff:ffff:ffff,AWS
+	2400:7fc0:2400::/40,AWS 2400:7fc0:2800::/40,AWS 2400:7fc0:2a00::/40,AWS 2400:7fc0:2c00::/40,AWS 2400:7fc0:2e80::/48,AWS 2400:7fc0:2f00::-2400:7fc0:30ff:ffff:ffff:ffff:ffff:ffff,AWS
+	2400:7fc0:4000::-2400:7fc0:4100:ffff:ffff:ffff:ffff:ffff,AWS 2400:7fc0:6000::/40,AWS 2400:7fc0:8000::/36,AWS 2400:7fc0:a000::/36,AWS 2400:7fc0:bb00::/40,AWS 2400:7fc0:c000::/36,AWS
+	2400:7fc0:e800::/40,AWS 2400:7fc0:ea00::/39,AWS 2400:7fc0:ef00::/40,AWS 2400:7fc0:f300::/40,AWS 2400:8901::-2400:8902:ffff:ffff:ffff:ffff:ffff:ffff,Linode 2400:8904::/31,Linode
+	2400:8907::/32,Linode 2400:b200::/32,Alibaba 2401:2e00::/32,Alibaba 2401:8680:4004::/46,Alibaba 2401:8680:4100::-2401:8680:4102:ffff:ffff:ffff:ffff:ffff,Alibaba 2401:b180::/32,Alibaba
+	2402:1f00::/32,OVH 2403:b300::/48,AWS 2403:b300:ff00::/48,AWS 2404:2280:1000::-2404:2280:4fff:ffff:ffff:ffff:ffff:ffff,Alibaba 2404:c2c0::/40,AWS 2404:c2c0:110::-2404:c2c0:112:ffff:ffff:ffff:ffff:ffff,AWS
+	2404:c2c0:200::/40,AWS 2404:c2c0:500::/40,AWS 2404:c2c0:2100::-2404:c2c0:22ff:ffff:ffff:ffff:ffff:ffff,AWS 2404:c2c0:2400::/40,AWS 2404:c2c0:2600::/40,AWS 2404:c2c0:2800::/40,AWS
+	2404:c2c0:2a00::/40,AWS 2404:c2c0:2c00::/40,AWS 2404:c2c0:2e80::/48,AWS 2404:c2c0:2f00::-2404:c2c0:31ff:ffff:ffff:ffff:ffff:ffff,AWS 2404:c2c0:4000::-2404:c2c0:4100:ffff:ffff:ffff:ffff:ffff,AWS 2404:c2c0:6000::/40,AWS
+	2404:c2c0:8000::/36,AWS 2404:c2c0:bb00::/40,AWS 2404:c2c0:c000::/36,AWS 2404:c2c0:e800::/40,AWS 2404:c2c0:ea00::/39,AWS 2404:c2c0:ef00::/40,AWS
+	2404:c2c0:f300::/40,AWS 2405:e000::/32,Alibaba 2406:da00:800::/40,AWS 2406:da00:1000::/40,AWS 2406:da00:2000::/40,AWS 2406:da00:2800::/40,AWS
+	2406:da00:4000::/40,AWS 2406:da00:4800::/40,AWS 2406:da00:6000::/40,AWS 2406:da00:7000::/40,AWS 2406:da00:8000::/40,AWS 2406:da00:9000::/40,AWS
+	2406:da00:a000::/40,AWS 2406:da00:b000::/40,AWS 2406:da00:c000::/40,AWS 2406:da00:c800::/40,AWS 2406:da00:e000::/40,AWS 2406:da00:f000::/40,AWS
+	2406:da00:ff00::/48,AWS 2406:da10:8000::/36,AWS 2406:da11::/36,AWS 2406:da12::/36,AWS 2406:da12:8000::/36,AWS 2406:da13::/36,AWS
+	2406:da14::/35,AWS 2406:da14:8000::/36,AWS 2406:da15::/36,AWS 2406:da16::/36,AWS 2406:da17::/36,AWS 2406:da18::/35,AWS
+	2406:da18:8000::/36,AWS 2406:da19::/36,AWS 2406:da1a::/35,AWS 2406:da1a:8000::/36,AWS 2406:da1b::/36,AWS 2406:da1c::/35,AWS
+	2406:da1c:8000::/36,AWS 2406:da1d::/36,AWS 2406:da1e::-2406:da1f:fff:ffff:ffff:ffff:ffff:ffff,AWS 2406:da20:8000::/36,AWS 2406:da21::/36,AWS 2406:da22::/36,AWS
+	2406:da22:8000::/36,AWS 2406:da23::/36,AWS 2406:da24::/36,AWS 2406:da24:8000::/36,AWS 2406:da25::/36,AWS 2406:da26::/36,AWS
+	2406:da27::/36,AWS 2406:da28::/36,AWS 2406:da28:8000::/36,AWS 2406:da29::/36,AWS 2406:da2a::/36,AWS 2406:da2a:8000::/36,AWS
+	2406:da2b::/36,AWS 2406:da2c::/36,AWS 2406:da2c:8000::/36,AWS 2406:da2d::/36,AWS 2406:da2e::/36,AWS 2406:da2f::/36,AWS
+	2406:da30:800::/40,AWS 2406:da30:1000::/40,AWS 2406:da30:2000::/40,AWS 2406:da30:2800::/40,AWS 2406:da30:4000::/40,AWS 2406:da30:4800::/40,AWS
+	2406:da30:6000::/40,AWS 2406:da30:7000::/40,AWS 2406:da30:8000::/40,AWS 2406:da30:8800::/40,AWS 2406:da30:9000::/40,AWS 2406:da30:a000::/40,AWS
+	2406:da30:b000::/40,AWS 2406:da30:c000::/40,AWS 2406:da30:c800::/40,AWS 2406:da30:e000::/40,AWS 2406:da30:f000::/40,AWS 2406:da32:800::/40,AWS
+	2406:da32:1000::/40,AWS 2406:da32:2000::/40,AWS 2406:da32:2800::/40,AWS 2406:da32:4000::/40,AWS 2406:da32:4800::/40,AWS 2406:da32:6000::/40,AWS
+	2406:da32:7000::/40,AWS 2406:da32:8000::/40,AWS 2406:da32:8800::/40,AWS 2406:da32:9000::/40,AWS 2406:da32:a000::/40,AWS 2406:da32:b000::/40,AWS
+	2406:da32:c000::/40,AWS 2406:da32:c800::/40,AWS 2406:da32:e000::/40,AWS 2406:da32:f000::/40,AWS 2406:da33:800::/40,AWS 2406:da33:1000::/40,AWS
+	2406:da33:2000::/40,AWS 2406:da33:2800::/40,AWS 2406:da33:4000::/40,AWS 2406:da33:4800::/40,AWS 2406:da33:6000::/40,AWS 2406:da33:7000::/40,AWS
+	2406:da33:8000::/40,AWS 2406:da33:8800::/40,AWS 2406:da33:9000::/40,AWS 2406:da33:a000::/40,AWS 2406:da33:b000::/40,AWS 2406:da33:c000::/40,AWS
+	2406:da33:c800::/40,AWS 2406:da33:e000::/40,AWS 2406:da33:f000::/40,AWS 2406:da36:800::/40,AWS 2406:da36:1000::/40,AWS 2406:da36:2000::/40,AWS
+	2406:da36:2800::/40,AWS 2406:da36:4000::/40,AWS 2406:da36:4800::/40,AWS 2406:da36:6000::/40,AWS 2406:da36:7000::/40,AWS 2406:da36:8000::/40,AWS
+	2406:da36:8800::/40,AWS 2406:da36:9000::/40,AWS 2406:da36:a000::/40,AWS 2406:da36:b000::/40,AWS 2406:da36:c000::/40,AWS 2406:da36:c800::/40,AWS
+	2406:da36:e000::/40,AWS 2406:da36:f000::/40,AWS 2406:da38:800::/40,AWS 2406:da38:1000::/40,AWS 2406:da38:2000::/40,AWS 2406:da38:2800::/40,AWS
+	2406:da38:4000::/40,AWS 2406:da38:4800::/40,AWS 2406:da38:6000::/40,AWS 2406:da38:7000::/40,AWS 2406:da38:8000::/40,AWS 2406:da38:8800::/40,AWS
+	2406:da38:9000::/40,AWS 2406:da38:a000::/40,AWS 2406:da38:b000::/40,AWS 2406:da38:c000::/40,AWS 2406:da38:c800::/40,AWS 2406:da38:e000::/40,AWS
+	2406:da38:f000::/40,AWS 2406:da60:800::/40,AWS 2406:da60:1000::/40,AWS 2406:da60:2000::/40,AWS 2406:da60:2800::/40,AWS 2406:da60:4000::/40,AWS
+	2406:da60:4800::/40,AWS 2406:da60:6000::/40,AWS 2406:da60:7000::/40,AWS 2406:da60:8000::/40,AWS 2406:da60:8800::/40,AWS 2406:da60:9000::/40,AWS
+	2406:da60:a000::/40,AWS 2406:da60:b000::/40,AWS 2406:da60:c000::/40,AWS 2406:da60:c800::/40,AWS 2406:da60:e000::/40,AWS 2406:da60:f000::/40,AWS
+	2406:da61:800::/40,AWS 2406:da61:1000::/40,AWS 2406:da61:2000::/40,AWS 2406:da61:2800::/40,AWS 2406:da61:4000::/40,AWS 2406:da61:4800::/40,AWS
+	2406:da61:6000::/40,AWS 2406:da61:7000::/40,AWS 2406:da61:8000::/40,AWS 2406:da61:8800::/40,AWS 2406:da61:9000::/40,AWS 2406:da61:a000::/40,AWS
+	2406:da61:b000::/40,AWS 2406:da61:c000::/40,AWS 2406:da61:c800::/40,AWS 2406:da61:e000::/40,AWS 2406:da61:f000::/40,AWS 2406:da70:800::/40,AWS
+	2406:da70:1000::/40,AWS 2406:da70:2000::/40,AWS 2406:da70:2800::/40,AWS 2406:da70:4000::/40,AWS 2406:da70:4800::/40,AWS 2406:da70:6000::/40,AWS
+	2406:da70:7000::/40,AWS 2406:da70:8000::/40,AWS 2406:da70:8800::/40,AWS 2406:da70:9000::/40,AWS 2406:da70:a000::/40,AWS 2406:da70:b000::/40,AWS
+	2406:da70:c000::/40,AWS 2406:da70:c800::/40,AWS 2406:da70:e000::/40,AWS 2406:da70:f000::/40,AWS 2406:daa0:800::/40,AWS 2406:daa0:1000::/40,AWS
+	2406:daa0:2000::/40,AWS 2406:daa0:2800::/40,AWS 2406:daa0:4000::/40,AWS 2406:daa0:4800::/40,AWS 2406:daa0:6000::/40,AWS 2406:daa0:7000::/40,AWS
+	2406:daa0:8000::/40,AWS 2406:daa0:8800::/40,AWS 2406:daa0:9000::/40,AWS 2406:daa0:a000::/40,AWS 2406:daa0:a800::/40,AWS 2406:daa0:b000::/40,AWS
+	2406:daa0:c000::/40,AWS 2406:daa0:c800::/40,AWS 2406:daa0:e000::/40,AWS 2406:daa0:f000::/40,AWS 2406:dab9:800::/40,AWS 2406:dab9:1000::/40,AWS
+	2406:dab9:2000::/40,AWS 2406:dab9:2800::/40,AWS 2406:dab9:4000::/40,AWS 2406:dab9:4800::/40,AWS 2406:dab9:6000::/40,AWS 2406:dab9:7000::/40,AWS
+	2406:dab9:8000::/40,AWS 2406:dab9:8800::/40,AWS 2406:dab9:9000::/40,AWS 2406:dab9:a000::/40,AWS 2406:dab9:b000::/40,AWS 2406:dab9:c000::/40,AWS
+	2406:dab9:c800::/40,AWS 2406:dab9:e000::/40,AWS 2406:dab9:f000::/40,AWS 2406:daba:800::/40,AWS 2406:daba:1000::/40,AWS 2406:daba:2000::/40,AWS
+	2406:daba:2800::/40,AWS 2406:daba:4000::/40,AWS 2406:daba:4800::/40,AWS 2406:daba:6000::/40,AWS 2406:daba:7000::/40,AWS 2406:daba:8000::/40,AWS
+	2406:daba:8800::/40,AWS 2406:daba:9000::/40,AWS 2406:daba:a000::/40,AWS 2406:daba:b000::/40,AWS 2406:daba:c000::/40,AWS 2406:daba:c800::/40,AWS
+	2406:daba:e000::/40,AWS 2406:daba:f000::/40,AWS 2406:dabb:800::/40,AWS 2406:dabb:1000::/40,AWS 2406:dabb:2000::/40,AWS 2406:dabb:2800::/40,AWS
+	2406:dabb:4000::/40,AWS 2406:dabb:4800::/40,AWS 2406:dabb:6000::/40,AWS 2406:dabb:7000::/40,AWS 2406:dabb:8000::/40,AWS 2406:dabb:8800::/40,AWS
+	2406:dabb:9000::/40,AWS 2406:dabb:a000::/40,AWS 2406:dabb:b000::/40,AWS 2406:dabb:c000::/40,AWS 2406:dabb:c800::/40,AWS 2406:dabb:e000::/40,AWS
+	2406:dabb:f000::/40,AWS 2406:dae8:800::/40,AWS 2406:dae8:1000::/40,AWS 2406:dae8:2000::/40,AWS 2406:dae8:2800::/40,AWS 2406:dae8:4000::/40,AWS
+	2406:dae8:4800::/40,AWS 2406:dae8:6000::/40,AWS 2406:dae8:7000::/40,AWS 2406:dae8:8000::/40,AWS 2406:dae8:8800::/40,AWS 2406:dae8:9000::/40,AWS
+	2406:dae8:a000::/40,AWS 2406:dae8:b000::/40,AWS 2406:dae8:c000::/40,AWS 2406:dae8:c800::/40,AWS 2406:dae8:e000::/40,AWS 2406:dae8:f000::/40,AWS
+	2406:dae9:800::/40,AWS 2406:dae9:1000::/40,AWS 2406:dae9:2000::/40,AWS 2406:dae9:2800::/40,AWS 2406:dae9:4000::/40,AWS 2406:dae9:4800::/40,AWS
+	2406:dae9:6000::/40,AWS 2406:dae9:7000::/40,AWS 2406:dae9:8000::/40,AWS 2406:dae9:8800::/40,AWS 2406:dae9:9000::/40,AWS 2406:dae9:a000::/40,AWS
+	2406:dae9:b000::/40,AWS 2406:dae9:c000::/40,AWS 2406:dae9:c800::/40,AWS 2406:dae9:e000::/40,AWS 2406:dae9:f000::/40,AWS 2406:daea:800::/40,AWS
+	2406:daea:1000::/40,AWS 2406:daea:2000::/40,AWS 2406:daea:2800::/40,AWS 2406:daea:4000::/40,AWS 2406:daea:4800::/40,AWS 2406:daea:6000::/40,AWS
+	2406:daea:7000::/40,AWS 2406:daea:8000::/40,AWS 2406:daea:8800::/40,AWS 2406:daea:9000::/40,AWS 2406:daea:a000::/40,AWS 2406:daea:b000::/40,AWS
+	2406:daea:c000::/40,AWS 2406:daea:c800::/40,AWS 2406:daea:e000::/40,AWS 2406:daea:f000::/40,AWS 2406:daeb:800::/40,AWS 2406:daeb:1000::/40,AWS
+	2406:daeb:2000::/40,AWS 2406:daeb:2800::/40,AWS 2406:daeb:4000::/40,AWS 2406:daeb:4800::/40,AWS 2406:daeb:6000::/40,AWS 2406:daeb:7000::/40,AWS
+	2406:daeb:8000::/40,AWS 2406:daeb:8800::/40,AWS 2406:daeb:9000::/40,AWS 2406:daeb:a000::/40,AWS 2406:daeb:b000::/40,AWS 2406:daeb:c000::/40,AWS
+	2406:daeb:c800::/40,AWS 2406:daeb:e000::/40,AWS 2406:daeb:f000::/40,AWS 2406:daef:800::/40,AWS 2406:daef:1000::/40,AWS 2406:daef:2000::/40,AWS
+	2406:daef:2800::/40,AWS 2406:daef:4000::/40,AWS 2406:daef:4800::/40,AWS 2406:daef:6000::/40,AWS 2406:daef:7000::/40,AWS 2406:daef:8000::/40,AWS
+	2406:daef:8800::/40,AWS 2406:daef:9000::/40,AWS 2406:daef:a000::/40,AWS 2406:daef:b000::/40,AWS 2406:daef:c000::/40,AWS 2406:daef:c800::/40,AWS
+	2406:daef:e000::/40,AWS 2406:daef:f000::/40,AWS 2406:daf0:800::/40,AWS 2406:daf0:1000::/40,AWS 2406:daf0:2000::/40,AWS 2406:daf0:2800::/40,AWS
+	2406:daf0:4000::/40,AWS 2406:daf0:4800::/40,AWS 2406:daf0:6000::/40,AWS 2406:daf0:7000::/40,AWS 2406:daf0:8000::/40,AWS 2406:daf0:8800::/40,AWS
+	2406:daf0:9000::/40,AWS 2406:daf0:a000::/40,AWS 2406:daf0:b000::/40,AWS 2406:daf0:c000::/40,AWS 2406:daf0:c800::/40,AWS 2406:daf0:e000::/40,AWS
+	2406:daf0:f000::/40,AWS 2406:daf2:800::/40,AWS 2406:daf2:1000::/40,AWS 2406:daf2:2000::/40,AWS 2406:daf2:2800::/40,AWS 2406:daf2:4000::/40,AWS
+	2406:daf2:4800::/40,AWS 2406:daf2:6000::/40,AWS 2406:daf2:7000::/40,AWS 2406:daf2:8000::/40,AWS 2406:daf2:8800::/40,AWS 2406:daf2:9000::/40,AWS
+	2406:daf2:a000::/40,AWS 2406:daf2:b000::/40,AWS 2406:daf2:c000::/40,AWS 2406:daf2:c800::/40,AWS 2406:daf2:e000::/40,AWS 2406:daf2:f000::/40,AWS
+	2406:daf3:800::/40,AWS 2406:daf3:1000::/40,AWS 2406:daf3:2000::/40,AWS 2406:daf3:2800::/40,AWS 2406:daf3:4000::/40,AWS 2406:daf3:4800::/40,AWS
+	2406:daf3:6000::/40,AWS 2406:daf3:7000::/40,AWS 2406:daf3:8000::/40,AWS 2406:daf3:8800::/40,AWS 2406:daf3:9000::/40,AWS 2406:daf3:a000::/40,AWS
+	2406:daf3:b000::/40,AWS 2406:daf3:c000::/40,AWS 2406:daf3:c800::/40,AWS 2406:daf3:e000::/40,AWS 2406:daf3:f000::/40,AWS 2406:daf4:800::/40,AWS
+	2406:daf4:1000::/40,AWS 2406:daf4:2000::/40,AWS 2406:daf4:2800::/40,AWS 2406:daf4:4000::/40,AWS 2406:daf4:4800::/40,AWS 2406:daf4:6000::/40,AWS
+	2406:daf4:7000::/40,AWS 2406:daf4:8000::/40,AWS 2406:daf4:8800::/40,AWS 2406:daf4:9000::/40,AWS 2406:daf4:a000::/40,AWS 2406:daf4:b000::/40,AWS
+	2406:daf4:c000::/40,AWS 2406:daf4:c800::/40,AWS 2406:daf4:e000::/40,AWS 2406:daf4:f000::/40,AWS 2406:daf6:800::/40,AWS 2406:daf6:1000::/40,AWS
+	2406:daf6:2000::/40,AWS 2406:daf6:2800::/40,AWS 2406:daf6:4000::/40,AWS 2406:daf6:4800::/40,AWS 2406:daf6:6000::/40,AWS 2406:daf6:7000::/40,AWS
+	2406:daf6:8000::/40,AWS 2406:daf6:8800::/40,AWS 2406:daf6:9000::/40,AWS 2406:daf6:a000::/40,AWS 2406:daf6:b000::/40,AWS 2406:daf6:c000::/40,AWS
+	2406:daf6:c800::/40,AWS 2406:daf6:e000::/40,AWS 2406:daf6:f000::/40,AWS 2406:daf8:800::/40,AWS 2406:daf8:1000::/40,AWS 2406:daf8:2000::/40,AWS
+	2406:daf8:2800::/40,AWS 2406:daf8:4000::/40,AWS 2406:daf8:4800::/40,AWS 2406:daf8:6000::/40,AWS 2406:daf8:7000::/40,AWS 2406:daf8:8000::/40,AWS
+	2406:daf8:8800::/40,AWS 2406:daf8:9000::/40,AWS 2406:daf8:a000::/40,AWS 2406:daf8:a800::/40,AWS 2406:daf8:b000::/40,AWS 2406:daf8:c000::/40,AWS
+	2406:daf8:c800::/40,AWS 2406:daf8:e000::/40,AWS 2406:daf8:f000::/40,AWS 2406:daf9:800::/40,AWS 2406:daf9:1000::/40,AWS 2406:daf9:2000::/40,AWS
+	2406:daf9:2800::/40,AWS 2406:daf9:4000::/40,AWS 2406:daf9:4800::/40,AWS 2406:daf9:6000::/40,AWS 2406:daf9:7000::/40,AWS 2406:daf9:8000::/40,AWS
+	2406:daf9:8800::/40,AWS 2406:daf9:9000::/40,AWS 2406:daf9:a000::/40,AWS 2406:daf9:a800::/40,AWS 2406:daf9:b000::/40,AWS 2406:daf9:c000::/40,AWS
+	2406:daf9:c800::/40,AWS 2406:daf9:e000::/40,AWS 2406:daf9:f000::/40,AWS 2406:dafa:2000::/40,AWS 2406:dafa:4000::/40,AWS 2406:dafa:6000::/40,AWS
+	2406:dafa:8000::/40,AWS 2406:dafa:a000::/40,AWS 2406:dafa:c000::/40,AWS 2406:dafa:e000::/40,AWS 2406:dafc:800::/40,AWS 2406:dafc:1000::/40,AWS
+	2406:dafc:2000::/40,AWS 2406:dafc:4000::/40,AWS 2406:dafc:4800::/40,AWS 2406:dafc:6000::/40,AWS 2406:dafc:7000::/40,AWS 2406:dafc:8000::/40,AWS
+	2406:dafc:9000::/40,AWS 2406:dafc:a000::/40,AWS 2406:dafc:b000::/40,AWS 2406:dafc:c000::/40,AWS 2406:dafc:c800::/40,AWS 2406:dafc:e000::/40,AWS
+	2406:dafc:f000::/40,AWS 2406:dafc:ff60::/46,AWS 2406:dafc:ff80::/46,AWS 2406:dafc:ffa0::/46,AWS 2406:dafe:800::/40,AWS 2406:dafe:1000::/40,AWS
+	2406:dafe:2000::/40,AWS 2406:dafe:2800::/40,AWS 2406:dafe:4000::/40,AWS 2406:dafe:4800::/40,AWS 2406:dafe:6000::/40,AWS 2406:dafe:7000::/40,AWS
+	2406:dafe:8000::/40,AWS 2406:dafe:8800::/40,AWS 2406:dafe:9000::/40,AWS 2406:dafe:a000::/40,AWS 2406:dafe:a800::/40,AWS 2406:dafe:b000::/40,AWS
+	2406:dafe:c000::/40,AWS 2406:dafe:c800::/40,AWS 2406:dafe:e000::/40,AWS 2406:dafe:f000::/40,AWS 2406:daff:800::/40,AWS 2406:daff:1000::/40,AWS
+	2406:daff:2000::/40,AWS 2406:daff:2800::/40,AWS 2406:daff:4000::/40,AWS 2406:daff:4800::/40,AWS 2406:daff:6000::/40,AWS 2406:daff:7000::/40,AWS
+	2406:daff:8000::/40,AWS 2406:daff:8800::/40,AWS 2406:daff:9000::/40,AWS 2406:daff:a000::/40,AWS 2406:daff:a800::/40,AWS 2406:daff:b000::/40,AWS
+	2406:daff:c000::/40,AWS 2406:daff:c800::/40,AWS 2406:daff:e000::/40,AWS 2406:daff:f000::/40,AWS 2407:bc00::/32,Alibaba 2408:4000::/22,Alibaba
+	2409:8c00:2421:300::-2409:8c00:2421:4ff:ffff:ffff:ffff:ffff,AWS 240b:4000::-240b:4002:ffff:ffff:ffff:ffff:ffff:ffff,Alibaba 240b:4004::-240b:4006:0:ffff:ffff:ffff:ffff:ffff,Alibaba 240b:4006:1000::-240b:4006:102f:ffff:ffff:ffff:ffff:ffff,Alibaba 240b:4007::/32,Alibaba 240b:4009::/32,Alibaba
+	240b:400b::-240b:400f:ffff:ffff:ffff:ffff:ffff:ffff,Alibaba 240b:4010:fffe::-240b:401b:ffff:ffff:ffff:ffff:ffff:ffff,Alibaba 240b:401f::/32,Alibaba 240f:8000:4000::/40,AWS 240f:8000:8000::/40,AWS 240f:8014::/36,AWS
+	240f:8018::/36,AWS 240f:80a0:4000::/40,AWS 240f:80a0:8000::/40,AWS 240f:80f8:4000::/40,AWS 240f:80f8:8000::/40,AWS 240f:80f9:4000::/40,AWS
+	240f:80f9:8000::/40,AWS 240f:80fa:4000::/40,AWS 240f:80fa:8000::/40,AWS 240f:80fc:4000::/40,AWS 240f:80fc:8000::/40,AWS 240f:80fe:4000::/40,AWS
+	240f:80fe:8000::/40,AWS 240f:80ff:4000::/40,AWS 240f:80ff:8000::/40,AWS 2600:1900:4000::/40,GoogleCloud 2600:1900:4120::/44,GoogleCloud 2600:1900:4140::-2600:1900:418f:ffff:ffff:ffff:ffff:ffff,GoogleCloud
+	2600:1900:41a0::-2600:1900:41ef:ffff:ffff:ffff:ffff:ffff,GoogleCloud 2600:1900:4280::-2600:1900:42af:ffff:ffff:ffff:ffff:ffff,GoogleCloud 2600:1900:42c0::/44,GoogleCloud 2600:1900:42e0::/44,GoogleCloud 2600:1900:4334::/46,GoogleCloud 2600:1900:5400::/44,GoogleCloud
+	2600:1900:8000::/44,GoogleCloud 2600:1901::/48,GoogleCloud 2600:1901:4010::/44,GoogleCloud 2600:1901:8100::-2600:1901:818f:ffff:ffff:ffff:ffff:ffff,GoogleCloud 2600:1901:81b0::-2600:1901:81cf:ffff:ffff:ffff:ffff:ffff,GoogleCloud 2600:1901:81f0::/44,GoogleCloud
+	2600:1902::-2600:1902:23f:ffff:ffff:ffff:ffff:ffff,GoogleCloud 2600:1902:250::-2600:1902:2ef:ffff:ffff:ffff:ffff:ffff,GoogleCloud 2600:1f00:800::/64,AWS 2600:1f00:1000::/40,AWS 2600:1f00:2000::/40,AWS 2600:1f00:4000::/40,AWS
+	2600:1f00:5000::/40,AWS 2600:1f00:6000::/40,AWS 2600:1f00:7400::/40,AWS 2600:1f00:8000::/40,AWS 2600:1f00:a400::/40,AWS 2600:1f00:c000::/40,AWS
+	2600:1f00:e000::/40,AWS 2600:1f00:ec00::/40,AWS 2600:1f01:4800::-2600:1f01:4815:ffff:ffff:ffff:ffff:ffff,AWS 2600:1f01:481a::/47,AWS 2600:1f01:4820::-2600:1f01:4822:ff:ffff:ffff:ffff:ffff,AWS 2600:1f01:4830::/47,AWS
+	2600:1f01:4840::/47,AWS 2600:1f01:4844::/47,AWS 2600:1f01:4850::/47,AWS 2600:1f01:4860::/47,AWS 2600:1f01:4870::/47,AWS 2600:1f01:4874::/47,AWS
+	2600:1f01:4880::/47,AWS 2600:1f01:4890::/47,AWS 2600:1f01:48a0::/47,AWS 2600:1f01:48b0::/47,AWS 2600:1f01:48c0::/47,AWS 2600:1f01:48d0::/46,AWS
+	2600:1f01:48e0::/46,AWS 2600:1f01:48f0::-2600:1f01:48f5:ffff:ffff:ffff:ffff:ffff,AWS 2600:1f01:4900:100::-2600:1f01:4900:2ff:ffff:ffff:ffff:ffff,AWS 2600:1f01:4902::/62,AWS 2600:1f01:4902:100::/56,AWS 2600:1f01:4904::-2600:1f01:4929:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:1f01:492a:2::-2600:1f01:492a:5:ffff:ffff:ffff:ffff,AWS 2600:1f01:492e:100::/56,AWS 2600:1f01:4930::-2600:1f01:494b:ffff:ffff:ffff:ffff:ffff,AWS 2600:1f10:2000::/36,AWS 2600:1f10:4000::/36,AWS 2600:1f10:8000::/36,AWS
+	2600:1f10:c000::/36,AWS 2600:1f11::/36,AWS 2600:1f11:4000::/36,AWS 2600:1f11:8000::/36,AWS 2600:1f11:c000::/36,AWS 2600:1f12::/36,AWS
+	2600:1f12:2000::/36,AWS 2600:1f12:4000::/36,AWS 2600:1f12:8000::/36,AWS 2600:1f12:c000::/36,AWS 2600:1f13::/36,AWS 2600:1f13:8000::/36,AWS
+	2600:1f13:c000::/36,AWS 2600:1f14::-2600:1f14:4fff:ffff:ffff:ffff:ffff:ffff,AWS 2600:1f14:8000::/36,AWS 2600:1f14:c000::/36,AWS 2600:1f15::/36,AWS 2600:1f15:4000::/36,AWS
+	2600:1f15:8000::/36,AWS 2600:1f15:c000::/36,AWS 2600:1f16::/34,AWS 2600:1f16:8000::/36,AWS 2600:1f16:c000::/36,AWS 2600:1f17:4000::/36,AWS
+	2600:1f17:8000::/36,AWS 2600:1f17:c000::/36,AWS 2600:1f18::-2600:1f18:8fff:ffff:ffff:ffff:ffff:ffff,AWS 2600:1f18:c000::/36,AWS 2600:1f19:4000::/36,AWS 2600:1f19:8000::/36,AWS
 	2600:1f19:c000::/36,AWS 2600:1f1a:2000::/36,AWS 2600:1f1a:4000::/36,AWS 2600:1f1a:8000::/36,AWS 2600:1f1a:c000::/36,AWS 2600:1f1b:4000::/36,AWS
 	2600:1f1b:8000::/36,AWS 2600:1f1b:c000::/36,AWS 2600:1f1c::/36,AWS 2600:1f1c:2000::/36,AWS 2600:1f1c:4000::/36,AWS 2600:1f1c:8000::/36,AWS
 	2600:1f1c:c000::/36,AWS 2600:1f1d:4000::/36,AWS 2600:1f1d:8000::/36,AWS 2600:1f1d:c000::/36,AWS 2600:1f1e::/36,AWS 2600:1f1e:4000::/36,AWS
@@ -1387,493 +807,330 @@ var ranges6 = `
 	2600:1ff4:c000::/40,AWS 2600:1ff4:e000::/40,AWS 2600:1ff4:ec00::/40,AWS 2600:1ff6:800::/40,AWS 2600:1ff6:1000::/40,AWS 2600:1ff6:2000::/40,AWS
 	2600:1ff6:4000::/39,AWS 2600:1ff6:5000::/40,AWS 2600:1ff6:6000::/40,AWS 2600:1ff6:7400::/40,AWS 2600:1ff6:8000::/39,AWS 2600:1ff6:a400::/40,AWS
 	2600:1ff6:c000::/40,AWS 2600:1ff6:e000::/40,AWS 2600:1ff6:ec00::/40,AWS 2600:1ff8:800::/40,AWS 2600:1ff8:1000::/40,AWS 2600:1ff8:2000::/40,AWS
-	2600:1ff8:2c00::/40,AWS 2600:1ff8:4000::/39,AWS 2600:1ff8:5000::/36,AWS 2600:1ff8:6000::/40,AWS 2600:1ff8:7400::/40,AWS 2600:1ff8:8000::/39,AWS
-	2600:1ff8:a400::/40,AWS 2600:1ff8:c000::/40,AWS 2600:1ff8:e000::/40,AWS 2600:1ff8:ec00::/40,AWS 2600:1ff9:800::/40,AWS 2600:1ff9:1000::/40,AWS
-	2600:1ff9:2000::/40,AWS 2600:1ff9:2c00::/40,AWS 2600:1ff9:4000::/39,AWS 2600:1ff9:5000::/40,AWS 2600:1ff9:6000::/40,AWS 2600:1ff9:7400::/40,AWS
-	2600:1ff9:8000::/39,AWS 2600:1ff9:a400::/40,AWS 2600:1ff9:c000::/40,AWS 2600:1ff9:e000::/40,AWS 2600:1ff9:ec00::/40,AWS 2600:1ffa:1000::/40,AWS
-	2600:1ffa:2000::/40,AWS 2600:1ffa:4000::/40,AWS 2600:1ffa:5000::/40,AWS 2600:1ffa:6000::/40,AWS 2600:1ffa:8000::/40,AWS 2600:1ffa:c000::/40,AWS
-	2600:1ffa:e000::/40,AWS 2600:1ffc:1000::/40,AWS 2600:1ffc:2000::/40,AWS 2600:1ffc:4000::/39,AWS 2600:1ffc:5000::/40,AWS 2600:1ffc:6000::/40,AWS
-	2600:1ffc:7400::/40,AWS 2600:1ffc:8000::/39,AWS 2600:1ffc:a400::/40,AWS 2600:1ffc:c000::/40,AWS 2600:1ffc:e000::/40,AWS 2600:1ffd:803f::/48,AWS
-	2600:1ffd:8066::/48,AWS 2600:1ffd:807b::/48,AWS 2600:1ffd:807f::/48,AWS 2600:1ffd:80a7::/48,AWS 2600:1ffd:80c8::/48,AWS 2600:1ffd:80cb::/48,AWS
-	2600:1ffd:80d0::/48,AWS 2600:1ffd:80e1::/48,AWS 2600:1ffd:80f0::/48,AWS 2600:1ffd:812f::/48,AWS 2600:1ffd:8143::/48,AWS 2600:1ffd:8149::/48,AWS
-	2600:1ffd:8165::/48,AWS 2600:1ffd:816c::/48,AWS 2600:1ffd:8188::/48,AWS 2600:1ffd:818f::/48,AWS 2600:1ffd:8190::/48,AWS 2600:1ffd:819f::/48,AWS
-	2600:1ffd:81a7::/48,AWS 2600:1ffd:81c2::/48,AWS 2600:1ffd:8285::/48,AWS 2600:1ffd:82be::/48,AWS 2600:1ffd:831b::/48,AWS 2600:1ffd:833b::/48,AWS
-	2600:1ffd:838e::/48,AWS 2600:1ffd:83ad::/48,AWS 2600:1ffd:83d2::/48,AWS 2600:1ffd:8422::/48,AWS 2600:1ffd:8492::/48,AWS 2600:1ffd:84af::/48,AWS
-	2600:1ffd:84bd::/48,AWS 2600:1ffd:8508::/48,AWS 2600:1ffd:85b2::/48,AWS 2600:1ffd:85c0::/48,AWS 2600:1ffd:85e8::/48,AWS 2600:1ffe:800::/40,AWS
-	2600:1ffe:1000::/40,AWS 2600:1ffe:2000::/40,AWS 2600:1ffe:2c00::/40,AWS 2600:1ffe:4000::/39,AWS 2600:1ffe:5000::/40,AWS 2600:1ffe:6000::/40,AWS
-	2600:1ffe:7400::/40,AWS 2600:1ffe:8000::/39,AWS 2600:1ffe:a400::/40,AWS 2600:1ffe:c000::/40,AWS 2600:1ffe:e000::/40,AWS 2600:1ffe:ec00::/40,AWS
-	2600:1fff:800::/40,AWS 2600:1fff:1000::/40,AWS 2600:1fff:2000::/40,AWS 2600:1fff:2c00::/40,AWS 2600:1fff:3000::/40,AWS 2600:1fff:4000::/39,AWS
-	2600:1fff:5000::/40,AWS 2600:1fff:6000::/40,AWS 2600:1fff:7400::/40,AWS 2600:1fff:8000::/39,AWS 2600:1fff:a400::/40,AWS 2600:1fff:c000::/40,AWS
-	2600:1fff:e000::/40,AWS 2600:1fff:ec00::/40,AWS 2600:3c00::/29,Linode 2600:3c08::/30,Linode 2600:3c0c::/31,Linode 2600:3c0e::/32,Linode
-	2600:3c0f:2::/47,Linode 2600:3c0f:4::/48,Linode 2600:3c0f:6::/47,Linode 2600:3c0f:9::/48,Linode 2600:3c0f:10::/47,Linode 2600:3c0f:14::/46,Linode
-	2600:3c0f:18::/47,Linode 2600:3c0f:20::/45,Linode 2600:3c0f:28::/47,Linode 2600:3c0f:30::/48,Linode 2600:3c0f:32::/48,Linode 2600:3c0f:34::/47,Linode
-	2600:3c0f:37::/48,Linode 2600:3c0f:38::/48,Linode 2600:3c0f:41::/48,Linode 2600:3c0f:42::/47,Linode 2600:3c0f:44::/46,Linode 2600:3c0f:48::/47,Linode
-	2600:3c0f:50::/45,Linode 2600:3c0f:58::/48,Linode 2600:3c12:100::/40,Linode 2600:3c12:300::/40,Linode 2600:3c12:400::/39,Linode 2600:3c12:800::/37,Linode
-	2600:3c13::/32,Linode 2600:3c14::/30,Linode 2600:3c18::/30,Linode 2600:3c1c::/32,Linode 2600:3c1e::/32,Linode 2600:9000:ddd::/48,AWS
-	2600:9000:de0::/43,AWS 2600:9000:eee::/48,AWS 2600:9000:fc0::/43,AWS 2600:9000:ff8::/46,AWS 2600:9000:fff::/48,AWS 2600:9000:1000::/36,AWS
-	2600:9000:2000::/35,AWS 2600:9000:4000::/36,AWS 2600:9000:5200::/39,AWS 2600:9000:6000::/35,AWS 2600:9000:a100::/40,AWS 2600:9000:a200::/39,AWS
-	2600:9000:a400::/38,AWS 2600:9000:a800::/37,AWS 2600:9000:f000::/36,AWS 2600:f000::/39,AWS 2600:f000:8000::/39,AWS 2600:f001::/40,AWS
-	2600:f001:4000::/40,AWS 2600:f002::/39,AWS 2600:f002:8000::/40,AWS 2600:f002:c000::/40,AWS 2600:f003:a200::/40,AWS 2600:f004::/40,AWS
-	2600:f004:4000::/40,AWS 2600:f004:8000::/40,AWS 2600:f004:a000::/40,AWS 2600:f005:8000::/40,AWS 2600:f007::/40,AWS 2600:f008::/38,AWS
-	2600:f008:400::/40,AWS 2600:f00c::/39,AWS 2600:f00c:8000::/39,AWS 2600:f00d::/40,AWS 2600:f00d:8000::/40,AWS 2600:f00e::/39,AWS
+	2600:1ff8:2c00::/40,AWS 2600:1ff8:4000::/39,AWS 2600:1ff8:5000::-2600:1ff8:60ff:ffff:ffff:ffff:ffff:ffff,AWS 2600:1ff8:7400::/40,AWS 2600:1ff8:8000::/39,AWS 2600:1ff8:a400::/40,AWS
+	2600:1ff8:c000::/40,AWS 2600:1ff8:e000::/40,AWS 2600:1ff8:ec00::/40,AWS 2600:1ff9:800::/40,AWS 2600:1ff9:1000::/40,AWS 2600:1ff9:2000::/40,AWS
+	2600:1ff9:2c00::/40,AWS 2600:1ff9:4000::/39,AWS 2600:1ff9:5000::/40,AWS 2600:1ff9:6000::/40,AWS 2600:1ff9:7400::/40,AWS 2600:1ff9:8000::/39,AWS
+	2600:1ff9:a400::/40,AWS 2600:1ff9:c000::/40,AWS 2600:1ff9:e000::/40,AWS 2600:1ff9:ec00::/40,AWS 2600:1ffa:1000::/40,AWS 2600:1ffa:2000::/40,AWS
+	2600:1ffa:4000::/40,AWS 2600:1ffa:5000::/40,AWS 2600:1ffa:6000::/40,AWS 2600:1ffa:8000::/40,AWS 2600:1ffa:c000::/40,AWS 2600:1ffa:e000::/40,AWS
+	2600:1ffc:1000::/40,AWS 2600:1ffc:2000::/40,AWS 2600:1ffc:4000::/39,AWS 2600:1ffc:5000::/40,AWS 2600:1ffc:6000::/40,AWS 2600:1ffc:7400::/40,AWS
+	2600:1ffc:8000::/39,AWS 2600:1ffc:a400::/40,AWS 2600:1ffc:c000::/40,AWS 2600:1ffc:e000::/40,AWS 2600:1ffd:803f::/48,AWS 2600:1ffd:8066::/48,AWS
+	2600:1ffd:807b::/48,AWS 2600:1ffd:807f::/48,AWS 2600:1ffd:80a7::/48,AWS 2600:1ffd:80c8::/48,AWS 2600:1ffd:80cb::/48,AWS 2600:1ffd:80d0::/48,AWS
+	2600:1ffd:80e1::/48,AWS 2600:1ffd:80f0::/48,AWS 2600:1ffd:812f::/48,AWS 2600:1ffd:8143::/48,AWS 2600:1ffd:8149::/48,AWS 2600:1ffd:8165::/48,AWS
+	2600:1ffd:816c::/48,AWS 2600:1ffd:8188::/48,AWS 2600:1ffd:818f::-2600:1ffd:8190:ffff:ffff:ffff:ffff:ffff,AWS 2600:1ffd:819f::/48,AWS 2600:1ffd:81a7::/48,AWS 2600:1ffd:81c2::/48,AWS
+	2600:1ffd:8285::/48,AWS 2600:1ffd:82be::/48,AWS 2600:1ffd:831b::/48,AWS 2600:1ffd:833b::/48,AWS 2600:1ffd:838e::/48,AWS 2600:1ffd:83ad::/48,AWS
+	2600:1ffd:83d2::/48,AWS 2600:1ffd:8422::/48,AWS 2600:1ffd:8492::/48,AWS 2600:1ffd:84af::/48,AWS 2600:1ffd:84bd::/48,AWS 2600:1ffd:8508::/48,AWS
+	2600:1ffd:85b2::/48,AWS 2600:1ffd:85c0::/48,AWS 2600:1ffd:85e8::/48,AWS 2600:1ffe:800::/40,AWS 2600:1ffe:1000::/40,AWS 2600:1ffe:2000::/40,AWS
+	2600:1ffe:2c00::/40,AWS 2600:1ffe:4000::/39,AWS 2600:1ffe:5000::/40,AWS 2600:1ffe:6000::/40,AWS 2600:1ffe:7400::/40,AWS 2600:1ffe:8000::/39,AWS
+	2600:1ffe:a400::/40,AWS 2600:1ffe:c000::/40,AWS 2600:1ffe:e000::/40,AWS 2600:1ffe:ec00::/40,AWS 2600:1fff:800::/40,AWS 2600:1fff:1000::/40,AWS
+	2600:1fff:2000::/40,AWS 2600:1fff:2c00::/40,AWS 2600:1fff:3000::/40,AWS 2600:1fff:4000::/39,AWS 2600:1fff:5000::/40,AWS 2600:1fff:6000::/40,AWS
+	2600:1fff:7400::/40,AWS 2600:1fff:8000::/39,AWS 2600:1fff:a400::/40,AWS 2600:1fff:c000::/40,AWS 2600:1fff:e000::/40,AWS 2600:1fff:ec00::/40,AWS
+	2600:3c00::-2600:3c0e:ffff:ffff:ffff:ffff:ffff:ffff,Linode 2600:3c0f:2::-2600:3c0f:4:ffff:ffff:ffff:ffff:ffff,Linode 2600:3c0f:6::/47,Linode 2600:3c0f:9::/48,Linode 2600:3c0f:10::/47,Linode 2600:3c0f:14::-2600:3c0f:19:ffff:ffff:ffff:ffff:ffff,Linode
+	2600:3c0f:20::-2600:3c0f:29:ffff:ffff:ffff:ffff:ffff,Linode 2600:3c0f:30::/48,Linode 2600:3c0f:32::/48,Linode 2600:3c0f:34::/47,Linode 2600:3c0f:37::-2600:3c0f:38:ffff:ffff:ffff:ffff:ffff,Linode 2600:3c0f:41::-2600:3c0f:49:ffff:ffff:ffff:ffff:ffff,Linode
+	2600:3c0f:50::-2600:3c0f:58:ffff:ffff:ffff:ffff:ffff,Linode 2600:3c12:100::/40,Linode 2600:3c12:300::-2600:3c12:5ff:ffff:ffff:ffff:ffff:ffff,Linode 2600:3c12:800::/37,Linode 2600:3c13::-2600:3c1c:ffff:ffff:ffff:ffff:ffff:ffff,Linode 2600:3c1e::/32,Linode
+	2600:9000:ddd::/48,AWS 2600:9000:de0::/43,AWS 2600:9000:eee::/48,AWS 2600:9000:fc0::/43,AWS 2600:9000:ff8::/46,AWS 2600:9000:fff::-2600:9000:4fff:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:9000:5200::/39,AWS 2600:9000:6000::/35,AWS 2600:9000:a100::-2600:9000:afff:ffff:ffff:ffff:ffff:ffff,AWS 2600:9000:f000::/36,AWS 2600:f000::/39,AWS 2600:f000:8000::/39,AWS
+	2600:f001::/40,AWS 2600:f001:4000::/40,AWS 2600:f002::/39,AWS 2600:f002:8000::/40,AWS 2600:f002:c000::/40,AWS 2600:f003:a200::/40,AWS
+	2600:f004::/40,AWS 2600:f004:4000::/40,AWS 2600:f004:8000::/40,AWS 2600:f004:a000::/40,AWS 2600:f005:8000::/40,AWS 2600:f007::/40,AWS
+	2600:f008::-2600:f008:4ff:ffff:ffff:ffff:ffff:ffff,AWS 2600:f00c::/39,AWS 2600:f00c:8000::/39,AWS 2600:f00d::/40,AWS 2600:f00d:8000::/40,AWS 2600:f00e::/39,AWS
 	2600:f00e:400::/40,AWS 2600:f00e:600::/40,AWS 2600:f00f::/40,AWS 2600:f00f:4000::/40,AWS 2600:f00f:6000::/40,AWS 2600:f00f:c000::/40,AWS
-	2600:f0f0:0:100::/56,AWS 2600:f0f0:0:200::/59,AWS 2600:f0f0:0:300::/56,AWS 2600:f0f0:0:d00::/56,AWS 2600:f0f0:1:a00::/56,AWS 2600:f0f0:1:f00::/56,AWS
-	2600:f0f0:1:1000::/55,AWS 2600:f0f0:1:1300::/56,AWS 2600:f0f0:1:1400::/56,AWS 2600:f0f0:1:1600::/55,AWS 2600:f0f0:1:1800::/56,AWS 2600:f0f0:1:1a00::/55,AWS
-	2600:f0f0:1:1c00::/56,AWS 2600:f0f0:1:1e00::/55,AWS 2600:f0f0:1:2000::/55,AWS 2600:f0f0:1:2200::/56,AWS 2600:f0f0:1:fd00::/56,AWS 2600:f0f0:1:fe00::/55,AWS
-	2600:f0f0:2::/48,AWS 2600:f0f0:4::/47,AWS 2600:f0f0:7::/48,AWS 2600:f0f0:8::/48,AWS 2600:f0f0:a::/47,AWS 2600:f0f0:10::/47,AWS
-	2600:f0f0:20::/48,AWS 2600:f0f0:22::/47,AWS 2600:f0f0:30::/44,AWS 2600:f0f0:40::/48,AWS 2600:f0f0:60::/43,AWS 2600:f0f0:80::/45,AWS
-	2600:f0f0:90:1400::/56,AWS 2600:f0f0:91::/48,AWS 2600:f0f0:a0::/48,AWS 2600:f0f0:c0::/44,AWS 2600:f0f0:300:100::/56,AWS 2600:f0f0:30e::/47,AWS
-	2600:f0f0:310::/48,AWS 2600:f0f0:400::/44,AWS 2600:f0f0:500::/48,AWS 2600:f0f0:600::/54,AWS 2600:f0f0:601::/48,AWS 2600:f0f0:602::/47,AWS
-	2600:f0f0:700::/47,AWS 2600:f0f0:702::/48,AWS 2600:f0f0:720::/47,AWS 2600:f0f0:722::/48,AWS 2600:f0f0:730::/47,AWS 2600:f0f0:cfb::/48,AWS
-	2600:f0f0:cfc::/46,AWS 2600:f0f0:e00::/45,AWS 2600:f0f0:e08::/47,AWS 2600:f0f0:e0a::/48,AWS 2600:f0f0:e0d::/48,AWS 2600:f0f0:e0f::/48,AWS
-	2600:f0f0:e11::/48,AWS 2600:f0f0:e12::/47,AWS 2600:f0f0:e14::/46,AWS 2600:f0f0:e18::/45,AWS 2600:f0f0:e20::/48,AWS 2600:f0f0:e24::/48,AWS
-	2600:f0f0:e26::/47,AWS 2600:f0f0:e28::/45,AWS 2600:f0f0:e30::/45,AWS 2600:f0f0:e38::/48,AWS 2600:f0f0:f00::/54,AWS 2600:f0f0:f00:400::/55,AWS
-	2600:f0f0:1000::/44,AWS 2600:f0f0:1100::/40,AWS 2600:f0f0:4000::/48,AWS 2600:f0f0:4100::/40,AWS 2600:f0f0:4200::/40,AWS 2600:f0f0:5400::/45,AWS
-	2600:f0f0:5408::/47,AWS 2600:f0f0:5500::/48,AWS 2600:f0f0:5502::/47,AWS 2600:f0f0:5504::/46,AWS 2600:f0f0:5510::/44,AWS 2600:f0f0:5520::/43,AWS
-	2600:f0f0:6000::/43,AWS 2600:f0f0:6025::/48,AWS 2600:f0f0:6026::/47,AWS 2600:f0f0:6100::/43,AWS 2600:f0f0:6120::/46,AWS 2600:f0f0:6124::/47,AWS
-	2600:f0f0:6126::/48,AWS 2600:f0f0:8100::/44,AWS 2600:f0f0:8110::/48,AWS 2600:f0f0:8120::/48,AWS 2600:f0f0:8130::/48,AWS 2600:f0f0:8140::/48,AWS
-	2600:f0f0:8150::/48,AWS 2600:f0f0:8160::/48,AWS 2600:f0f0:8170::/48,AWS 2600:f0f0:8180::/46,AWS 2600:f0f0:8184::/47,AWS 2600:f0f0:8186::/48,AWS
-	2600:f0f0:c000::/46,AWS 2600:f0f0:c004::/47,AWS 2600:f0f0:c040::/45,AWS 2600:f0f0:c048::/47,AWS 2600:f0f0:c102::/48,AWS 2600:f0f0:c106::/47,AWS
-	2600:f0f0:c109::/48,AWS 2600:f0f0:c10a::/47,AWS 2600:f0f0:c10c::/46,AWS 2600:f0f0:c111::/48,AWS 2600:f0f0:c112::/47,AWS 2600:f0f0:c114::/46,AWS
-	2600:f0f0:c118::/46,AWS 2600:f0f0:c11c::/48,AWS 2600:f0f0:c11f::/48,AWS 2600:f0f0:c120::/45,AWS 2600:f0f0:c128::/46,AWS 2600:f0f0:c12d::/48,AWS
-	2600:f0f0:c12e::/47,AWS 2600:f0f0:c130::/46,AWS 2600:f0f0:c135::/48,AWS 2600:f0f0:c136::/47,AWS 2600:f0f0:c138::/45,AWS 2600:f0f0:c140::/48,AWS
-	2600:f0f0:c143::/48,AWS 2600:f0f0:c144::/46,AWS 2600:f0f0:c148::/46,AWS 2600:f0f0:c14c::/47,AWS 2600:f0f1:10::/45,AWS 2600:f0f1:18::/46,AWS
-	2600:f0f1:80::/44,AWS 2600:f0f1:4000::/41,AWS 2600:f0f1:40c0::/42,AWS 2600:f0f1:4100::/40,AWS 2600:f0f1:4200::/39,AWS 2600:f0f1:4400::/40,AWS
-	2600:f0f1:4500::/41,AWS 2600:f0f1:4580::/42,AWS 2600:f0f1:4840::/42,AWS 2600:f0f1:8801::/48,AWS 2600:f0f1:8802::/48,AWS 2600:f0f1:8a00::/44,AWS
-	2600:f0f1:8a10::/48,AWS 2600:f0f1:8b00::/46,AWS 2600:f0f2:7000::/43,AWS 2600:f0f2:7020::/46,AWS 2600:f0f2:7025::/48,AWS 2600:f0f2:7028::/47,AWS
-	2600:f0f2:7100::/40,AWS 2600:f0f2:7400::/38,AWS 2600:f0f3:f000::/44,AWS 2600:f0f3:f010::/56,AWS 2600:f0f3:f010:200::/55,AWS 2600:f0f3:f010:2a00::/56,AWS
-	2600:f0f3:f010:2c00::/56,AWS 2600:f0f3:f010:3400::/56,AWS 2600:f0f3:f010:3800::/56,AWS 2600:f0f3:f010:3a00::/56,AWS 2600:f0fb:8000::/40,AWS 2600:f0fb:c000::/47,AWS
-	2600:f0fb:c002::/56,AWS 2600:f0fb:c003::/48,AWS 2600:f0fb:c0ff::/56,AWS 2600:f0fb:c800::/48,AWS 2600:f0fb:c900::/52,AWS 2600:f0fb:ca00::/52,AWS
-	2600:f0fb:e000::/36,AWS 2600:f0fb:f000::/44,AWS 2600:f0fb:f020::/45,AWS 2600:f0fb:f028::/47,AWS 2600:f0fb:f100::/44,AWS 2600:f0fb:f110::/47,AWS
-	2602:f7db::/44,AWS 2602:fd5e:1::/63,Azure 2602:fd5e:1:2::/64,Azure 2602:fd5e:2::/57,Azure 2603:1000::/47,Azure 2603:1000:3::/48,Azure
-	2603:1000:4::/47,Azure 2603:1000:6::/48,Azure 2603:1000:100::/47,Azure 2603:1000:103::/48,Azure 2603:1000:104::/47,Azure 2603:1000:106::/48,Azure
-	2603:1006:1400::/47,Azure 2603:1006:1500::/64,Azure 2603:1006:1500:4::/64,Azure 2603:1006:2000::/48,Azure 2603:1007:200::/48,Azure 2603:1010::/46,Azure
-	2603:1010:5::/48,Azure 2603:1010:6::/47,Azure 2603:1010:8::/48,Azure 2603:1010:40::/48,Azure 2603:1010:80::/56,Azure 2603:1010:100::/40,Azure
-	2603:1010:200::/47,Azure 2603:1010:202::/48,Azure 2603:1010:204::/46,Azure 2603:1010:300::/47,Azure 2603:1010:303::/48,Azure 2603:1010:304::/47,Azure
-	2603:1010:306::/48,Azure 2603:1010:400::/47,Azure 2603:1010:403::/48,Azure 2603:1010:404::/47,Azure 2603:1010:406::/48,Azure 2603:1010:501::/48,Azure
-	2603:1010:502::/47,Azure 2603:1010:504::/47,Azure 2603:1016:1400::/48,Azure 2603:1016:2400::/46,Azure 2603:1016:2500::/64,Azure 2603:1016:2500:4::/64,Azure
-	2603:1016:2500:8::/64,Azure 2603:1016:2500:c::/64,Azure 2603:1017::/48,Azure 2603:1020::/47,Azure 2603:1020:2::/48,Azure 2603:1020:4::/46,Azure
-	2603:1020:100::/47,Azure 2603:1020:103::/48,Azure 2603:1020:104::/48,Azure 2603:1020:106::/48,Azure 2603:1020:200::/46,Azure 2603:1020:205::/48,Azure
-	2603:1020:206::/47,Azure 2603:1020:208::/56,Azure 2603:1020:209::/48,Azure 2603:1020:300::/47,Azure 2603:1020:302::/48,Azure 2603:1020:305::/48,Azure
-	2603:1020:401::/48,Azure 2603:1020:402::/63,Azure 2603:1020:405::/48,Azure 2603:1020:406::/124,Azure 2603:1020:406::10/126,Azure 2603:1020:406::15/128,Azure
-	2603:1020:406::16/127,Azure 2603:1020:406::18/128,Azure 2603:1020:500::/47,Azure 2603:1020:503::/48,Azure 2603:1020:504::/48,Azure 2603:1020:600::/47,Azure
-	2603:1020:602::/48,Azure 2603:1020:604::/46,Azure 2603:1020:700::/47,Azure 2603:1020:702::/48,Azure 2603:1020:704::/46,Azure 2603:1020:800::/47,Azure
-	2603:1020:802::/48,Azure 2603:1020:804::/46,Azure 2603:1020:900::/47,Azure 2603:1020:902::/48,Azure 2603:1020:904::/46,Azure 2603:1020:a00::/47,Azure
-	2603:1020:a03::/48,Azure 2603:1020:a04::/47,Azure 2603:1020:a06::/48,Azure 2603:1020:b00::/47,Azure 2603:1020:b03::/48,Azure 2603:1020:b04::/47,Azure
-	2603:1020:b06::/48,Azure 2603:1020:c00::/47,Azure 2603:1020:c03::/48,Azure 2603:1020:c04::/47,Azure 2603:1020:c07::/48,Azure 2603:1020:c40::/48,Azure
-	2603:1020:c80::/56,Azure 2603:1020:d00::/47,Azure 2603:1020:d03::/48,Azure 2603:1020:d04::/47,Azure 2603:1020:d06::/48,Azure 2603:1020:e00::/47,Azure
-	2603:1020:e03::/48,Azure 2603:1020:e04::/47,Azure 2603:1020:e06::/48,Azure 2603:1020:f00::/47,Azure 2603:1020:f03::/48,Azure 2603:1020:f04::/47,Azure
-	2603:1020:f06::/48,Azure 2603:1020:1000::/47,Azure 2603:1020:1003::/48,Azure 2603:1020:1004::/47,Azure 2603:1020:1007::/48,Azure 2603:1020:1100::/47,Azure
-	2603:1020:1103::/48,Azure 2603:1020:1104::/46,Azure 2603:1020:1109::/48,Azure 2603:1020:1200::/47,Azure 2603:1020:1204::/47,Azure 2603:1020:1206::/48,Azure
-	2603:1020:1300::/46,Azure 2603:1020:1305::/48,Azure 2603:1020:1402::/47,Azure 2603:1020:1404::/47,Azure 2603:1020:1406::/48,Azure 2603:1020:1501::/48,Azure
-	2603:1020:1502::/47,Azure 2603:1020:1504::/47,Azure 2603:1020:1601::/48,Azure 2603:1020:1602::/47,Azure 2603:1020:1604::/47,Azure 2603:1020:1700::/46,Azure
-	2603:1020:1704::/48,Azure 2603:1026:800::/63,Azure 2603:1026:800:2::/64,Azure 2603:1026:900::/59,Azure 2603:1026:900:20::/61,Azure 2603:1026:900:28::/63,Azure
-	2603:1026:900:2b::/64,Azure 2603:1026:900:2c::/62,Azure 2603:1026:900:30::/60,Azure 2603:1026:900:40::/60,Azure 2603:1026:900:50::/62,Azure 2603:1026:900:54::/64,Azure
-	2603:1026:2400::/47,Azure 2603:1026:2402::/48,Azure 2603:1026:2403::/63,Azure 2603:1026:2404::/46,Azure 2603:1026:2408::/46,Azure 2603:1026:240c::/47,Azure
-	2603:1026:240e::/48,Azure 2603:1026:240f::/63,Azure 2603:1026:2410::/46,Azure 2603:1026:2415::/48,Azure 2603:1026:2500::/64,Azure 2603:1026:2500:4::/64,Azure
-	2603:1026:2500:8::/64,Azure 2603:1026:2500:c::/64,Azure 2603:1026:2500:10::/64,Azure 2603:1026:2500:14::/64,Azure 2603:1026:2500:18::/64,Azure 2603:1026:2500:1c::/64,Azure
-	2603:1026:2500:20::/64,Azure 2603:1026:2500:24::/64,Azure 2603:1026:2500:28::/64,Azure 2603:1026:2500:2c::/64,Azure 2603:1026:2500:30::/64,Azure 2603:1026:2500:34::/64,Azure
-	2603:1026:2500:3c::/64,Azure 2603:1026:3000::/48,Azure 2603:1027:1::/48,Azure 2603:1030::/45,Azure 2603:1030:8::/48,Azure 2603:1030:9::/59,Azure
-	2603:1030:9:20::/63,Azure 2603:1030:9:27::/64,Azure 2603:1030:9:28::/61,Azure 2603:1030:9:30::/60,Azure 2603:1030:9:40::/63,Azure 2603:1030:9:47::/64,Azure
-	2603:1030:9:48::/63,Azure 2603:1030:9:4a::/64,Azure 2603:1030:9:51::/64,Azure 2603:1030:9:52::/63,Azure 2603:1030:9:54::/62,Azure 2603:1030:9:58::/61,Azure
-	2603:1030:9:60::/59,Azure 2603:1030:9:80::/60,Azure 2603:1030:9:90::/61,Azure 2603:1030:9:98::/63,Azure 2603:1030:9:9a::/64,Azure 2603:1030:9:a1::/64,Azure
-	2603:1030:9:a2::/63,Azure 2603:1030:9:a4::/62,Azure 2603:1030:9:a8::/61,Azure 2603:1030:9:b0::/60,Azure 2603:1030:9:c0::/63,Azure 2603:1030:9:c8::/61,Azure
-	2603:1030:9:d6::/63,Azure 2603:1030:9:d8::/61,Azure 2603:1030:9:e0::/59,Azure 2603:1030:9:100::/56,Azure 2603:1030:9:200::/57,Azure 2603:1030:9:280::/58,Azure
-	2603:1030:9:2c0::/60,Azure 2603:1030:9:2d0::/61,Azure 2603:1030:9:2d8::/63,Azure 2603:1030:9:2de::/63,Azure 2603:1030:9:2e0::/63,Azure 2603:1030:9:2e8::/61,Azure
-	2603:1030:9:2f0::/60,Azure 2603:1030:9:300::/59,Azure 2603:1030:9:320::/60,Azure 2603:1030:9:330::/61,Azure 2603:1030:9:338::/62,Azure 2603:1030:9:33c::/64,Azure
-	2603:1030:9:345::/64,Azure 2603:1030:9:346::/63,Azure 2603:1030:9:348::/63,Azure 2603:1030:9:34b::/64,Azure 2603:1030:9:34c::/62,Azure 2603:1030:9:350::/60,Azure
-	2603:1030:9:360::/59,Azure 2603:1030:9:380::/59,Azure 2603:1030:9:3a0::/60,Azure 2603:1030:9:3b0::/61,Azure 2603:1030:9:3b8::/63,Azure 2603:1030:9:3bc::/62,Azure
-	2603:1030:9:3c0::/62,Azure 2603:1030:9:3c4::/63,Azure 2603:1030:9:3c8::/61,Azure 2603:1030:9:3d0::/62,Azure 2603:1030:9:3d4::/63,Azure 2603:1030:9:3d9::/64,Azure
-	2603:1030:9:3da::/63,Azure 2603:1030:9:3dc::/62,Azure 2603:1030:9:3e0::/60,Azure 2603:1030:9:3f0::/62,Azure 2603:1030:9:3f4::/63,Azure 2603:1030:9:3f8::/61,Azure
-	2603:1030:9:402::/63,Azure 2603:1030:9:404::/63,Azure 2603:1030:9:408::/61,Azure 2603:1030:9:410::/60,Azure 2603:1030:9:420::/59,Azure 2603:1030:9:440::/58,Azure
-	2603:1030:9:480::/57,Azure 2603:1030:9:500::/57,Azure 2603:1030:9:580::/59,Azure 2603:1030:9:5a0::/60,Azure 2603:1030:9:5b0::/61,Azure 2603:1030:9:5b8::/62,Azure
-	2603:1030:9:5bc::/63,Azure 2603:1030:9:5be::/64,Azure 2603:1030:9:5c3::/64,Azure 2603:1030:9:5c4::/62,Azure 2603:1030:9:5c8::/61,Azure 2603:1030:9:5d0::/60,Azure
-	2603:1030:9:5e0::/59,Azure 2603:1030:9:600::/58,Azure 2603:1030:9:640::/59,Azure 2603:1030:9:660::/64,Azure 2603:1030:9:664::/62,Azure 2603:1030:9:668::/63,Azure
-	2603:1030:9:66d::/64,Azure 2603:1030:9:66e::/63,Azure 2603:1030:9:673::/64,Azure 2603:1030:9:674::/62,Azure 2603:1030:9:678::/61,Azure 2603:1030:9:680::/57,Azure
-	2603:1030:9:700::/59,Azure 2603:1030:9:720::/63,Azure 2603:1030:9:734::/62,Azure 2603:1030:9:738::/61,Azure 2603:1030:9:740::/58,Azure 2603:1030:9:780::/57,Azure
-	2603:1030:9:800::/58,Azure 2603:1030:9:840::/59,Azure 2603:1030:9:860::/61,Azure 2603:1030:9:868::/62,Azure 2603:1030:9:86c::/63,Azure 2603:1030:9:870::/60,Azure
-	2603:1030:9:880::/59,Azure 2603:1030:9:8a0::/61,Azure 2603:1030:9:8a8::/64,Azure 2603:1030:a::/47,Azure 2603:1030:d::/48,Azure 2603:1030:e::/47,Azure
-	2603:1030:10::/47,Azure 2603:1030:12::/48,Azure 2603:1030:13::/55,Azure 2603:1030:13:200::/62,Azure 2603:1030:14::/48,Azure 2603:1030:100::/60,Azure
-	2603:1030:100:12::/63,Azure 2603:1030:100:14::/62,Azure 2603:1030:100:1a::/63,Azure 2603:1030:100:1c::/62,Azure 2603:1030:100:20::/62,Azure 2603:1030:100:24::/64,Azure
-	2603:1030:100:26::/63,Azure 2603:1030:100:28::/63,Azure 2603:1030:100:2b::/64,Azure 2603:1030:100:2c::/63,Azure 2603:1030:100:2e::/64,Azure 2603:1030:100:30::/60,Azure
-	2603:1030:100:40::/60,Azure 2603:1030:101::/48,Azure 2603:1030:103::/48,Azure 2603:1030:104::/46,Azure 2603:1030:108::/47,Azure 2603:1030:20c::/47,Azure
-	2603:1030:20e::/48,Azure 2603:1030:210::/47,Azure 2603:1030:212::/56,Azure 2603:1030:213::/48,Azure 2603:1030:214::/48,Azure 2603:1030:301::/48,Azure
-	2603:1030:302::/47,Azure 2603:1030:400::/48,Azure 2603:1030:401::/60,Azure 2603:1030:401:10::/62,Azure 2603:1030:401:16::/63,Azure 2603:1030:401:18::/61,Azure
-	2603:1030:401:20::/61,Azure 2603:1030:401:28::/64,Azure 2603:1030:401:2c::/64,Azure 2603:1030:401:2f::/64,Azure 2603:1030:401:30::/60,Azure 2603:1030:401:40::/60,Azure
-	2603:1030:401:50::/62,Azure 2603:1030:401:54::/63,Azure 2603:1030:401:56::/64,Azure 2603:1030:401:59::/64,Azure 2603:1030:401:5a::/63,Azure 2603:1030:401:5c::/62,Azure
-	2603:1030:401:60::/62,Azure 2603:1030:401:64::/64,Azure 2603:1030:401:6b::/64,Azure 2603:1030:401:6c::/62,Azure 2603:1030:401:70::/60,Azure 2603:1030:401:80::/60,Azure
-	2603:1030:401:92::/63,Azure 2603:1030:401:94::/62,Azure 2603:1030:401:98::/61,Azure 2603:1030:401:a0::/61,Azure 2603:1030:401:a8::/62,Azure 2603:1030:401:ac::/63,Azure
-	2603:1030:401:ae::/64,Azure 2603:1030:401:b1::/64,Azure 2603:1030:401:b2::/63,Azure 2603:1030:401:b4::/62,Azure 2603:1030:401:b8::/61,Azure 2603:1030:401:c0::/62,Azure
-	2603:1030:401:c4::/64,Azure 2603:1030:401:c7::/64,Azure 2603:1030:401:c8::/61,Azure 2603:1030:401:d0::/60,Azure 2603:1030:401:e0::/62,Azure 2603:1030:401:e4::/63,Azure
-	2603:1030:401:e6::/64,Azure 2603:1030:401:e9::/64,Azure 2603:1030:401:ea::/63,Azure 2603:1030:401:ec::/62,Azure 2603:1030:401:f0::/60,Azure 2603:1030:401:100::/57,Azure
-	2603:1030:401:180::/59,Azure 2603:1030:401:1a0::/61,Azure 2603:1030:401:1a8::/63,Azure 2603:1030:401:1ac::/62,Azure 2603:1030:401:1b0::/62,Azure 2603:1030:401:1b4::/63,Azure
-	2603:1030:401:1b8::/61,Azure 2603:1030:401:1c0::/58,Azure 2603:1030:401:200::/60,Azure 2603:1030:401:210::/64,Azure 2603:1030:401:213::/64,Azure 2603:1030:401:214::/62,Azure
-	2603:1030:401:218::/63,Azure 2603:1030:401:21a::/64,Azure 2603:1030:401:21d::/64,Azure 2603:1030:401:21e::/63,Azure 2603:1030:401:220::/59,Azure 2603:1030:401:240::/58,Azure
-	2603:1030:401:280::/59,Azure 2603:1030:401:2a0::/60,Azure 2603:1030:401:2b0::/61,Azure 2603:1030:401:2b8::/62,Azure 2603:1030:401:2bc::/64,Azure 2603:1030:401:2bf::/64,Azure
-	2603:1030:401:2c0::/61,Azure 2603:1030:401:2c8::/64,Azure 2603:1030:401:2cb::/64,Azure 2603:1030:401:2cc::/62,Azure 2603:1030:401:2d0::/64,Azure 2603:1030:401:2d3::/64,Azure
-	2603:1030:401:2d4::/62,Azure 2603:1030:401:2d8::/62,Azure 2603:1030:401:2dc::/64,Azure 2603:1030:401:2df::/64,Azure 2603:1030:401:2e0::/62,Azure 2603:1030:401:2e4::/64,Azure
-	2603:1030:401:2e7::/64,Azure 2603:1030:401:2e8::/61,Azure 2603:1030:401:2f0::/62,Azure 2603:1030:401:2f7::/64,Azure 2603:1030:401:2f8::/61,Azure 2603:1030:401:300::/60,Azure
-	2603:1030:401:310::/62,Azure 2603:1030:401:314::/63,Azure 2603:1030:401:318::/61,Azure 2603:1030:401:320::/61,Azure 2603:1030:401:328::/64,Azure 2603:1030:401:32b::/64,Azure
-	2603:1030:401:32c::/62,Azure 2603:1030:401:330::/60,Azure 2603:1030:401:340::/60,Azure 2603:1030:401:350::/61,Azure 2603:1030:401:358::/62,Azure 2603:1030:401:35c::/64,Azure
-	2603:1030:401:35f::/64,Azure 2603:1030:401:360::/59,Azure 2603:1030:401:380::/57,Azure 2603:1030:401:400::/60,Azure 2603:1030:401:410::/62,Azure 2603:1030:401:414::/64,Azure
-	2603:1030:401:417::/64,Azure 2603:1030:401:418::/61,Azure 2603:1030:401:420::/60,Azure 2603:1030:401:430::/61,Azure 2603:1030:401:438::/64,Azure 2603:1030:401:43b::/64,Azure
-	2603:1030:401:43c::/62,Azure 2603:1030:401:444::/62,Azure 2603:1030:401:448::/61,Azure 2603:1030:401:450::/60,Azure 2603:1030:401:460::/61,Azure 2603:1030:401:468::/62,Azure
-	2603:1030:401:470::/60,Azure 2603:1030:401:480::/59,Azure 2603:1030:401:4a0::/63,Azure 2603:1030:401:4a4::/62,Azure 2603:1030:401:4a8::/61,Azure 2603:1030:401:4b0::/60,Azure
-	2603:1030:401:4c0::/58,Azure 2603:1030:401:500::/60,Azure 2603:1030:401:510::/61,Azure 2603:1030:401:518::/64,Azure 2603:1030:401:51b::/64,Azure 2603:1030:401:51c::/62,Azure
-	2603:1030:401:520::/60,Azure 2603:1030:401:532::/63,Azure 2603:1030:401:536::/63,Azure 2603:1030:401:538::/62,Azure 2603:1030:401:53c::/63,Azure 2603:1030:401:540::/60,Azure
-	2603:1030:401:550::/62,Azure 2603:1030:401:557::/64,Azure 2603:1030:401:558::/63,Azure 2603:1030:401:55d::/64,Azure 2603:1030:401:55e::/63,Azure 2603:1030:401:560::/63,Azure
-	2603:1030:401:564::/62,Azure 2603:1030:401:568::/61,Azure 2603:1030:401:570::/64,Azure 2603:1030:401:573::/64,Azure 2603:1030:401:574::/62,Azure 2603:1030:401:578::/63,Azure
-	2603:1030:401:57c::/63,Azure 2603:1030:401:580::/61,Azure 2603:1030:401:588::/64,Azure 2603:1030:401:58b::/64,Azure 2603:1030:401:58c::/63,Azure 2603:1030:401:58e::/64,Azure
-	2603:1030:401:590::/64,Azure 2603:1030:401:592::/64,Azure 2603:1030:401:594::/62,Azure 2603:1030:401:598::/64,Azure 2603:1030:401:59c::/64,Azure 2603:1030:401:59e::/63,Azure
-	2603:1030:401:5a0::/62,Azure 2603:1030:401:5a4::/64,Azure 2603:1030:401:5a6::/63,Azure 2603:1030:401:5a8::/61,Azure 2603:1030:401:5b0::/61,Azure 2603:1030:401:5b8::/62,Azure
-	2603:1030:401:5bc::/63,Azure 2603:1030:401:5be::/64,Azure 2603:1030:401:5c3::/64,Azure 2603:1030:401:5c4::/62,Azure 2603:1030:401:5c8::/61,Azure 2603:1030:401:5d0::/60,Azure
-	2603:1030:401:5e0::/59,Azure 2603:1030:401:600::/58,Azure 2603:1030:401:640::/59,Azure 2603:1030:401:660::/61,Azure 2603:1030:401:668::/62,Azure 2603:1030:401:66c::/64,Azure
-	2603:1030:401:66e::/63,Azure 2603:1030:401:670::/63,Azure 2603:1030:401:672::/64,Azure 2603:1030:401:674::/62,Azure 2603:1030:401:678::/64,Azure 2603:1030:401:67a::/63,Azure
-	2603:1030:401:67c::/63,Azure 2603:1030:401:67f::/64,Azure 2603:1030:401:680::/58,Azure 2603:1030:401:6c0::/59,Azure 2603:1030:401:6e0::/61,Azure 2603:1030:401:6e8::/62,Azure
-	2603:1030:401:6ee::/63,Azure 2603:1030:401:6f1::/64,Azure 2603:1030:401:6f2::/63,Azure 2603:1030:401:6f4::/62,Azure 2603:1030:401:6f8::/61,Azure 2603:1030:401:700::/61,Azure
-	2603:1030:401:708::/62,Azure 2603:1030:401:70d::/64,Azure 2603:1030:401:70e::/63,Azure 2603:1030:401:710::/60,Azure 2603:1030:401:720::/59,Azure 2603:1030:401:740::/59,Azure
-	2603:1030:401:760::/60,Azure 2603:1030:401:770::/61,Azure 2603:1030:401:778::/62,Azure 2603:1030:401:77c::/63,Azure 2603:1030:401:77e::/64,Azure 2603:1030:401:780::/64,Azure
-	2603:1030:401:783::/64,Azure 2603:1030:401:784::/62,Azure 2603:1030:401:788::/61,Azure 2603:1030:401:790::/60,Azure 2603:1030:401:7a0::/59,Azure 2603:1030:401:7c0::/59,Azure
-	2603:1030:401:7e0::/60,Azure 2603:1030:401:7f0::/63,Azure 2603:1030:401:7f2::/64,Azure 2603:1030:401:7f5::/64,Azure 2603:1030:401:7f6::/63,Azure 2603:1030:401:7f8::/61,Azure
-	2603:1030:401:800::/59,Azure 2603:1030:401:820::/61,Azure 2603:1030:401:828::/63,Azure 2603:1030:401:82c::/64,Azure 2603:1030:401:82e::/63,Azure 2603:1030:401:830::/61,Azure
-	2603:1030:401:838::/62,Azure 2603:1030:401:83c::/63,Azure 2603:1030:401:83f::/64,Azure 2603:1030:401:840::/58,Azure 2603:1030:401:880::/59,Azure 2603:1030:401:8a1::/64,Azure
-	2603:1030:401:8a2::/63,Azure 2603:1030:401:8a4::/62,Azure 2603:1030:401:8a8::/61,Azure 2603:1030:401:8b0::/60,Azure 2603:1030:401:8c0::/59,Azure 2603:1030:401:8e0::/61,Azure
-	2603:1030:401:8e8::/63,Azure 2603:1030:401:8ea::/64,Azure 2603:1030:401:8ee::/63,Azure 2603:1030:401:8f0::/60,Azure 2603:1030:401:900::/61,Azure 2603:1030:401:908::/62,Azure
-	2603:1030:401:90c::/64,Azure 2603:1030:401:90e::/63,Azure 2603:1030:401:910::/60,Azure 2603:1030:401:920::/62,Azure 2603:1030:401:924::/64,Azure 2603:1030:401:926::/63,Azure
-	2603:1030:401:928::/61,Azure 2603:1030:401:930::/60,Azure 2603:1030:401:940::/58,Azure 2603:1030:401:980::/57,Azure 2603:1030:401:a00::/56,Azure 2603:1030:401:b00::/60,Azure
-	2603:1030:401:b10::/61,Azure 2603:1030:401:b18::/64,Azure 2603:1030:402::/47,Azure 2603:1030:405::/48,Azure 2603:1030:406::/47,Azure 2603:1030:408::/47,Azure
-	2603:1030:40a::/61,Azure 2603:1030:40a:8::/63,Azure 2603:1030:40a:10::/64,Azure 2603:1030:40b::/48,Azure 2603:1030:40c::/48,Azure 2603:1030:40d::/60,Azure
-	2603:1030:40d:4000::/50,Azure 2603:1030:40d:8000::/49,Azure 2603:1030:40e::/55,Azure 2603:1030:40f::/48,Azure 2603:1030:410::/47,Azure 2603:1030:412::/48,Azure
-	2603:1030:500::/47,Azure 2603:1030:503::/48,Azure 2603:1030:504::/47,Azure 2603:1030:507::/48,Azure 2603:1030:600::/46,Azure 2603:1030:604::/47,Azure
-	2603:1030:607::/48,Azure 2603:1030:608::/47,Azure 2603:1030:60a::/48,Azure 2603:1030:701::/48,Azure 2603:1030:702::/47,Azure 2603:1030:704::/47,Azure
-	2603:1030:800::/48,Azure 2603:1030:802::/47,Azure 2603:1030:804::/63,Azure 2603:1030:804:2::/64,Azure 2603:1030:804:6::/63,Azure 2603:1030:804:8::/61,Azure
-	2603:1030:804:10::/60,Azure 2603:1030:804:20::/63,Azure 2603:1030:804:22::/64,Azure 2603:1030:804:26::/63,Azure 2603:1030:804:28::/62,Azure 2603:1030:804:35::/64,Azure
-	2603:1030:804:36::/63,Azure 2603:1030:804:38::/64,Azure 2603:1030:804:3f::/64,Azure 2603:1030:804:40::/63,Azure 2603:1030:804:47::/64,Azure 2603:1030:804:48::/61,Azure
-	2603:1030:804:50::/60,Azure 2603:1030:804:60::/59,Azure 2603:1030:804:80::/59,Azure 2603:1030:804:a0::/60,Azure 2603:1030:804:b0::/61,Azure 2603:1030:804:b8::/62,Azure
-	2603:1030:804:bc::/63,Azure 2603:1030:804:c2::/63,Azure 2603:1030:804:c4::/62,Azure 2603:1030:804:c8::/61,Azure 2603:1030:804:d0::/60,Azure 2603:1030:804:e0::/60,Azure
-	2603:1030:804:f0::/61,Azure 2603:1030:804:f8::/62,Azure 2603:1030:804:fc::/63,Azure 2603:1030:804:ff::/64,Azure 2603:1030:804:102::/63,Azure 2603:1030:804:104::/62,Azure
-	2603:1030:804:108::/62,Azure 2603:1030:804:10f::/64,Azure 2603:1030:804:110::/64,Azure 2603:1030:804:115::/64,Azure 2603:1030:804:116::/63,Azure 2603:1030:804:118::/61,Azure
-	2603:1030:804:120::/62,Azure 2603:1030:804:12d::/64,Azure 2603:1030:804:12e::/63,Azure 2603:1030:804:130::/60,Azure 2603:1030:804:140::/60,Azure 2603:1030:804:150::/61,Azure
-	2603:1030:804:158::/62,Azure 2603:1030:804:15c::/64,Azure 2603:1030:804:161::/64,Azure 2603:1030:804:162::/63,Azure 2603:1030:804:164::/62,Azure 2603:1030:804:168::/64,Azure
-	2603:1030:804:16d::/64,Azure 2603:1030:804:16e::/64,Azure 2603:1030:804:171::/64,Azure 2603:1030:804:172::/63,Azure 2603:1030:804:174::/62,Azure 2603:1030:804:178::/61,Azure
-	2603:1030:804:180::/63,Azure 2603:1030:804:182::/64,Azure 2603:1030:804:185::/64,Azure 2603:1030:804:186::/63,Azure 2603:1030:804:188::/61,Azure 2603:1030:804:190::/60,Azure
-	2603:1030:804:1a0::/60,Azure 2603:1030:804:1b0::/61,Azure 2603:1030:804:1b8::/64,Azure 2603:1030:804:1c1::/64,Azure 2603:1030:804:1c2::/63,Azure 2603:1030:804:1c4::/62,Azure
-	2603:1030:804:1c8::/61,Azure 2603:1030:804:1d0::/60,Azure 2603:1030:804:1e0::/64,Azure 2603:1030:804:1e3::/64,Azure 2603:1030:804:1e4::/64,Azure 2603:1030:804:1e8::/61,Azure
-	2603:1030:804:1f0::/60,Azure 2603:1030:804:200::/59,Azure 2603:1030:804:220::/60,Azure 2603:1030:804:230::/61,Azure 2603:1030:804:238::/63,Azure 2603:1030:804:23a::/64,Azure
-	2603:1030:804:23f::/64,Azure 2603:1030:804:240::/63,Azure 2603:1030:804:247::/64,Azure 2603:1030:804:248::/64,Azure 2603:1030:804:250::/63,Azure 2603:1030:804:254::/62,Azure
-	2603:1030:804:258::/63,Azure 2603:1030:804:25c::/64,Azure 2603:1030:804:263::/64,Azure 2603:1030:804:264::/62,Azure 2603:1030:804:268::/62,Azure 2603:1030:804:26e::/63,Azure
-	2603:1030:804:270::/61,Azure 2603:1030:804:278::/62,Azure 2603:1030:804:27c::/63,Azure 2603:1030:804:27e::/64,Azure 2603:1030:804:283::/64,Azure 2603:1030:804:284::/62,Azure
-	2603:1030:804:288::/61,Azure 2603:1030:804:292::/63,Azure 2603:1030:804:294::/62,Azure 2603:1030:804:298::/62,Azure 2603:1030:804:29c::/63,Azure 2603:1030:804:2a0::/60,Azure
-	2603:1030:804:2b0::/61,Azure 2603:1030:804:2b8::/62,Azure 2603:1030:804:2bc::/63,Azure 2603:1030:804:2be::/64,Azure 2603:1030:804:2c4::/63,Azure 2603:1030:804:2c6::/64,Azure
-	2603:1030:804:2c9::/64,Azure 2603:1030:804:2cd::/64,Azure 2603:1030:804:2ce::/63,Azure 2603:1030:804:2d9::/64,Azure 2603:1030:804:2dc::/64,Azure 2603:1030:804:2e0::/63,Azure
-	2603:1030:804:2e5::/64,Azure 2603:1030:804:2e6::/63,Azure 2603:1030:804:2ec::/64,Azure 2603:1030:804:2f0::/64,Azure 2603:1030:804:2f4::/64,Azure 2603:1030:804:2f7::/64,Azure
-	2603:1030:804:2f8::/62,Azure 2603:1030:804:2fc::/63,Azure 2603:1030:804:305::/64,Azure 2603:1030:804:30b::/64,Azure 2603:1030:804:30f::/64,Azure 2603:1030:804:310::/63,Azure
-	2603:1030:804:312::/64,Azure 2603:1030:804:31c::/62,Azure 2603:1030:804:320::/59,Azure 2603:1030:804:340::/58,Azure 2603:1030:804:380::/59,Azure 2603:1030:804:3a0::/61,Azure
-	2603:1030:804:3a8::/64,Azure 2603:1030:804:3ab::/64,Azure 2603:1030:804:3ac::/64,Azure 2603:1030:804:3af::/64,Azure 2603:1030:804:3b0::/60,Azure 2603:1030:804:3c0::/60,Azure
-	2603:1030:804:3d0::/61,Azure 2603:1030:804:3d8::/64,Azure 2603:1030:804:3dd::/64,Azure 2603:1030:804:3de::/63,Azure 2603:1030:804:3e0::/59,Azure 2603:1030:804:400::/56,Azure
-	2603:1030:804:500::/60,Azure 2603:1030:804:514::/62,Azure 2603:1030:804:518::/61,Azure 2603:1030:804:520::/59,Azure 2603:1030:804:540::/58,Azure 2603:1030:804:580::/57,Azure
-	2603:1030:804:600::/57,Azure 2603:1030:804:680::/62,Azure 2603:1030:805::/48,Azure 2603:1030:806::/47,Azure 2603:1030:809::/48,Azure 2603:1030:80a::/56,Azure
-	2603:1030:80b::/48,Azure 2603:1030:80c::/47,Azure 2603:1030:901::/48,Azure 2603:1030:902::/47,Azure 2603:1030:904::/47,Azure 2603:1030:a00::/46,Azure
-	2603:1030:a04::/48,Azure 2603:1030:a06::/47,Azure 2603:1030:a08::/48,Azure 2603:1030:a09::/56,Azure 2603:1030:a09:100::/63,Azure 2603:1030:a0a::/47,Azure
-	2603:1030:a0c::/47,Azure 2603:1030:b00::/47,Azure 2603:1030:b03::/48,Azure 2603:1030:b04::/47,Azure 2603:1030:b06::/48,Azure 2603:1030:b07::/56,Azure
-	2603:1030:b08::/48,Azure 2603:1030:b80::/48,Azure 2603:1030:c00::/48,Azure 2603:1030:c02::/47,Azure 2603:1030:c04::/46,Azure 2603:1030:c80::/56,Azure
-	2603:1030:d00::/47,Azure 2603:1030:d80::/48,Azure 2603:1030:e01::/64,Azure 2603:1030:e01:2::/64,Azure 2603:1030:e03::/48,Azure 2603:1030:f00::/47,Azure
-	2603:1030:f02::/48,Azure 2603:1030:f04::/47,Azure 2603:1030:f06::/48,Azure 2603:1030:f07::/56,Azure 2603:1030:f08::/47,Azure 2603:1030:1000::/47,Azure
-	2603:1030:1002::/48,Azure 2603:1030:1004::/46,Azure 2603:1030:1101::/48,Azure 2603:1030:1102::/47,Azure 2603:1030:1104::/47,Azure 2603:1030:1201::/48,Azure
-	2603:1030:1202::/47,Azure 2603:1030:1204::/47,Azure 2603:1030:1301::/48,Azure 2603:1030:1302::/47,Azure 2603:1030:1304::/47,Azure 2603:1030:1401::/48,Azure
-	2603:1030:1402::/47,Azure 2603:1030:1404::/47,Azure 2603:1030:1406::/63,Azure 2603:1030:1501::/48,Azure 2603:1030:1502::/47,Azure 2603:1030:1504::/47,Azure
-	2603:1030:1601::/48,Azure 2603:1030:1602::/47,Azure 2603:1030:1604::/47,Azure 2603:1030:1701::/48,Azure 2603:1030:1702::/47,Azure 2603:1030:1704::/47,Azure
-	2603:1036:903::/62,Azure 2603:1036:903:4::/64,Azure 2603:1036:903:6::/63,Azure 2603:1036:903:8::/61,Azure 2603:1036:903:10::/60,Azure 2603:1036:903:20::/60,Azure
-	2603:1036:903:30::/62,Azure 2603:1036:903:34::/64,Azure 2603:1036:903:36::/63,Azure 2603:1036:903:38::/63,Azure 2603:1036:903:3d::/64,Azure 2603:1036:903:3e::/63,Azure
-	2603:1036:903:40::/61,Azure 2603:1036:903:48::/63,Azure 2603:1036:90c::/62,Azure 2603:1036:90c:4::/63,Azure 2603:1036:90c:6::/64,Azure 2603:1036:9ff:ffff::/64,Azure
-	2603:1036:d20::/64,Azure 2603:1036:120d::/48,Azure 2603:1036:2400::/44,Azure 2603:1036:2410::/47,Azure 2603:1036:2500::/64,Azure 2603:1036:2500:4::/64,Azure
+	2600:f0f0:0:100::-2600:f0f0:0:21f:ffff:ffff:ffff:ffff,AWS 2600:f0f0:0:300::/56,AWS 2600:f0f0:0:d00::/56,AWS 2600:f0f0:1:a00::/56,AWS 2600:f0f0:1:f00::-2600:f0f0:1:11ff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:1:1300::-2600:f0f0:1:14ff:ffff:ffff:ffff:ffff,AWS
+	2600:f0f0:1:1600::-2600:f0f0:1:18ff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:1:1a00::-2600:f0f0:1:1cff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:1:1e00::-2600:f0f0:1:22ff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:1:fd00::-2600:f0f0:2:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:4::/47,AWS 2600:f0f0:7::-2600:f0f0:8:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:f0f0:a::/47,AWS 2600:f0f0:10::/47,AWS 2600:f0f0:20::/48,AWS 2600:f0f0:22::/47,AWS 2600:f0f0:30::-2600:f0f0:40:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:60::-2600:f0f0:87:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:f0f0:90:1400::/56,AWS 2600:f0f0:91::/48,AWS 2600:f0f0:a0::/48,AWS 2600:f0f0:c0::/44,AWS 2600:f0f0:300:100::/56,AWS 2600:f0f0:30e::-2600:f0f0:310:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:f0f0:400::/44,AWS 2600:f0f0:500::/48,AWS 2600:f0f0:600::/54,AWS 2600:f0f0:601::-2600:f0f0:603:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:700::-2600:f0f0:702:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:720::-2600:f0f0:722:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:f0f0:730::/47,AWS 2600:f0f0:cfb::-2600:f0f0:cff:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:e00::-2600:f0f0:e0a:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:e0d::/48,AWS 2600:f0f0:e0f::/48,AWS 2600:f0f0:e11::-2600:f0f0:e20:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:f0f0:e24::/48,AWS 2600:f0f0:e26::-2600:f0f0:e38:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:f00::-2600:f0f0:f00:5ff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:1000::/44,AWS 2600:f0f0:1100::/40,AWS 2600:f0f0:4000::/48,AWS
+	2600:f0f0:4100::-2600:f0f0:42ff:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:5400::-2600:f0f0:5409:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:5500::/48,AWS 2600:f0f0:5502::-2600:f0f0:5507:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:5510::-2600:f0f0:553f:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:6000::/43,AWS
+	2600:f0f0:6025::-2600:f0f0:6027:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:6100::-2600:f0f0:6126:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:8100::-2600:f0f0:8110:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:8120::/48,AWS 2600:f0f0:8130::/48,AWS 2600:f0f0:8140::/48,AWS
+	2600:f0f0:8150::/48,AWS 2600:f0f0:8160::/48,AWS 2600:f0f0:8170::/48,AWS 2600:f0f0:8180::-2600:f0f0:8186:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:c000::-2600:f0f0:c005:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:c040::-2600:f0f0:c049:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:f0f0:c102::/48,AWS 2600:f0f0:c106::/47,AWS 2600:f0f0:c109::-2600:f0f0:c10f:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:c111::-2600:f0f0:c11c:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:c11f::-2600:f0f0:c12b:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:c12d::-2600:f0f0:c133:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:f0f0:c135::-2600:f0f0:c140:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f0:c143::-2600:f0f0:c14d:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f1:10::-2600:f0f1:1b:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f1:80::/44,AWS 2600:f0f1:4000::/41,AWS 2600:f0f1:40c0::-2600:f0f1:45bf:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:f0f1:4840::/42,AWS 2600:f0f1:8801::-2600:f0f1:8802:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f1:8a00::-2600:f0f1:8a10:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f1:8b00::/46,AWS 2600:f0f2:7000::-2600:f0f2:7023:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0f2:7025::/48,AWS
+	2600:f0f2:7028::/47,AWS 2600:f0f2:7100::/40,AWS 2600:f0f2:7400::/38,AWS 2600:f0f3:f000::-2600:f0f3:f010:ff:ffff:ffff:ffff:ffff,AWS 2600:f0f3:f010:200::/55,AWS 2600:f0f3:f010:2a00::/56,AWS
+	2600:f0f3:f010:2c00::/56,AWS 2600:f0f3:f010:3400::/56,AWS 2600:f0f3:f010:3800::/56,AWS 2600:f0f3:f010:3a00::/56,AWS 2600:f0fb:8000::/40,AWS 2600:f0fb:c000::-2600:f0fb:c002:ff:ffff:ffff:ffff:ffff,AWS
+	2600:f0fb:c003::/48,AWS 2600:f0fb:c0ff::/56,AWS 2600:f0fb:c800::/48,AWS 2600:f0fb:c900::/52,AWS 2600:f0fb:ca00::/52,AWS 2600:f0fb:e000::-2600:f0fb:f00f:ffff:ffff:ffff:ffff:ffff,AWS
+	2600:f0fb:f020::-2600:f0fb:f029:ffff:ffff:ffff:ffff:ffff,AWS 2600:f0fb:f100::-2600:f0fb:f111:ffff:ffff:ffff:ffff:ffff,AWS 2602:f7db::/44,AWS 2602:fd5e:1::-2602:fd5e:1:2:ffff:ffff:ffff:ffff,Azure 2602:fd5e:2::/57,Azure 2603:1000::/47,Azure
+	2603:1000:3::-2603:1000:6:ffff:ffff:ffff:ffff:ffff,Azure 2603:1000:100::/47,Azure 2603:1000:103::-2603:1000:106:ffff:ffff:ffff:ffff:ffff,Azure 2603:1006:1400::/47,Azure 2603:1006:1500::/64,Azure 2603:1006:1500:4::/64,Azure
+	2603:1006:2000::/48,Azure 2603:1007:200::/48,Azure 2603:1010::/46,Azure 2603:1010:5::-2603:1010:8:ffff:ffff:ffff:ffff:ffff,Azure 2603:1010:40::/48,Azure 2603:1010:80::/56,Azure
+	2603:1010:100::-2603:1010:202:ffff:ffff:ffff:ffff:ffff,Azure 2603:1010:204::/46,Azure 2603:1010:300::/47,Azure 2603:1010:303::-2603:1010:306:ffff:ffff:ffff:ffff:ffff,Azure 2603:1010:400::/47,Azure 2603:1010:403::-2603:1010:406:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1010:501::-2603:1010:505:ffff:ffff:ffff:ffff:ffff,Azure 2603:1016:1400::/48,Azure 2603:1016:2400::/46,Azure 2603:1016:2500::/64,Azure 2603:1016:2500:4::/64,Azure 2603:1016:2500:8::/64,Azure
+	2603:1016:2500:c::/64,Azure 2603:1017::/48,Azure 2603:1020::-2603:1020:2:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:4::/46,Azure 2603:1020:100::/47,Azure 2603:1020:103::-2603:1020:104:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1020:106::/48,Azure 2603:1020:200::/46,Azure 2603:1020:205::-2603:1020:208:ff:ffff:ffff:ffff:ffff,Azure 2603:1020:209::/48,Azure 2603:1020:300::-2603:1020:302:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:305::/48,Azure
+	2603:1020:401::-2603:1020:402:1:ffff:ffff:ffff:ffff,Azure 2603:1020:405::-2603:1020:406::13,Azure 2603:1020:406::15-2603:1020:406::18,Azure 2603:1020:500::/47,Azure 2603:1020:503::-2603:1020:504:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:600::-2603:1020:602:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1020:604::/46,Azure 2603:1020:700::-2603:1020:702:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:704::/46,Azure 2603:1020:800::-2603:1020:802:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:804::/46,Azure 2603:1020:900::-2603:1020:902:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1020:904::/46,Azure 2603:1020:a00::/47,Azure 2603:1020:a03::-2603:1020:a06:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:b00::/47,Azure 2603:1020:b03::-2603:1020:b06:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:c00::/47,Azure
+	2603:1020:c03::-2603:1020:c05:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:c07::/48,Azure 2603:1020:c40::/48,Azure 2603:1020:c80::/56,Azure 2603:1020:d00::/47,Azure 2603:1020:d03::-2603:1020:d06:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1020:e00::/47,Azure 2603:1020:e03::-2603:1020:e06:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:f00::/47,Azure 2603:1020:f03::-2603:1020:f06:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:1000::/47,Azure 2603:1020:1003::-2603:1020:1005:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1020:1007::/48,Azure 2603:1020:1100::/47,Azure 2603:1020:1103::-2603:1020:1107:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:1109::/48,Azure 2603:1020:1200::/47,Azure 2603:1020:1204::-2603:1020:1206:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1020:1300::/46,Azure 2603:1020:1305::/48,Azure 2603:1020:1402::-2603:1020:1406:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:1501::-2603:1020:1505:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:1601::-2603:1020:1605:ffff:ffff:ffff:ffff:ffff,Azure 2603:1020:1700::-2603:1020:1704:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1026:800::-2603:1026:800:2:ffff:ffff:ffff:ffff,Azure 2603:1026:900::-2603:1026:900:29:ffff:ffff:ffff:ffff,Azure 2603:1026:900:2b::-2603:1026:900:54:ffff:ffff:ffff:ffff,Azure 2603:1026:2400::-2603:1026:2403:1:ffff:ffff:ffff:ffff,Azure 2603:1026:2404::-2603:1026:240f:1:ffff:ffff:ffff:ffff,Azure 2603:1026:2410::/46,Azure
+	2603:1026:2415::/48,Azure 2603:1026:2500::/64,Azure 2603:1026:2500:4::/64,Azure 2603:1026:2500:8::/64,Azure 2603:1026:2500:c::/64,Azure 2603:1026:2500:10::/64,Azure
+	2603:1026:2500:14::/64,Azure 2603:1026:2500:18::/64,Azure 2603:1026:2500:1c::/64,Azure 2603:1026:2500:20::/64,Azure 2603:1026:2500:24::/64,Azure 2603:1026:2500:28::/64,Azure
+	2603:1026:2500:2c::/64,Azure 2603:1026:2500:30::/64,Azure 2603:1026:2500:34::/64,Azure 2603:1026:2500:3c::/64,Azure 2603:1026:3000::/48,Azure 2603:1027:1::/48,Azure
+	2603:1030::-2603:1030:9:21:ffff:ffff:ffff:ffff,Azure 2603:1030:9:27::-2603:1030:9:41:ffff:ffff:ffff:ffff,Azure 2603:1030:9:47::-2603:1030:9:4a:ffff:ffff:ffff:ffff,Azure 2603:1030:9:51::-2603:1030:9:9a:ffff:ffff:ffff:ffff,Azure 2603:1030:9:a1::-2603:1030:9:c1:ffff:ffff:ffff:ffff,Azure 2603:1030:9:c8::/61,Azure
+	2603:1030:9:d6::-2603:1030:9:2d9:ffff:ffff:ffff:ffff,Azure 2603:1030:9:2de::-2603:1030:9:2e1:ffff:ffff:ffff:ffff,Azure 2603:1030:9:2e8::-2603:1030:9:33c:ffff:ffff:ffff:ffff,Azure 2603:1030:9:345::-2603:1030:9:349:ffff:ffff:ffff:ffff,Azure 2603:1030:9:34b::-2603:1030:9:3b9:ffff:ffff:ffff:ffff,Azure 2603:1030:9:3bc::-2603:1030:9:3c5:ffff:ffff:ffff:ffff,Azure
+	2603:1030:9:3c8::-2603:1030:9:3d5:ffff:ffff:ffff:ffff,Azure 2603:1030:9:3d9::-2603:1030:9:3f5:ffff:ffff:ffff:ffff,Azure 2603:1030:9:3f8::/61,Azure 2603:1030:9:402::-2603:1030:9:405:ffff:ffff:ffff:ffff,Azure 2603:1030:9:408::-2603:1030:9:5be:ffff:ffff:ffff:ffff,Azure 2603:1030:9:5c3::-2603:1030:9:660:ffff:ffff:ffff:ffff,Azure
+	2603:1030:9:664::-2603:1030:9:669:ffff:ffff:ffff:ffff,Azure 2603:1030:9:66d::-2603:1030:9:66f:ffff:ffff:ffff:ffff,Azure 2603:1030:9:673::-2603:1030:9:721:ffff:ffff:ffff:ffff,Azure 2603:1030:9:734::-2603:1030:9:86d:ffff:ffff:ffff:ffff,Azure 2603:1030:9:870::-2603:1030:9:8a8:ffff:ffff:ffff:ffff,Azure 2603:1030:a::/47,Azure
+	2603:1030:d::-2603:1030:13:203:ffff:ffff:ffff:ffff,Azure 2603:1030:14::/48,Azure 2603:1030:100::/60,Azure 2603:1030:100:12::-2603:1030:100:17:ffff:ffff:ffff:ffff,Azure 2603:1030:100:1a::-2603:1030:100:24:ffff:ffff:ffff:ffff,Azure 2603:1030:100:26::-2603:1030:100:29:ffff:ffff:ffff:ffff,Azure
+	2603:1030:100:2b::-2603:1030:100:2e:ffff:ffff:ffff:ffff,Azure 2603:1030:100:30::-2603:1030:100:4f:ffff:ffff:ffff:ffff,Azure 2603:1030:101::/48,Azure 2603:1030:103::-2603:1030:109:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:20c::-2603:1030:20e:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:210::-2603:1030:212:ff:ffff:ffff:ffff:ffff,Azure
+	2603:1030:213::-2603:1030:214:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:301::-2603:1030:303:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:400::-2603:1030:401:13:ffff:ffff:ffff:ffff,Azure 2603:1030:401:16::-2603:1030:401:28:ffff:ffff:ffff:ffff,Azure 2603:1030:401:2c::/64,Azure 2603:1030:401:2f::-2603:1030:401:56:ffff:ffff:ffff:ffff,Azure
+	2603:1030:401:59::-2603:1030:401:64:ffff:ffff:ffff:ffff,Azure 2603:1030:401:6b::-2603:1030:401:8f:ffff:ffff:ffff:ffff,Azure 2603:1030:401:92::-2603:1030:401:ae:ffff:ffff:ffff:ffff,Azure 2603:1030:401:b1::-2603:1030:401:c4:ffff:ffff:ffff:ffff,Azure 2603:1030:401:c7::-2603:1030:401:e6:ffff:ffff:ffff:ffff,Azure 2603:1030:401:e9::-2603:1030:401:1a9:ffff:ffff:ffff:ffff,Azure
+	2603:1030:401:1ac::-2603:1030:401:1b5:ffff:ffff:ffff:ffff,Azure 2603:1030:401:1b8::-2603:1030:401:210:ffff:ffff:ffff:ffff,Azure 2603:1030:401:213::-2603:1030:401:21a:ffff:ffff:ffff:ffff,Azure 2603:1030:401:21d::-2603:1030:401:2bc:ffff:ffff:ffff:ffff,Azure 2603:1030:401:2bf::-2603:1030:401:2c8:ffff:ffff:ffff:ffff,Azure 2603:1030:401:2cb::-2603:1030:401:2d0:ffff:ffff:ffff:ffff,Azure
+	2603:1030:401:2d3::-2603:1030:401:2dc:ffff:ffff:ffff:ffff,Azure 2603:1030:401:2df::-2603:1030:401:2e4:ffff:ffff:ffff:ffff,Azure 2603:1030:401:2e7::-2603:1030:401:2f3:ffff:ffff:ffff:ffff,Azure 2603:1030:401:2f7::-2603:1030:401:315:ffff:ffff:ffff:ffff,Azure 2603:1030:401:318::-2603:1030:401:328:ffff:ffff:ffff:ffff,Azure 2603:1030:401:32b::-2603:1030:401:35c:ffff:ffff:ffff:ffff,Azure
+	2603:1030:401:35f::-2603:1030:401:414:ffff:ffff:ffff:ffff,Azure 2603:1030:401:417::-2603:1030:401:438:ffff:ffff:ffff:ffff,Azure 2603:1030:401:43b::-2603:1030:401:43f:ffff:ffff:ffff:ffff,Azure 2603:1030:401:444::-2603:1030:401:46b:ffff:ffff:ffff:ffff,Azure 2603:1030:401:470::-2603:1030:401:4a1:ffff:ffff:ffff:ffff,Azure 2603:1030:401:4a4::-2603:1030:401:518:ffff:ffff:ffff:ffff,Azure
+	2603:1030:401:51b::-2603:1030:401:52f:ffff:ffff:ffff:ffff,Azure 2603:1030:401:532::/63,Azure 2603:1030:401:536::-2603:1030:401:53d:ffff:ffff:ffff:ffff,Azure 2603:1030:401:540::-2603:1030:401:553:ffff:ffff:ffff:ffff,Azure 2603:1030:401:557::-2603:1030:401:559:ffff:ffff:ffff:ffff,Azure 2603:1030:401:55d::-2603:1030:401:561:ffff:ffff:ffff:ffff,Azure
+	2603:1030:401:564::-2603:1030:401:570:ffff:ffff:ffff:ffff,Azure 2603:1030:401:573::-2603:1030:401:579:ffff:ffff:ffff:ffff,Azure 2603:1030:401:57c::/63,Azure 2603:1030:401:580::-2603:1030:401:588:ffff:ffff:ffff:ffff,Azure 2603:1030:401:58b::-2603:1030:401:58e:ffff:ffff:ffff:ffff,Azure 2603:1030:401:590::/64,Azure
+	2603:1030:401:592::/64,Azure 2603:1030:401:594::-2603:1030:401:598:ffff:ffff:ffff:ffff,Azure 2603:1030:401:59c::/64,Azure 2603:1030:401:59e::-2603:1030:401:5a4:ffff:ffff:ffff:ffff,Azure 2603:1030:401:5a6::-2603:1030:401:5be:ffff:ffff:ffff:ffff,Azure 2603:1030:401:5c3::-2603:1030:401:66c:ffff:ffff:ffff:ffff,Azure
+	2603:1030:401:66e::-2603:1030:401:672:ffff:ffff:ffff:ffff,Azure 2603:1030:401:674::-2603:1030:401:678:ffff:ffff:ffff:ffff,Azure 2603:1030:401:67a::-2603:1030:401:67d:ffff:ffff:ffff:ffff,Azure 2603:1030:401:67f::-2603:1030:401:6eb:ffff:ffff:ffff:ffff,Azure 2603:1030:401:6ee::/63,Azure 2603:1030:401:6f1::-2603:1030:401:70b:ffff:ffff:ffff:ffff,Azure
+	2603:1030:401:70d::-2603:1030:401:77e:ffff:ffff:ffff:ffff,Azure 2603:1030:401:780::/64,Azure 2603:1030:401:783::-2603:1030:401:7f2:ffff:ffff:ffff:ffff,Azure 2603:1030:401:7f5::-2603:1030:401:829:ffff:ffff:ffff:ffff,Azure 2603:1030:401:82c::/64,Azure 2603:1030:401:82e::-2603:1030:401:83d:ffff:ffff:ffff:ffff,Azure
+	2603:1030:401:83f::-2603:1030:401:89f:ffff:ffff:ffff:ffff,Azure 2603:1030:401:8a1::-2603:1030:401:8ea:ffff:ffff:ffff:ffff,Azure 2603:1030:401:8ee::-2603:1030:401:90c:ffff:ffff:ffff:ffff,Azure 2603:1030:401:90e::-2603:1030:401:924:ffff:ffff:ffff:ffff,Azure 2603:1030:401:926::-2603:1030:401:b18:ffff:ffff:ffff:ffff,Azure 2603:1030:402::/47,Azure
+	2603:1030:405::-2603:1030:40a:9:ffff:ffff:ffff:ffff,Azure 2603:1030:40a:10::/64,Azure 2603:1030:40b::-2603:1030:40d:f:ffff:ffff:ffff:ffff,Azure 2603:1030:40d:4000::-2603:1030:40e:1ff:ffff:ffff:ffff:ffff,Azure 2603:1030:40f::-2603:1030:412:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:500::/47,Azure
+	2603:1030:503::-2603:1030:505:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:507::/48,Azure 2603:1030:600::-2603:1030:605:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:607::-2603:1030:60a:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:701::-2603:1030:705:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:800::/48,Azure
+	2603:1030:802::-2603:1030:804:2:ffff:ffff:ffff:ffff,Azure 2603:1030:804:6::-2603:1030:804:22:ffff:ffff:ffff:ffff,Azure 2603:1030:804:26::-2603:1030:804:2b:ffff:ffff:ffff:ffff,Azure 2603:1030:804:35::-2603:1030:804:38:ffff:ffff:ffff:ffff,Azure 2603:1030:804:3f::-2603:1030:804:41:ffff:ffff:ffff:ffff,Azure 2603:1030:804:47::-2603:1030:804:bd:ffff:ffff:ffff:ffff,Azure
+	2603:1030:804:c2::-2603:1030:804:fd:ffff:ffff:ffff:ffff,Azure 2603:1030:804:ff::/64,Azure 2603:1030:804:102::-2603:1030:804:10b:ffff:ffff:ffff:ffff,Azure 2603:1030:804:10f::-2603:1030:804:110:ffff:ffff:ffff:ffff,Azure 2603:1030:804:115::-2603:1030:804:123:ffff:ffff:ffff:ffff,Azure 2603:1030:804:12d::-2603:1030:804:15c:ffff:ffff:ffff:ffff,Azure
+	2603:1030:804:161::-2603:1030:804:168:ffff:ffff:ffff:ffff,Azure 2603:1030:804:16d::-2603:1030:804:16e:ffff:ffff:ffff:ffff,Azure 2603:1030:804:171::-2603:1030:804:182:ffff:ffff:ffff:ffff,Azure 2603:1030:804:185::-2603:1030:804:1b8:ffff:ffff:ffff:ffff,Azure 2603:1030:804:1c1::-2603:1030:804:1e0:ffff:ffff:ffff:ffff,Azure 2603:1030:804:1e3::-2603:1030:804:1e4:ffff:ffff:ffff:ffff,Azure
+	2603:1030:804:1e8::-2603:1030:804:23a:ffff:ffff:ffff:ffff,Azure 2603:1030:804:23f::-2603:1030:804:241:ffff:ffff:ffff:ffff,Azure 2603:1030:804:247::-2603:1030:804:248:ffff:ffff:ffff:ffff,Azure 2603:1030:804:250::/63,Azure 2603:1030:804:254::-2603:1030:804:259:ffff:ffff:ffff:ffff,Azure 2603:1030:804:25c::/64,Azure
+	2603:1030:804:263::-2603:1030:804:26b:ffff:ffff:ffff:ffff,Azure 2603:1030:804:26e::-2603:1030:804:27e:ffff:ffff:ffff:ffff,Azure 2603:1030:804:283::-2603:1030:804:28f:ffff:ffff:ffff:ffff,Azure 2603:1030:804:292::-2603:1030:804:29d:ffff:ffff:ffff:ffff,Azure 2603:1030:804:2a0::-2603:1030:804:2be:ffff:ffff:ffff:ffff,Azure 2603:1030:804:2c4::-2603:1030:804:2c6:ffff:ffff:ffff:ffff,Azure
+	2603:1030:804:2c9::/64,Azure 2603:1030:804:2cd::-2603:1030:804:2cf:ffff:ffff:ffff:ffff,Azure 2603:1030:804:2d9::/64,Azure 2603:1030:804:2dc::/64,Azure 2603:1030:804:2e0::/63,Azure 2603:1030:804:2e5::-2603:1030:804:2e7:ffff:ffff:ffff:ffff,Azure
+	2603:1030:804:2ec::/64,Azure 2603:1030:804:2f0::/64,Azure 2603:1030:804:2f4::/64,Azure 2603:1030:804:2f7::-2603:1030:804:2fd:ffff:ffff:ffff:ffff,Azure 2603:1030:804:305::/64,Azure 2603:1030:804:30b::/64,Azure
+	2603:1030:804:30f::-2603:1030:804:312:ffff:ffff:ffff:ffff,Azure 2603:1030:804:31c::-2603:1030:804:3a8:ffff:ffff:ffff:ffff,Azure 2603:1030:804:3ab::-2603:1030:804:3ac:ffff:ffff:ffff:ffff,Azure 2603:1030:804:3af::-2603:1030:804:3d8:ffff:ffff:ffff:ffff,Azure 2603:1030:804:3dd::-2603:1030:804:50f:ffff:ffff:ffff:ffff,Azure 2603:1030:804:514::-2603:1030:804:683:ffff:ffff:ffff:ffff,Azure
+	2603:1030:805::-2603:1030:807:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:809::-2603:1030:80a:ff:ffff:ffff:ffff:ffff,Azure 2603:1030:80b::-2603:1030:80d:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:901::-2603:1030:905:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:a00::-2603:1030:a04:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:a06::-2603:1030:a09:101:ffff:ffff:ffff:ffff,Azure
+	2603:1030:a0a::-2603:1030:a0d:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:b00::/47,Azure 2603:1030:b03::-2603:1030:b07:ff:ffff:ffff:ffff:ffff,Azure 2603:1030:b08::/48,Azure 2603:1030:b80::/48,Azure 2603:1030:c00::/48,Azure
+	2603:1030:c02::-2603:1030:c07:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:c80::/56,Azure 2603:1030:d00::/47,Azure 2603:1030:d80::/48,Azure 2603:1030:e01::/64,Azure 2603:1030:e01:2::/64,Azure
+	2603:1030:e03::/48,Azure 2603:1030:f00::-2603:1030:f02:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:f04::-2603:1030:f07:ff:ffff:ffff:ffff:ffff,Azure 2603:1030:f08::/47,Azure 2603:1030:1000::-2603:1030:1002:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:1004::/46,Azure
+	2603:1030:1101::-2603:1030:1105:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:1201::-2603:1030:1205:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:1301::-2603:1030:1305:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:1401::-2603:1030:1406:1:ffff:ffff:ffff:ffff,Azure 2603:1030:1501::-2603:1030:1505:ffff:ffff:ffff:ffff:ffff,Azure 2603:1030:1601::-2603:1030:1605:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1030:1701::-2603:1030:1705:ffff:ffff:ffff:ffff:ffff,Azure 2603:1036:903::-2603:1036:903:4:ffff:ffff:ffff:ffff,Azure 2603:1036:903:6::-2603:1036:903:34:ffff:ffff:ffff:ffff,Azure 2603:1036:903:36::-2603:1036:903:39:ffff:ffff:ffff:ffff,Azure 2603:1036:903:3d::-2603:1036:903:49:ffff:ffff:ffff:ffff,Azure 2603:1036:90c::-2603:1036:90c:6:ffff:ffff:ffff:ffff,Azure
+	2603:1036:9ff:ffff::/64,Azure 2603:1036:d20::/64,Azure 2603:1036:120d::/48,Azure 2603:1036:2400::-2603:1036:2411:ffff:ffff:ffff:ffff:ffff,Azure 2603:1036:2500::/64,Azure 2603:1036:2500:4::/64,Azure
 	2603:1036:2500:8::/64,Azure 2603:1036:2500:c::/64,Azure 2603:1036:2500:10::/64,Azure 2603:1036:2500:14::/64,Azure 2603:1036:2500:18::/63,Azure 2603:1036:2500:1c::/64,Azure
 	2603:1036:2500:20::/64,Azure 2603:1036:2500:24::/64,Azure 2603:1036:2500:28::/64,Azure 2603:1036:2500:2c::/64,Azure 2603:1036:2500:30::/64,Azure 2603:1036:2500:34::/64,Azure
-	2603:1036:2500:38::/64,Azure 2603:1036:2500:3c::/64,Azure 2603:1036:2500:40::/61,Azure 2603:1036:2500:48::/64,Azure 2603:1036:2500:60::/61,Azure 2603:1036:2500:68::/64,Azure
-	2603:1036:3000::/48,Azure 2603:1037:1::/48,Azure 2603:1039:205::/48,Azure 2603:1040::/47,Azure 2603:1040:2::/48,Azure 2603:1040:4::/47,Azure
-	2603:1040:6::/48,Azure 2603:1040:7::/56,Azure 2603:1040:8::/48,Azure 2603:1040:100::/46,Azure 2603:1040:200::/46,Azure 2603:1040:204::/48,Azure
-	2603:1040:206::/47,Azure 2603:1040:208::/47,Azure 2603:1040:400::/46,Azure 2603:1040:404::/48,Azure 2603:1040:406::/47,Azure 2603:1040:408::/47,Azure
-	2603:1040:600::/46,Azure 2603:1040:605::/48,Azure 2603:1040:606::/47,Azure 2603:1040:608::/48,Azure 2603:1040:800::/46,Azure 2603:1040:805::/48,Azure
-	2603:1040:806::/47,Azure 2603:1040:808::/48,Azure 2603:1040:900::/47,Azure 2603:1040:903::/48,Azure 2603:1040:904::/47,Azure 2603:1040:906::/48,Azure
-	2603:1040:a00::/46,Azure 2603:1040:a05::/48,Azure 2603:1040:a06::/47,Azure 2603:1040:a08::/48,Azure 2603:1040:b00::/47,Azure 2603:1040:b03::/48,Azure
-	2603:1040:b04::/47,Azure 2603:1040:b06::/48,Azure 2603:1040:c00::/46,Azure 2603:1040:c05::/48,Azure 2603:1040:c06::/47,Azure 2603:1040:c08::/48,Azure
-	2603:1040:d00::/47,Azure 2603:1040:d03::/48,Azure 2603:1040:d04::/47,Azure 2603:1040:e00::/47,Azure 2603:1040:e02::/48,Azure 2603:1040:e04::/46,Azure
-	2603:1040:f00::/47,Azure 2603:1040:f02::/48,Azure 2603:1040:f04::/47,Azure 2603:1040:f06::/48,Azure 2603:1040:f08::/48,Azure 2603:1040:1001::/48,Azure
-	2603:1040:1002::/47,Azure 2603:1040:1004::/48,Azure 2603:1040:1006::/48,Azure 2603:1040:1100::/47,Azure 2603:1040:1103::/48,Azure 2603:1040:1104::/47,Azure
-	2603:1040:1201::/48,Azure 2603:1040:1202::/47,Azure 2603:1040:1204::/48,Azure 2603:1040:1206::/48,Azure 2603:1040:1301::/48,Azure 2603:1040:1302::/47,Azure
-	2603:1040:1304::/48,Azure 2603:1040:1306::/48,Azure 2603:1040:1401::/48,Azure 2603:1040:1402::/47,Azure 2603:1040:1404::/48,Azure 2603:1040:1406::/48,Azure
-	2603:1040:1502::/47,Azure 2603:1040:1504::/47,Azure 2603:1040:1506::/48,Azure 2603:1040:1601::/48,Azure 2603:1040:1602::/47,Azure 2603:1040:1604::/47,Azure
-	2603:1040:1701::/48,Azure 2603:1040:1702::/47,Azure 2603:1040:1704::/47,Azure 2603:1040:1706::/64,Azure 2603:1040:1801::/48,Azure 2603:1040:1802::/47,Azure
-	2603:1040:1804::/48,Azure 2603:1040:1806::/48,Azure 2603:1040:1901::/48,Azure 2603:1040:1902::/47,Azure 2603:1040:1904::/47,Azure 2603:1040:1a01::/48,Azure
-	2603:1040:1a02::/47,Azure 2603:1040:1a04::/47,Azure 2603:1040:1b01::/48,Azure 2603:1040:1b02::/47,Azure 2603:1040:1b04::/47,Azure 2603:1046:a00::/59,Azure
-	2603:1046:a00:20::/60,Azure 2603:1046:a00:30::/61,Azure 2603:1046:a00:38::/63,Azure 2603:1046:a00:3b::/64,Azure 2603:1046:a00:3c::/62,Azure 2603:1046:a00:40::/59,Azure
-	2603:1046:a00:60::/62,Azure 2603:1046:1400::/44,Azure 2603:1046:1500::/64,Azure 2603:1046:1500:4::/64,Azure 2603:1046:1500:8::/64,Azure 2603:1046:1500:c::/64,Azure
-	2603:1046:1500:10::/64,Azure 2603:1046:1500:14::/64,Azure 2603:1046:1500:18::/64,Azure 2603:1046:1500:1c::/64,Azure 2603:1046:1500:20::/64,Azure 2603:1046:1500:24::/64,Azure
-	2603:1046:1500:28::/64,Azure 2603:1046:1500:2c::/64,Azure 2603:1046:1500:30::/64,Azure 2603:1046:1500:34::/64,Azure 2603:1046:1500:44::/64,Azure 2603:1046:2000::/48,Azure
-	2603:1047:1::/48,Azure 2603:1050:1::/48,Azure 2603:1050:2::/47,Azure 2603:1050:5::/48,Azure 2603:1050:6::/47,Azure 2603:1050:9::/48,Azure
-	2603:1050:100::/40,Azure 2603:1050:300::/46,Azure 2603:1050:305::/48,Azure 2603:1050:400::/48,Azure 2603:1050:402::/47,Azure 2603:1050:404::/47,Azure
-	2603:1056:100::/61,Azure 2603:1056:100:8::/62,Azure 2603:1056:1400::/46,Azure 2603:1056:1500::/64,Azure 2603:1056:1500:4::/64,Azure 2603:1056:2000::/48,Azure
-	2603:1057:2::/48,Azure 2603:1061:f::/48,Azure 2603:1061:10::/47,Azure 2603:1061:12::/48,Azure 2603:1061:14::/47,Azure 2603:1061:16::/50,Azure
-	2603:1061:17::/48,Azure 2603:1061:1000::/46,Azure 2603:1061:1004::/57,Azure 2603:1061:1004:80::/58,Azure 2603:1061:1004:c0::/61,Azure 2603:1061:1004:c8::/63,Azure
-	2603:1061:1005::/57,Azure 2603:1061:1005:80::/59,Azure 2603:1061:1005:a0::/60,Azure 2603:1061:1005:b0::/61,Azure 2603:1061:1005:b8::/62,Azure 2603:1061:1006::/56,Azure
-	2603:1061:1006:100::/58,Azure 2603:1061:1006:140::/60,Azure 2603:1061:1006:150::/61,Azure 2603:1061:1006:158::/62,Azure 2603:1061:1006:15c::/63,Azure 2603:1061:1007::/57,Azure
-	2603:1061:1007:80::/58,Azure 2603:1061:1007:c0::/59,Azure 2603:1061:1007:e0::/60,Azure 2603:1061:1008::/57,Azure 2603:1061:1008:80::/59,Azure 2603:1061:1008:a0::/60,Azure
-	2603:1061:1008:b0::/61,Azure 2603:1061:1008:b8::/62,Azure 2603:1061:1008:c0::/58,Azure 2603:1061:1008:100::/59,Azure 2603:1061:1008:120::/61,Azure 2603:1061:1009::/58,Azure
-	2603:1061:100a::/62,Azure 2603:1061:100a:4::/63,Azure 2603:1061:100a:8::/62,Azure 2603:1061:100a:c::/63,Azure 2603:1061:100a:10::/62,Azure 2603:1061:100a:16::/63,Azure
-	2603:1061:100a:1a::/63,Azure 2603:1061:100a:1c::/62,Azure 2603:1061:100a:20::/59,Azure 2603:1061:100a:40::/61,Azure 2603:1061:100a:48::/62,Azure 2603:1061:100a:4c::/63,Azure
-	2603:1061:100a:4e::/64,Azure 2603:1061:100b::/56,Azure 2603:1061:100b:100::/57,Azure 2603:1061:100b:180::/59,Azure 2603:1061:100b:1a0::/64,Azure 2603:1061:100c::/57,Azure
-	2603:1061:100c:80::/58,Azure 2603:1061:100c:c0::/59,Azure 2603:1061:100c:e0::/61,Azure 2603:1061:100d::/56,Azure 2603:1061:100d:100::/61,Azure 2603:1061:100d:108::/62,Azure
-	2603:1061:100d:10c::/63,Azure 2603:1061:100d:10e::/64,Azure 2603:1061:100e::/56,Azure 2603:1061:100e:100::/57,Azure 2603:1061:100e:180::/62,Azure 2603:1061:100e:184::/63,Azure
-	2603:1061:100e:186::/64,Azure 2603:1061:1010::/56,Azure 2603:1061:1010:100::/58,Azure 2603:1061:1010:140::/59,Azure 2603:1061:1010:160::/60,Azure 2603:1061:1010:170::/63,Azure
-	2603:1061:1010:172::/64,Azure 2603:1061:1011::/60,Azure 2603:1061:1011:10::/61,Azure 2603:1061:1011:18::/64,Azure 2603:1061:1011:1c::/62,Azure 2603:1061:1012::/58,Azure
-	2603:1061:1012:40::/59,Azure 2603:1061:1012:60::/61,Azure 2603:1061:1012:68::/63,Azure 2603:1061:1013::/57,Azure 2603:1061:1013:80::/60,Azure 2603:1061:1013:90::/61,Azure
-	2603:1061:1013:98::/62,Azure 2603:1061:1013:9c::/63,Azure 2603:1061:1013:9e::/64,Azure 2603:1061:1014::/59,Azure 2603:1061:1014:20::/60,Azure 2603:1061:1014:30::/62,Azure
-	2603:1061:1014:34::/64,Azure 2603:1061:1015::/58,Azure 2603:1061:1015:40::/62,Azure 2603:1061:1015:44::/64,Azure 2603:1061:1016::/57,Azure 2603:1061:1016:80::/58,Azure
-	2603:1061:1016:c0::/60,Azure 2603:1061:1016:d0::/61,Azure 2603:1061:1016:d8::/63,Azure 2603:1061:1017::/56,Azure 2603:1061:1017:100::/59,Azure 2603:1061:1017:120::/60,Azure
-	2603:1061:1017:130::/61,Azure 2603:1061:1017:138::/64,Azure 2603:1061:1018::/57,Azure 2603:1061:1018:80::/60,Azure 2603:1061:1018:90::/63,Azure 2603:1061:1018:92::/64,Azure
-	2603:1061:1019::/57,Azure 2603:1061:1019:80::/58,Azure 2603:1061:1019:c0::/60,Azure 2603:1061:1019:d0::/61,Azure 2603:1061:1019:d8::/64,Azure 2603:1061:101a::/57,Azure
-	2603:1061:101a:80::/58,Azure 2603:1061:101a:c0::/61,Azure 2603:1061:101b::/58,Azure 2603:1061:101b:40::/59,Azure 2603:1061:101b:60::/61,Azure 2603:1061:101b:68::/63,Azure
-	2603:1061:101b:6a::/64,Azure 2603:1061:101c::/58,Azure 2603:1061:101c:40::/59,Azure 2603:1061:101c:60::/61,Azure 2603:1061:101c:68::/62,Azure 2603:1061:101c:6c::/63,Azure
-	2603:1061:101d::/59,Azure 2603:1061:101d:20::/60,Azure 2603:1061:101d:30::/61,Azure 2603:1061:101d:38::/62,Azure 2603:1061:101d:3c::/63,Azure 2603:1061:101d:3e::/64,Azure
-	2603:1061:101e::/59,Azure 2603:1061:101e:20::/60,Azure 2603:1061:101e:30::/61,Azure 2603:1061:101e:38::/62,Azure 2603:1061:101e:3c::/64,Azure 2603:1061:101f::/58,Azure
-	2603:1061:101f:40::/60,Azure 2603:1061:101f:50::/61,Azure 2603:1061:101f:58::/62,Azure 2603:1061:101f:5c::/64,Azure 2603:1061:1310::/50,Azure 2603:1061:1310:4000::/51,Azure
-	2603:1061:1310:6000::/54,Azure 2603:1061:1311::/50,Azure 2603:1061:1311:4000::/51,Azure 2603:1061:1311:6000::/54,Azure 2603:1061:1312::/50,Azure 2603:1061:1312:4000::/51,Azure
-	2603:1061:1312:6000::/54,Azure 2603:1061:1313::/53,Azure 2603:1061:1314::/52,Azure 2603:1061:1314:1000::/54,Azure 2603:1061:1315::/52,Azure 2603:1061:1601::/48,Azure
-	2603:1061:1700::/42,Azure 2603:1061:1740::/46,Azure 2603:1061:1744::/47,Azure 2603:1061:1746::/62,Azure 2603:1061:1747::/63,Azure 2603:1061:1748::/63,Azure
+	2603:1036:2500:38::/64,Azure 2603:1036:2500:3c::/64,Azure 2603:1036:2500:40::-2603:1036:2500:48:ffff:ffff:ffff:ffff,Azure 2603:1036:2500:60::-2603:1036:2500:68:ffff:ffff:ffff:ffff,Azure 2603:1036:3000::/48,Azure 2603:1037:1::/48,Azure
+	2603:1039:205::/48,Azure 2603:1040::-2603:1040:2:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:4::-2603:1040:7:ff:ffff:ffff:ffff:ffff,Azure 2603:1040:8::/48,Azure 2603:1040:100::/46,Azure 2603:1040:200::-2603:1040:204:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1040:206::-2603:1040:209:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:400::-2603:1040:404:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:406::-2603:1040:409:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:600::/46,Azure 2603:1040:605::-2603:1040:608:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:800::/46,Azure
+	2603:1040:805::-2603:1040:808:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:900::/47,Azure 2603:1040:903::-2603:1040:906:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:a00::/46,Azure 2603:1040:a05::-2603:1040:a08:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:b00::/47,Azure
+	2603:1040:b03::-2603:1040:b06:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:c00::/46,Azure 2603:1040:c05::-2603:1040:c08:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:d00::/47,Azure 2603:1040:d03::-2603:1040:d05:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:e00::-2603:1040:e02:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1040:e04::/46,Azure 2603:1040:f00::-2603:1040:f02:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:f04::-2603:1040:f06:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:f08::/48,Azure 2603:1040:1001::-2603:1040:1004:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:1006::/48,Azure
+	2603:1040:1100::/47,Azure 2603:1040:1103::-2603:1040:1105:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:1201::-2603:1040:1204:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:1206::/48,Azure 2603:1040:1301::-2603:1040:1304:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:1306::/48,Azure
+	2603:1040:1401::-2603:1040:1404:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:1406::/48,Azure 2603:1040:1502::-2603:1040:1506:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:1601::-2603:1040:1605:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:1701::-2603:1040:1706:0:ffff:ffff:ffff:ffff,Azure 2603:1040:1801::-2603:1040:1804:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1040:1806::/48,Azure 2603:1040:1901::-2603:1040:1905:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:1a01::-2603:1040:1a05:ffff:ffff:ffff:ffff:ffff,Azure 2603:1040:1b01::-2603:1040:1b05:ffff:ffff:ffff:ffff:ffff,Azure 2603:1046:a00::-2603:1046:a00:39:ffff:ffff:ffff:ffff,Azure 2603:1046:a00:3b::-2603:1046:a00:63:ffff:ffff:ffff:ffff,Azure
+	2603:1046:1400::/44,Azure 2603:1046:1500::/64,Azure 2603:1046:1500:4::/64,Azure 2603:1046:1500:8::/64,Azure 2603:1046:1500:c::/64,Azure 2603:1046:1500:10::/64,Azure
+	2603:1046:1500:14::/64,Azure 2603:1046:1500:18::/64,Azure 2603:1046:1500:1c::/64,Azure 2603:1046:1500:20::/64,Azure 2603:1046:1500:24::/64,Azure 2603:1046:1500:28::/64,Azure
+	2603:1046:1500:2c::/64,Azure 2603:1046:1500:30::/64,Azure 2603:1046:1500:34::/64,Azure 2603:1046:1500:44::/64,Azure 2603:1046:2000::/48,Azure 2603:1047:1::/48,Azure
+	2603:1050:1::-2603:1050:3:ffff:ffff:ffff:ffff:ffff,Azure 2603:1050:5::-2603:1050:7:ffff:ffff:ffff:ffff:ffff,Azure 2603:1050:9::/48,Azure 2603:1050:100::/40,Azure 2603:1050:300::/46,Azure 2603:1050:305::/48,Azure
+	2603:1050:400::/48,Azure 2603:1050:402::-2603:1050:405:ffff:ffff:ffff:ffff:ffff,Azure 2603:1056:100::-2603:1056:100:b:ffff:ffff:ffff:ffff,Azure 2603:1056:1400::/46,Azure 2603:1056:1500::/64,Azure 2603:1056:1500:4::/64,Azure
+	2603:1056:2000::/48,Azure 2603:1057:2::/48,Azure 2603:1061:f::-2603:1061:12:ffff:ffff:ffff:ffff:ffff,Azure 2603:1061:14::-2603:1061:16:3fff:ffff:ffff:ffff:ffff,Azure 2603:1061:17::/48,Azure 2603:1061:1000::-2603:1061:1004:c9:ffff:ffff:ffff:ffff,Azure
+	2603:1061:1005::-2603:1061:1005:bb:ffff:ffff:ffff:ffff,Azure 2603:1061:1006::-2603:1061:1006:15d:ffff:ffff:ffff:ffff,Azure 2603:1061:1007::-2603:1061:1007:ef:ffff:ffff:ffff:ffff,Azure 2603:1061:1008::-2603:1061:1008:bb:ffff:ffff:ffff:ffff,Azure 2603:1061:1008:c0::-2603:1061:1008:127:ffff:ffff:ffff:ffff,Azure 2603:1061:1009::/58,Azure
+	2603:1061:100a::-2603:1061:100a:5:ffff:ffff:ffff:ffff,Azure 2603:1061:100a:8::-2603:1061:100a:d:ffff:ffff:ffff:ffff,Azure 2603:1061:100a:10::/62,Azure 2603:1061:100a:16::/63,Azure 2603:1061:100a:1a::-2603:1061:100a:4e:ffff:ffff:ffff:ffff,Azure 2603:1061:100b::-2603:1061:100b:1a0:ffff:ffff:ffff:ffff,Azure
+	2603:1061:100c::-2603:1061:100c:e7:ffff:ffff:ffff:ffff,Azure 2603:1061:100d::-2603:1061:100d:10e:ffff:ffff:ffff:ffff,Azure 2603:1061:100e::-2603:1061:100e:186:ffff:ffff:ffff:ffff,Azure 2603:1061:1010::-2603:1061:1010:172:ffff:ffff:ffff:ffff,Azure 2603:1061:1011::-2603:1061:1011:18:ffff:ffff:ffff:ffff,Azure 2603:1061:1011:1c::/62,Azure
+	2603:1061:1012::-2603:1061:1012:69:ffff:ffff:ffff:ffff,Azure 2603:1061:1013::-2603:1061:1013:9e:ffff:ffff:ffff:ffff,Azure 2603:1061:1014::-2603:1061:1014:34:ffff:ffff:ffff:ffff,Azure 2603:1061:1015::-2603:1061:1015:44:ffff:ffff:ffff:ffff,Azure 2603:1061:1016::-2603:1061:1016:d9:ffff:ffff:ffff:ffff,Azure 2603:1061:1017::-2603:1061:1017:138:ffff:ffff:ffff:ffff,Azure
+	2603:1061:1018::-2603:1061:1018:92:ffff:ffff:ffff:ffff,Azure 2603:1061:1019::-2603:1061:1019:d8:ffff:ffff:ffff:ffff,Azure 2603:1061:101a::-2603:1061:101a:c7:ffff:ffff:ffff:ffff,Azure 2603:1061:101b::-2603:1061:101b:6a:ffff:ffff:ffff:ffff,Azure 2603:1061:101c::-2603:1061:101c:6d:ffff:ffff:ffff:ffff,Azure 2603:1061:101d::-2603:1061:101d:3e:ffff:ffff:ffff:ffff,Azure
+	2603:1061:101e::-2603:1061:101e:3c:ffff:ffff:ffff:ffff,Azure 2603:1061:101f::-2603:1061:101f:5c:ffff:ffff:ffff:ffff,Azure 2603:1061:1310::-2603:1061:1310:63ff:ffff:ffff:ffff:ffff,Azure 2603:1061:1311::-2603:1061:1311:63ff:ffff:ffff:ffff:ffff,Azure 2603:1061:1312::-2603:1061:1312:63ff:ffff:ffff:ffff:ffff,Azure 2603:1061:1313::/53,Azure
+	2603:1061:1314::-2603:1061:1314:13ff:ffff:ffff:ffff:ffff,Azure 2603:1061:1315::/52,Azure 2603:1061:1601::/48,Azure 2603:1061:1700::-2603:1061:1746:3:ffff:ffff:ffff:ffff,Azure 2603:1061:1747::/63,Azure 2603:1061:1748::/63,Azure
 	2603:1061:174a::/63,Azure 2603:1061:174b::/63,Azure 2603:1061:174c::/62,Azure 2603:1061:174d::/63,Azure 2603:1061:174e::/62,Azure 2603:1061:174f::/62,Azure
-	2603:1061:1750::/62,Azure 2603:1061:1751::/63,Azure 2603:1061:2000::/62,Azure 2603:1061:2000:100::/58,Azure 2603:1061:2000:140::/59,Azure 2603:1061:2000:400::/62,Azure
-	2603:1061:2000:408::/62,Azure 2603:1061:2000:410::/62,Azure 2603:1061:2000:440::/62,Azure 2603:1061:2000:480::/62,Azure 2603:1061:2000:488::/62,Azure 2603:1061:2000:4c0::/62,Azure
-	2603:1061:2000:4c8::/62,Azure 2603:1061:2000:500::/62,Azure 2603:1061:2000:508::/62,Azure 2603:1061:2000:540::/62,Azure 2603:1061:2000:548::/62,Azure 2603:1061:2000:580::/62,Azure
-	2603:1061:2000:588::/62,Azure 2603:1061:2000:5c0::/62,Azure 2603:1061:2000:5c8::/62,Azure 2603:1061:2000:5e0::/62,Azure 2603:1061:2000:600::/62,Azure 2603:1061:2000:608::/62,Azure
-	2603:1061:2000:640::/62,Azure 2603:1061:2000:648::/62,Azure 2603:1061:2000:660::/62,Azure 2603:1061:2000:680::/62,Azure 2603:1061:2000:688::/62,Azure 2603:1061:2000:6a0::/62,Azure
-	2603:1061:2000:6c0::/62,Azure 2603:1061:2000:6c8::/62,Azure 2603:1061:2000:6e0::/62,Azure 2603:1061:2000:700::/62,Azure 2603:1061:2000:708::/62,Azure 2603:1061:2000:720::/62,Azure
-	2603:1061:2000:740::/62,Azure 2603:1061:2000:748::/62,Azure 2603:1061:2000:760::/62,Azure 2603:1061:2000:780::/62,Azure 2603:1061:2000:788::/62,Azure 2603:1061:2000:7a0::/62,Azure
-	2603:1061:2000:7c0::/62,Azure 2603:1061:2000:7c8::/62,Azure 2603:1061:2000:7e0::/62,Azure 2603:1061:2000:7e8::/62,Azure 2603:1061:2002::/48,Azure 2603:1061:2004::/48,Azure
-	2603:1061:2010::/47,Azure 2603:1062:2::/55,Azure 2603:1062:2:200::/57,Azure 2603:1062:c::/59,Azure 2603:1062:c:20::/60,Azure 2603:1062:c:30::/61,Azure
-	2603:1062:10::/48,Azure 2603:1063::/56,Azure 2603:1063:0:200::/56,Azure 2603:1063:1::/56,Azure 2603:1063:2::/56,Azure 2603:1063:3::/56,Azure
-	2603:1063:4::/56,Azure 2603:1063:5::/56,Azure 2603:1063:6::/56,Azure 2603:1063:7::/56,Azure 2603:1063:8::/56,Azure 2603:1063:9::/56,Azure
-	2603:1063:a::/56,Azure 2603:1063:b::/56,Azure 2603:1063:c::/56,Azure 2603:1063:d::/56,Azure 2603:1063:e::/56,Azure 2603:1063:f::/56,Azure
-	2603:1063:10::/56,Azure 2603:1063:11::/56,Azure 2603:1063:12::/56,Azure 2603:1063:13::/56,Azure 2603:1063:14::/56,Azure 2603:1063:15::/56,Azure
-	2603:1063:16::/56,Azure 2603:1063:17::/56,Azure 2603:1063:18::/56,Azure 2603:1063:19::/56,Azure 2603:1063:1a::/56,Azure 2603:1063:1b::/56,Azure
-	2603:1063:1c::/56,Azure 2603:1063:1d::/56,Azure 2603:1063:1e::/56,Azure 2603:1063:1f::/56,Azure 2603:1063:20::/56,Azure 2603:1063:21::/56,Azure
-	2603:1063:22::/56,Azure 2603:1063:23::/56,Azure 2603:1063:24::/56,Azure 2603:1063:25::/56,Azure 2603:1063:26::/56,Azure 2603:1063:28::/56,Azure
-	2603:1063:30::/64,Azure 2603:1063:32::/56,Azure 2603:1063:37::/64,Azure 2603:1063:39::/48,Azure 2603:1063:41::/56,Azure 2603:1063:42::/56,Azure
-	2603:1063:43::/56,Azure 2603:1063:44::/56,Azure 2603:1063:45::/56,Azure 2603:1063:46::/56,Azure 2603:1063:47::/48,Azure 2603:1063:4a::/55,Azure
-	2603:1063:4b::/55,Azure 2603:1063:4c::/55,Azure 2603:1063:ff::/64,Azure 2603:1063:100::/55,Azure 2603:1063:100:200::/56,Azure 2603:1063:101::/55,Azure
-	2603:1063:101:200::/56,Azure 2603:1063:102::/55,Azure 2603:1063:102:200::/56,Azure 2603:1063:103::/55,Azure 2603:1063:103:200::/56,Azure 2603:1063:104::/55,Azure
-	2603:1063:104:200::/56,Azure 2603:1063:105::/55,Azure 2603:1063:105:200::/56,Azure 2603:1063:106::/55,Azure 2603:1063:106:200::/56,Azure 2603:1063:107::/55,Azure
-	2603:1063:107:200::/56,Azure 2603:1063:108::/55,Azure 2603:1063:108:200::/56,Azure 2603:1063:109::/55,Azure 2603:1063:109:200::/56,Azure 2603:1063:10a::/55,Azure
-	2603:1063:10a:200::/56,Azure 2603:1063:10b::/55,Azure 2603:1063:10b:200::/56,Azure 2603:1063:10c::/55,Azure 2603:1063:10c:200::/56,Azure 2603:1063:10d::/55,Azure
-	2603:1063:10d:200::/56,Azure 2603:1063:10e::/55,Azure 2603:1063:10e:200::/56,Azure 2603:1063:10f::/55,Azure 2603:1063:10f:200::/56,Azure 2603:1063:110::/55,Azure
-	2603:1063:110:200::/56,Azure 2603:1063:111::/55,Azure 2603:1063:111:200::/56,Azure 2603:1063:112::/55,Azure 2603:1063:112:200::/56,Azure 2603:1063:113::/55,Azure
-	2603:1063:113:200::/56,Azure 2603:1063:114::/55,Azure 2603:1063:114:200::/56,Azure 2603:1063:115::/55,Azure 2603:1063:115:200::/56,Azure 2603:1063:116::/55,Azure
-	2603:1063:116:200::/56,Azure 2603:1063:117::/55,Azure 2603:1063:117:200::/56,Azure 2603:1063:118::/55,Azure 2603:1063:118:200::/56,Azure 2603:1063:119::/55,Azure
-	2603:1063:119:200::/56,Azure 2603:1063:11a::/55,Azure 2603:1063:11a:200::/56,Azure 2603:1063:11b::/55,Azure 2603:1063:11b:200::/56,Azure 2603:1063:11c::/55,Azure
-	2603:1063:11c:200::/56,Azure 2603:1063:11d::/55,Azure 2603:1063:11d:200::/56,Azure 2603:1063:11e::/55,Azure 2603:1063:11e:200::/56,Azure 2603:1063:11f::/55,Azure
-	2603:1063:11f:200::/56,Azure 2603:1063:120::/55,Azure 2603:1063:120:200::/56,Azure 2603:1063:121::/55,Azure 2603:1063:121:200::/56,Azure 2603:1063:122::/55,Azure
-	2603:1063:122:200::/56,Azure 2603:1063:123::/55,Azure 2603:1063:123:200::/56,Azure 2603:1063:124::/55,Azure 2603:1063:124:200::/56,Azure 2603:1063:125::/55,Azure
-	2603:1063:125:200::/56,Azure 2603:1063:126::/55,Azure 2603:1063:126:200::/64,Azure 2603:1063:129::/55,Azure 2603:1063:129:200::/56,Azure 2603:1063:12a::/55,Azure
-	2603:1063:12a:200::/56,Azure 2603:1063:12b::/55,Azure 2603:1063:12b:200::/56,Azure 2603:1063:12c::/55,Azure 2603:1063:12c:200::/56,Azure 2603:1063:12d::/55,Azure
-	2603:1063:12d:200::/56,Azure 2603:1063:12e::/55,Azure 2603:1063:12e:200::/56,Azure 2603:1063:12f::/55,Azure 2603:1063:12f:200::/56,Azure 2603:1063:130::/55,Azure
-	2603:1063:130:200::/56,Azure 2603:1063:131::/55,Azure 2603:1063:131:200::/56,Azure 2603:1063:132::/55,Azure 2603:1063:132:200::/56,Azure 2603:1063:133::/55,Azure
-	2603:1063:133:200::/56,Azure 2603:1063:134::/55,Azure 2603:1063:134:200::/56,Azure 2603:1063:135::/55,Azure 2603:1063:135:200::/56,Azure 2603:1063:136::/56,Azure
-	2603:1063:137::/56,Azure 2603:1063:138::/56,Azure 2603:1063:139::/56,Azure 2603:1063:13a::/56,Azure 2603:1063:13b::/56,Azure 2603:1063:13c::/56,Azure
-	2603:1063:13d:1::/64,Azure 2603:1063:180::/64,Azure 2603:1063:200::/55,Azure 2603:1063:201::/55,Azure 2603:1063:202::/55,Azure 2603:1063:203::/55,Azure
-	2603:1063:204::/55,Azure 2603:1063:205::/55,Azure 2603:1063:206::/55,Azure 2603:1063:207::/55,Azure 2603:1063:208::/55,Azure 2603:1063:209::/55,Azure
-	2603:1063:20a::/55,Azure 2603:1063:20b::/55,Azure 2603:1063:20c::/55,Azure 2603:1063:20d::/55,Azure 2603:1063:20e::/55,Azure 2603:1063:20f::/55,Azure
-	2603:1063:210::/55,Azure 2603:1063:211::/55,Azure 2603:1063:212::/55,Azure 2603:1063:213::/55,Azure 2603:1063:214::/55,Azure 2603:1063:215::/55,Azure
-	2603:1063:216::/55,Azure 2603:1063:217::/55,Azure 2603:1063:218::/55,Azure 2603:1063:219::/55,Azure 2603:1063:21a::/55,Azure 2603:1063:21b::/55,Azure
-	2603:1063:21c::/55,Azure 2603:1063:21d::/55,Azure 2603:1063:21e::/55,Azure 2603:1063:21f::/55,Azure 2603:1063:220::/55,Azure 2603:1063:221::/55,Azure
-	2603:1063:222::/55,Azure 2603:1063:223::/55,Azure 2603:1063:224::/55,Azure 2603:1063:225::/55,Azure 2603:1063:226::/55,Azure 2603:1063:227::/56,Azure
-	2603:1063:22b::/56,Azure 2603:1063:22c::/56,Azure 2603:1063:22d::/56,Azure 2603:1063:22e::/56,Azure 2603:1063:22f::/56,Azure 2603:1063:230::/56,Azure
-	2603:1063:231::/56,Azure 2603:1063:232::/56,Azure 2603:1063:233::/56,Azure 2603:1063:234::/56,Azure 2603:1063:235::/56,Azure 2603:1063:236::/56,Azure
-	2603:1063:400::/56,Azure 2603:1063:401::/56,Azure 2603:1063:402::/56,Azure 2603:1063:403::/56,Azure 2603:1063:404::/56,Azure 2603:1063:405::/56,Azure
-	2603:1063:406::/56,Azure 2603:1063:407::/56,Azure 2603:1063:408::/56,Azure 2603:1063:409::/56,Azure 2603:1063:40a::/56,Azure 2603:1063:40b::/56,Azure
-	2603:1063:40c::/56,Azure 2603:1063:40d::/56,Azure 2603:1063:40e::/56,Azure 2603:1063:40f::/56,Azure 2603:1063:410::/56,Azure 2603:1063:411::/56,Azure
-	2603:1063:412::/56,Azure 2603:1063:413::/56,Azure 2603:1063:414::/56,Azure 2603:1063:415::/64,Azure 2603:1063:416::/56,Azure 2603:1063:417::/56,Azure
-	2603:1063:418::/56,Azure 2603:1063:419::/56,Azure 2603:1063:41a::/56,Azure 2603:1063:41b::/56,Azure 2603:1063:41c::/56,Azure 2603:1063:41d::/56,Azure
-	2603:1063:41e::/56,Azure 2603:1063:41f::/56,Azure 2603:1063:420::/56,Azure 2603:1063:421::/56,Azure 2603:1063:422::/56,Azure 2603:1063:423::/56,Azure
-	2603:1063:424::/56,Azure 2603:1063:425::/56,Azure 2603:1063:426::/56,Azure 2603:1063:427::/56,Azure 2603:1063:428::/56,Azure 2603:1063:429::/56,Azure
-	2603:1063:42a::/56,Azure 2603:1063:42b::/56,Azure 2603:1063:42c::/56,Azure 2603:1063:42d::/56,Azure 2603:1063:42e::/56,Azure 2603:1063:42f::/56,Azure
-	2603:1063:430::/56,Azure 2603:1063:431::/56,Azure 2603:1063:432::/56,Azure 2603:1063:433::/56,Azure 2603:1063:434::/56,Azure 2603:1063:435::/55,Azure
-	2603:1063:600::/56,Azure 2603:1063:601::/56,Azure 2603:1063:602::/56,Azure 2603:1063:603::/56,Azure 2603:1063:604::/56,Azure 2603:1063:605::/56,Azure
-	2603:1063:606::/56,Azure 2603:1063:607::/56,Azure 2603:1063:608::/56,Azure 2603:1063:609::/56,Azure 2603:1063:60a::/56,Azure 2603:1063:60b::/56,Azure
-	2603:1063:60c::/56,Azure 2603:1063:60d::/56,Azure 2603:1063:60e::/56,Azure 2603:1063:60f::/56,Azure 2603:1063:610::/56,Azure 2603:1063:611::/56,Azure
-	2603:1063:612::/56,Azure 2603:1063:613::/56,Azure 2603:1063:614::/56,Azure 2603:1063:615::/56,Azure 2603:1063:616::/56,Azure 2603:1063:617::/56,Azure
-	2603:1063:618::/56,Azure 2603:1063:619::/56,Azure 2603:1063:61a::/56,Azure 2603:1063:61b::/56,Azure 2603:1063:61c::/56,Azure 2603:1063:61d::/56,Azure
-	2603:1063:61e::/56,Azure 2603:1063:61f::/56,Azure 2603:1063:620::/56,Azure 2603:1063:621::/56,Azure 2603:1063:622::/56,Azure 2603:1063:623::/56,Azure
-	2603:1063:624::/56,Azure 2603:1063:625::/56,Azure 2603:1063:626::/56,Azure 2603:1063:627::/56,Azure 2603:1063:628::/56,Azure 2603:1063:629::/56,Azure
-	2603:1063:62a::/56,Azure 2603:1063:62b::/56,Azure 2603:1063:62c::/56,Azure 2603:1063:62d::/56,Azure 2603:1063:62e::/56,Azure 2603:1063:62f::/56,Azure
-	2603:1063:630::/56,Azure 2603:1063:631::/56,Azure 2603:1063:632::/56,Azure 2603:1063:633::/56,Azure 2603:1063:634::/56,Azure 2603:1063:635::/56,Azure
-	2603:1063:636::/56,Azure 2603:1063:637::/56,Azure 2603:1063:638::/56,Azure 2603:1063:639::/56,Azure 2603:1063:700::/56,Azure 2603:1063:702::/56,Azure
-	2603:1063:703::/56,Azure 2603:1063:704::/56,Azure 2603:1063:705::/56,Azure 2603:1063:706::/56,Azure 2603:1063:707::/56,Azure 2603:1063:708::/56,Azure
-	2603:1063:709::/56,Azure 2603:1063:70a::/56,Azure 2603:1063:70b::/56,Azure 2603:1063:70c::/56,Azure 2603:1063:70d::/56,Azure 2603:1063:70e::/56,Azure
-	2603:1063:70f::/56,Azure 2603:1063:710::/56,Azure 2603:1063:711::/56,Azure 2603:1063:712::/56,Azure 2603:1063:713::/56,Azure 2603:1063:714::/56,Azure
-	2603:1063:715::/56,Azure 2603:1063:716::/56,Azure 2603:1063:717::/56,Azure 2603:1063:718::/56,Azure 2603:1063:719::/56,Azure 2603:1063:71a::/56,Azure
-	2603:1063:71b::/56,Azure 2603:1063:71c::/56,Azure 2603:1063:71d::/56,Azure 2603:1063:71e::/56,Azure 2603:1063:71f::/56,Azure 2603:1063:720::/56,Azure
-	2603:1063:721::/56,Azure 2603:1063:722::/56,Azure 2603:1063:723::/56,Azure 2603:1063:724::/56,Azure 2603:1063:725::/56,Azure 2603:1063:726::/56,Azure
-	2603:1063:727::/56,Azure 2603:1063:728::/56,Azure 2603:1063:729::/56,Azure 2603:1063:72a::/56,Azure 2603:1063:72b::/56,Azure 2603:1063:72c::/56,Azure
-	2603:1063:72d::/56,Azure 2603:1063:72e::/56,Azure 2603:1063:72f::/56,Azure 2603:1063:730::/56,Azure 2603:1063:731::/56,Azure 2603:1063:732::/56,Azure
-	2603:1063:1c00::/55,Azure 2603:1063:1c01::/55,Azure 2603:1063:1c02::/55,Azure 2603:1063:1c03::/55,Azure 2603:1063:1c04::/55,Azure 2603:1063:1c05::/55,Azure
-	2603:1063:1c06::/55,Azure 2603:1063:1c07::/55,Azure 2603:1063:1c08::/55,Azure 2603:1063:1c09::/55,Azure 2603:1063:1c0a::/55,Azure 2603:1063:1c0b::/55,Azure
-	2603:1063:1c0c::/55,Azure 2603:1063:1c0d::/55,Azure 2603:1063:1c0e::/55,Azure 2603:1063:1c0f::/55,Azure 2603:1063:1c10::/55,Azure 2603:1063:1c11::/55,Azure
-	2603:1063:1c12::/55,Azure 2603:1063:1c13::/55,Azure 2603:1063:2200::/64,Azure 2603:1063:2200:4::/64,Azure 2603:1063:2200:8::/64,Azure 2603:1063:2200:c::/64,Azure
-	2603:1063:2200:10::/64,Azure 2603:1063:2200:14::/64,Azure 2603:1063:2200:18::/64,Azure 2603:1063:2200:1c::/64,Azure 2603:1063:2200:20::/64,Azure 2603:1063:2200:24::/64,Azure
-	2603:1063:2200:28::/64,Azure 2603:1063:2200:2c::/64,Azure 2603:1063:2200:30::/64,Azure 2603:1063:2200:34::/64,Azure 2603:1063:2200:38::/64,Azure 2603:1063:2200:3c::/64,Azure
-	2603:1063:2202::/64,Azure 2603:1063:2202:4::/64,Azure 2603:1063:2202:8::/64,Azure 2603:1063:2202:c::/64,Azure 2603:1063:2202:10::/64,Azure 2603:1063:2202:14::/64,Azure
-	2603:1063:2202:18::/64,Azure 2603:1063:2202:1c::/64,Azure 2603:1063:2202:20::/64,Azure 2603:1063:2202:24::/64,Azure 2603:1063:2202:28::/64,Azure 2603:1063:2202:2c::/64,Azure
-	2603:1063:2202:30::/64,Azure 2603:1063:2202:34::/64,Azure 2603:1063:2202:38::/64,Azure 2603:1063:2202:3c::/64,Azure 2603:1063:2202:40::/64,Azure 2603:1063:2202:44::/64,Azure
-	2603:1063:2202:48::/64,Azure 2603:1063:2202:4c::/64,Azure 2603:1063:2202:50::/64,Azure 2603:1063:2202:54::/64,Azure 2603:1063:2202:58::/64,Azure 2603:1063:2202:5c::/64,Azure
-	2603:1063:2202:60::/64,Azure 2603:1063:2202:64::/64,Azure 2603:1063:2202:68::/64,Azure 2603:1063:2202:6c::/64,Azure 2603:1063:2202:70::/64,Azure 2603:1063:2202:74::/64,Azure
-	2603:1063:2202:78::/64,Azure 2603:1063:2202:7c::/64,Azure 2603:1063:2204::/64,Azure 2603:1063:2204:4::/64,Azure 2603:1063:2204:8::/64,Azure 2603:1063:2204:c::/64,Azure
-	2603:1063:2204:10::/64,Azure 2603:1063:2204:14::/64,Azure 2603:1063:2204:18::/64,Azure 2603:1063:2206::/64,Azure 2603:1063:2206:4::/64,Azure 2603:1063:2206:8::/64,Azure
-	2603:1063:2206:c::/64,Azure 2603:1063:2206:10::/64,Azure 2603:1063:2206:14::/64,Azure 2603:1063:2206:18::/64,Azure 2603:1063:2206:1c::/64,Azure 2603:1063:2206:20::/64,Azure
-	2603:1063:2206:24::/64,Azure 2603:1063:2206:28::/64,Azure 2603:1063:2206:2c::/64,Azure 2603:1063:2206:30::/64,Azure 2603:1063:2206:34::/64,Azure 2603:1063:2206:38::/64,Azure
-	2603:1063:2206:3c::/64,Azure 2603:1063:2206:40::/64,Azure 2603:1063:2206:44::/64,Azure 2603:1063:2206:48::/64,Azure 2603:1063:2206:4c::/64,Azure 2603:1063:2206:50::/64,Azure
-	2603:1063:2206:54::/64,Azure 2603:1063:2206:5c::/64,Azure 2603:1063:2400::/45,Azure 2603:1063:2408::/64,Azure 2603:1063:2409::/64,Azure 2603:1063:240a::/47,Azure
-	2603:1063:240c::/46,Azure 2603:1063:2410::/44,Azure 2603:1063:2420::/44,Azure 2603:1063:2430::/45,Azure 2603:1063:2438::/46,Azure 2603:1063:243c::/47,Azure
-	2603:1063:2600::/45,Azure 2603:1063:2608::/48,Azure 2603:1063:260a::/47,Azure 2603:1063:260c::/46,Azure 2603:1063:2610::/44,Azure 2603:1063:2620::/44,Azure
-	2603:1063:2630::/45,Azure 2603:1063:2638::/46,Azure 2603:1063:263c::/48,Azure 2603:1063:2800::/43,Azure 2603:1063:2820::/44,Azure 2603:1063:2830::/45,Azure
-	2603:1063:2838::/46,Azure 2603:1063:2a00::/43,Azure 2603:1063:2a20::/44,Azure 2603:1063:2a30::/45,Azure 2603:1063:2a38::/46,Azure 2603:1063:c000::/44,Azure
-	2603:10e1:0:100::/56,Azure 2603:10e1:1::/48,Azure 2603:10e1:100:2::1415:e48/128,Azure 2603:10e1:100:2::1435:5552/128,Azure 2603:10e1:100:2::1448:bca0/128,Azure 2603:10e1:100:2::144c:f22d/128,Azure
-	2603:10e1:100:2::1458:b0aa/128,Azure 2603:10e1:100:2::1458:e0aa/128,Azure 2603:10e1:100:2::1476:62f3/128,Azure 2603:10e1:100:2::1479:6172/128,Azure 2603:10e1:100:2::14c3:6100/128,Azure 2603:10e1:100:2::14d7:8032/128,Azure
-	2603:10e1:100:2::14d7:80d6/128,Azure 2603:10e1:100:2::287d:67fb/128,Azure 2603:10e1:100:2::3368:a5a2/128,Azure 2603:10e1:100:2::348b:476/128,Azure 2603:10e1:100:2::34ba:290f/128,Azure 2603:10e1:100:2::34bf:e4f5/128,Azure
-	2604:2dc0::/32,OVH 2604:a880:0:1010::/61,DigitalOcean 2604:a880:0:1018::/63,DigitalOcean 2604:a880:0:1020::/61,DigitalOcean 2604:a880:0:1028::/63,DigitalOcean 2604:a880:0:2010::/61,DigitalOcean
-	2604:a880:0:2018::/63,DigitalOcean 2604:a880:0:2020::/61,DigitalOcean 2604:a880:0:2028::/63,DigitalOcean 2604:a880:0:202a::/64,DigitalOcean 2604:a880:1:20::/64,DigitalOcean 2604:a880:1:4a::/64,DigitalOcean
-	2604:a880:2:d0::/63,DigitalOcean 2604:a880:4:1d0::/64,DigitalOcean 2604:a880:5:1::/64,DigitalOcean 2604:a880:6:7::/64,DigitalOcean 2604:a880:7:7::/64,DigitalOcean 2604:a880:8:7::/64,DigitalOcean
-	2604:a880:400:d0::/63,DigitalOcean 2604:a880:800:10::/62,DigitalOcean 2604:a880:800:14::/64,DigitalOcean 2604:a880:800:a1::/64,DigitalOcean 2604:a880:800:c1::/64,DigitalOcean 2604:a880:803::/48,DigitalOcean
-	2604:a880:cad:d0::/64,DigitalOcean 2605:9cc0:1ff0::/56,AWS 2605:9cc0:1ff0:400::/54,AWS 2605:9cc0:1ff0:800::/55,AWS 2605:9cc0:1ff0:1000::/52,AWS 2605:9cc0:1ff0:2300::/56,AWS
-	2605:9cc0:1ff0:2800::/56,AWS 2605:9cc0:1ff0:2d00::/56,AWS 2605:9cc0:1ff0:3c00::/56,AWS 2605:9cc0:1ff0:4000::/54,AWS 2605:9cc0:1ff0:6000::/56,AWS 2605:9cc0:1ff0:7200::/56,AWS
-	2605:9cc0:1ff0:8000::/56,AWS 2605:9cc0:1ff0:8200::/56,AWS 2605:9cc0:1ff0:f800::/56,AWS 2605:9cc0:1fff:fc00::/54,AWS 2605:b140:1000::/48,AWS 2605:b140:9800::/46,AWS
-	2605:b140:9804::/47,AWS 2605:b140:9900::/46,AWS 2605:b140:9904::/47,AWS 2605:b140:9906::/48,AWS 2605:b140:9a00::/46,AWS 2605:b140:9a04::/47,AWS
-	2606:f40::/39,AWS 2606:f40:400::/39,AWS 2606:f40:800::/40,AWS 2606:f40:a00::/40,AWS 2606:f40:1000::/39,AWS 2606:f40:1400::/40,AWS
-	2606:f40:1800::/40,AWS 2606:f40:2100::/40,AWS 2606:f40:2200::/40,AWS 2606:f40:2400::/40,AWS 2606:f40:2800::/40,AWS 2606:f40:2a00::/40,AWS
-	2606:f40:3200::/40,AWS 2606:f40:3e00::/40,AWS 2606:f40:4200::/39,AWS 2606:f40:4400::/38,AWS 2606:f40:4900::/46,AWS 2606:f40:4904::/48,AWS
+	2603:1061:1750::/62,Azure 2603:1061:1751::/63,Azure 2603:1061:2000::/62,Azure 2603:1061:2000:100::-2603:1061:2000:15f:ffff:ffff:ffff:ffff,Azure 2603:1061:2000:400::/62,Azure 2603:1061:2000:408::/62,Azure
+	2603:1061:2000:410::/62,Azure 2603:1061:2000:440::/62,Azure 2603:1061:2000:480::/62,Azure 2603:1061:2000:488::/62,Azure 2603:1061:2000:4c0::/62,Azure 2603:1061:2000:4c8::/62,Azure
+	2603:1061:2000:500::/62,Azure 2603:1061:2000:508::/62,Azure 2603:1061:2000:540::/62,Azure 2603:1061:2000:548::/62,Azure 2603:1061:2000:580::/62,Azure 2603:1061:2000:588::/62,Azure
+	2603:1061:2000:5c0::/62,Azure 2603:1061:2000:5c8::/62,Azure 2603:1061:2000:5e0::/62,Azure 2603:1061:2000:600::/62,Azure 2603:1061:2000:608::/62,Azure 2603:1061:2000:640::/62,Azure
+	2603:1061:2000:648::/62,Azure 2603:1061:2000:660::/62,Azure 2603:1061:2000:680::/62,Azure 2603:1061:2000:688::/62,Azure 2603:1061:2000:6a0::/62,Azure 2603:1061:2000:6c0::/62,Azure
+	2603:1061:2000:6c8::/62,Azure 2603:1061:2000:6e0::/62,Azure 2603:1061:2000:700::/62,Azure 2603:1061:2000:708::/62,Azure 2603:1061:2000:720::/62,Azure 2603:1061:2000:740::/62,Azure
+	2603:1061:2000:748::/62,Azure 2603:1061:2000:760::/62,Azure 2603:1061:2000:780::/62,Azure 2603:1061:2000:788::/62,Azure 2603:1061:2000:7a0::/62,Azure 2603:1061:2000:7c0::/62,Azure
+	2603:1061:2000:7c8::/62,Azure 2603:1061:2000:7e0::/62,Azure 2603:1061:2000:7e8::/62,Azure 2603:1061:2002::/48,Azure 2603:1061:2004::/48,Azure 2603:1061:2010::/47,Azure
+	2603:1062:2::-2603:1062:2:27f:ffff:ffff:ffff:ffff,Azure 2603:1062:c::-2603:1062:c:37:ffff:ffff:ffff:ffff,Azure 2603:1062:10::/48,Azure 2603:1063::/56,Azure 2603:1063:0:200::/56,Azure 2603:1063:1::/56,Azure
+	2603:1063:2::/56,Azure 2603:1063:3::/56,Azure 2603:1063:4::/56,Azure 2603:1063:5::/56,Azure 2603:1063:6::/56,Azure 2603:1063:7::/56,Azure
+	2603:1063:8::/56,Azure 2603:1063:9::/56,Azure 2603:1063:a::/56,Azure 2603:1063:b::/56,Azure 2603:1063:c::/56,Azure 2603:1063:d::/56,Azure
+	2603:1063:e::/56,Azure 2603:1063:f::/56,Azure 2603:1063:10::/56,Azure 2603:1063:11::/56,Azure 2603:1063:12::/56,Azure 2603:1063:13::/56,Azure
+	2603:1063:14::/56,Azure 2603:1063:15::/56,Azure 2603:1063:16::/56,Azure 2603:1063:17::/56,Azure 2603:1063:18::/56,Azure 2603:1063:19::/56,Azure
+	2603:1063:1a::/56,Azure 2603:1063:1b::/56,Azure 2603:1063:1c::/56,Azure 2603:1063:1d::/56,Azure 2603:1063:1e::/56,Azure 2603:1063:1f::/56,Azure
+	2603:1063:20::/56,Azure 2603:1063:21::/56,Azure 2603:1063:22::/56,Azure 2603:1063:23::/56,Azure 2603:1063:24::/56,Azure 2603:1063:25::/56,Azure
+	2603:1063:26::/56,Azure 2603:1063:28::/56,Azure 2603:1063:30::/64,Azure 2603:1063:32::/56,Azure 2603:1063:37::/64,Azure 2603:1063:39::/48,Azure
+	2603:1063:41::/56,Azure 2603:1063:42::/56,Azure 2603:1063:43::/56,Azure 2603:1063:44::/56,Azure 2603:1063:45::/56,Azure 2603:1063:46::/56,Azure
+	2603:1063:47::/48,Azure 2603:1063:4a::/55,Azure 2603:1063:4b::/55,Azure 2603:1063:4c::/55,Azure 2603:1063:ff::/64,Azure 2603:1063:100::-2603:1063:100:2ff:ffff:ffff:ffff:ffff,Azure
+	2603:1063:101::-2603:1063:101:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:102::-2603:1063:102:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:103::-2603:1063:103:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:104::-2603:1063:104:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:105::-2603:1063:105:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:106::-2603:1063:106:2ff:ffff:ffff:ffff:ffff,Azure
+	2603:1063:107::-2603:1063:107:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:108::-2603:1063:108:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:109::-2603:1063:109:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:10a::-2603:1063:10a:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:10b::-2603:1063:10b:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:10c::-2603:1063:10c:2ff:ffff:ffff:ffff:ffff,Azure
+	2603:1063:10d::-2603:1063:10d:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:10e::-2603:1063:10e:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:10f::-2603:1063:10f:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:110::-2603:1063:110:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:111::-2603:1063:111:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:112::-2603:1063:112:2ff:ffff:ffff:ffff:ffff,Azure
+	2603:1063:113::-2603:1063:113:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:114::-2603:1063:114:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:115::-2603:1063:115:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:116::-2603:1063:116:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:117::-2603:1063:117:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:118::-2603:1063:118:2ff:ffff:ffff:ffff:ffff,Azure
+	2603:1063:119::-2603:1063:119:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:11a::-2603:1063:11a:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:11b::-2603:1063:11b:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:11c::-2603:1063:11c:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:11d::-2603:1063:11d:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:11e::-2603:1063:11e:2ff:ffff:ffff:ffff:ffff,Azure
+	2603:1063:11f::-2603:1063:11f:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:120::-2603:1063:120:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:121::-2603:1063:121:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:122::-2603:1063:122:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:123::-2603:1063:123:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:124::-2603:1063:124:2ff:ffff:ffff:ffff:ffff,Azure
+	2603:1063:125::-2603:1063:125:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:126::-2603:1063:126:200:ffff:ffff:ffff:ffff,Azure 2603:1063:129::-2603:1063:129:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:12a::-2603:1063:12a:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:12b::-2603:1063:12b:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:12c::-2603:1063:12c:2ff:ffff:ffff:ffff:ffff,Azure
+	2603:1063:12d::-2603:1063:12d:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:12e::-2603:1063:12e:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:12f::-2603:1063:12f:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:130::-2603:1063:130:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:131::-2603:1063:131:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:132::-2603:1063:132:2ff:ffff:ffff:ffff:ffff,Azure
+	2603:1063:133::-2603:1063:133:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:134::-2603:1063:134:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:135::-2603:1063:135:2ff:ffff:ffff:ffff:ffff,Azure 2603:1063:136::/56,Azure 2603:1063:137::/56,Azure 2603:1063:138::/56,Azure
+	2603:1063:139::/56,Azure 2603:1063:13a::/56,Azure 2603:1063:13b::/56,Azure 2603:1063:13c::/56,Azure 2603:1063:13d:1::/64,Azure 2603:1063:180::/64,Azure
+	2603:1063:200::/55,Azure 2603:1063:201::/55,Azure 2603:1063:202::/55,Azure 2603:1063:203::/55,Azure 2603:1063:204::/55,Azure 2603:1063:205::/55,Azure
+	2603:1063:206::/55,Azure 2603:1063:207::/55,Azure 2603:1063:208::/55,Azure 2603:1063:209::/55,Azure 2603:1063:20a::/55,Azure 2603:1063:20b::/55,Azure
+	2603:1063:20c::/55,Azure 2603:1063:20d::/55,Azure 2603:1063:20e::/55,Azure 2603:1063:20f::/55,Azure 2603:1063:210::/55,Azure 2603:1063:211::/55,Azure
+	2603:1063:212::/55,Azure 2603:1063:213::/55,Azure 2603:1063:214::/55,Azure 2603:1063:215::/55,Azure 2603:1063:216::/55,Azure 2603:1063:217::/55,Azure
+	2603:1063:218::/55,Azure 2603:1063:219::/55,Azure 2603:1063:21a::/55,Azure 2603:1063:21b::/55,Azure 2603:1063:21c::/55,Azure 2603:1063:21d::/55,Azure
+	2603:1063:21e::/55,Azure 2603:1063:21f::/55,Azure 2603:1063:220::/55,Azure 2603:1063:221::/55,Azure 2603:1063:222::/55,Azure 2603:1063:223::/55,Azure
+	2603:1063:224::/55,Azure 2603:1063:225::/55,Azure 2603:1063:226::/55,Azure 2603:1063:227::/56,Azure 2603:1063:22b::/56,Azure 2603:1063:22c::/56,Azure
+	2603:1063:22d::/56,Azure 2603:1063:22e::/56,Azure 2603:1063:22f::/56,Azure 2603:1063:230::/56,Azure 2603:1063:231::/56,Azure 2603:1063:232::/56,Azure
+	2603:1063:233::/56,Azure 2603:1063:234::/56,Azure 2603:1063:235::/56,Azure 2603:1063:236::/56,Azure 2603:1063:400::/56,Azure 2603:1063:401::/56,Azure
+	2603:1063:402::/56,Azure 2603:1063:403::/56,Azure 2603:1063:404::/56,Azure 2603:1063:405::/56,Azure 2603:1063:406::/56,Azure 2603:1063:407::/56,Azure
+	2603:1063:408::/56,Azure 2603:1063:409::/56,Azure 2603:1063:40a::/56,Azure 2603:1063:40b::/56,Azure 2603:1063:40c::/56,Azure 2603:1063:40d::/56,Azure
+	2603:1063:40e::/56,Azure 2603:1063:40f::/56,Azure 2603:1063:410::/56,Azure 2603:1063:411::/56,Azure 2603:1063:412::/56,Azure 2603:1063:413::/56,Azure
+	2603:1063:414::/56,Azure 2603:1063:415::/64,Azure 2603:1063:416::/56,Azure 2603:1063:417::/56,Azure 2603:1063:418::/56,Azure 2603:1063:419::/56,Azure
+	2603:1063:41a::/56,Azure 2603:1063:41b::/56,Azure 2603:1063:41c::/56,Azure 2603:1063:41d::/56,Azure 2603:1063:41e::/56,Azure 2603:1063:41f::/56,Azure
+	2603:1063:420::/56,Azure 2603:1063:421::/56,Azure 2603:1063:422::/56,Azure 2603:1063:423::/56,Azure 2603:1063:424::/56,Azure 2603:1063:425::/56,Azure
+	2603:1063:426::/56,Azure 2603:1063:427::/56,Azure 2603:1063:428::/56,Azure 2603:1063:429::/56,Azure 2603:1063:42a::/56,Azure 2603:1063:42b::/56,Azure
+	2603:1063:42c::/56,Azure 2603:1063:42d::/56,Azure 2603:1063:42e::/56,Azure 2603:1063:42f::/56,Azure 2603:1063:430::/56,Azure 2603:1063:431::/56,Azure
+	2603:1063:432::/56,Azure 2603:1063:433::/56,Azure 2603:1063:434::/56,Azure 2603:1063:435::/55,Azure 2603:1063:600::/56,Azure 2603:1063:601::/56,Azure
+	2603:1063:602::/56,Azure 2603:1063:603::/56,Azure 2603:1063:604::/56,Azure 2603:1063:605::/56,Azure 2603:1063:606::/56,Azure 2603:1063:607::/56,Azure
+	2603:1063:608::/56,Azure 2603:1063:609::/56,Azure 2603:1063:60a::/56,Azure 2603:1063:60b::/56,Azure 2603:1063:60c::/56,Azure 2603:1063:60d::/56,Azure
+	2603:1063:60e::/56,Azure 2603:1063:60f::/56,Azure 2603:1063:610::/56,Azure 2603:1063:611::/56,Azure 2603:1063:612::/56,Azure 2603:1063:613::/56,Azure
+	2603:1063:614::/56,Azure 2603:1063:615::/56,Azure 2603:1063:616::/56,Azure 2603:1063:617::/56,Azure 2603:1063:618::/56,Azure 2603:1063:619::/56,Azure
+	2603:1063:61a::/56,Azure 2603:1063:61b::/56,Azure 2603:1063:61c::/56,Azure 2603:1063:61d::/56,Azure 2603:1063:61e::/56,Azure 2603:1063:61f::/56,Azure
+	2603:1063:620::/56,Azure 2603:1063:621::/56,Azure 2603:1063:622::/56,Azure 2603:1063:623::/56,Azure 2603:1063:624::/56,Azure 2603:1063:625::/56,Azure
+	2603:1063:626::/56,Azure 2603:1063:627::/56,Azure 2603:1063:628::/56,Azure 2603:1063:629::/56,Azure 2603:1063:62a::/56,Azure 2603:1063:62b::/56,Azure
+	2603:1063:62c::/56,Azure 2603:1063:62d::/56,Azure 2603:1063:62e::/56,Azure 2603:1063:62f::/56,Azure 2603:1063:630::/56,Azure 2603:1063:631::/56,Azure
+	2603:1063:632::/56,Azure 2603:1063:633::/56,Azure 2603:1063:634::/56,Azure 2603:1063:635::/56,Azure 2603:1063:636::/56,Azure 2603:1063:637::/56,Azure
+	2603:1063:638::/56,Azure 2603:1063:639::/56,Azure 2603:1063:700::/56,Azure 2603:1063:702::/56,Azure 2603:1063:703::/56,Azure 2603:1063:704::/56,Azure
+	2603:1063:705::/56,Azure 2603:1063:706::/56,Azure 2603:1063:707::/56,Azure 2603:1063:708::/56,Azure 2603:1063:709::/56,Azure 2603:1063:70a::/56,Azure
+	2603:1063:70b::/56,Azure 2603:1063:70c::/56,Azure 2603:1063:70d::/56,Azure 2603:1063:70e::/56,Azure 2603:1063:70f::/56,Azure 2603:1063:710::/56,Azure
+	2603:1063:711::/56,Azure 2603:1063:712::/56,Azure 2603:1063:713::/56,Azure 2603:1063:714::/56,Azure 2603:1063:715::/56,Azure 2603:1063:716::/56,Azure
+	2603:1063:717::/56,Azure 2603:1063:718::/56,Azure 2603:1063:719::/56,Azure 2603:1063:71a::/56,Azure 2603:1063:71b::/56,Azure 2603:1063:71c::/56,Azure
+	2603:1063:71d::/56,Azure 2603:1063:71e::/56,Azure 2603:1063:71f::/56,Azure 2603:1063:720::/56,Azure 2603:1063:721::/56,Azure 2603:1063:722::/56,Azure
+	2603:1063:723::/56,Azure 2603:1063:724::/56,Azure 2603:1063:725::/56,Azure 2603:1063:726::/56,Azure 2603:1063:727::/56,Azure 2603:1063:728::/56,Azure
+	2603:1063:729::/56,Azure 2603:1063:72a::/56,Azure 2603:1063:72b::/56,Azure 2603:1063:72c::/56,Azure 2603:1063:72d::/56,Azure 2603:1063:72e::/56,Azure
+	2603:1063:72f::/56,Azure 2603:1063:730::/56,Azure 2603:1063:731::/56,Azure 2603:1063:732::/56,Azure 2603:1063:1c00::/55,Azure 2603:1063:1c01::/55,Azure
+	2603:1063:1c02::/55,Azure 2603:1063:1c03::/55,Azure 2603:1063:1c04::/55,Azure 2603:1063:1c05::/55,Azure 2603:1063:1c06::/55,Azure 2603:1063:1c07::/55,Azure
+	2603:1063:1c08::/55,Azure 2603:1063:1c09::/55,Azure 2603:1063:1c0a::/55,Azure 2603:1063:1c0b::/55,Azure 2603:1063:1c0c::/55,Azure 2603:1063:1c0d::/55,Azure
+	2603:1063:1c0e::/55,Azure 2603:1063:1c0f::/55,Azure 2603:1063:1c10::/55,Azure 2603:1063:1c11::/55,Azure 2603:1063:1c12::/55,Azure 2603:1063:1c13::/55,Azure
+	2603:1063:2200::/64,Azure 2603:1063:2200:4::/64,Azure 2603:1063:2200:8::/64,Azure 2603:1063:2200:c::/64,Azure 2603:1063:2200:10::/64,Azure 2603:1063:2200:14::/64,Azure
+	2603:1063:2200:18::/64,Azure 2603:1063:2200:1c::/64,Azure 2603:1063:2200:20::/64,Azure 2603:1063:2200:24::/64,Azure 2603:1063:2200:28::/64,Azure 2603:1063:2200:2c::/64,Azure
+	2603:1063:2200:30::/64,Azure 2603:1063:2200:34::/64,Azure 2603:1063:2200:38::/64,Azure 2603:1063:2200:3c::/64,Azure 2603:1063:2202::/64,Azure 2603:1063:2202:4::/64,Azure
+	2603:1063:2202:8::/64,Azure 2603:1063:2202:c::/64,Azure 2603:1063:2202:10::/64,Azure 2603:1063:2202:14::/64,Azure 2603:1063:2202:18::/64,Azure 2603:1063:2202:1c::/64,Azure
+	2603:1063:2202:20::/64,Azure 2603:1063:2202:24::/64,Azure 2603:1063:2202:28::/64,Azure 2603:1063:2202:2c::/64,Azure 2603:1063:2202:30::/64,Azure 2603:1063:2202:34::/64,Azure
+	2603:1063:2202:38::/64,Azure 2603:1063:2202:3c::/64,Azure 2603:1063:2202:40::/64,Azure 2603:1063:2202:44::/64,Azure 2603:1063:2202:48::/64,Azure 2603:1063:2202:4c::/64,Azure
+	2603:1063:2202:50::/64,Azure 2603:1063:2202:54::/64,Azure 2603:1063:2202:58::/64,Azure 2603:1063:2202:5c::/64,Azure 2603:1063:2202:60::/64,Azure 2603:1063:2202:64::/64,Azure
+	2603:1063:2202:68::/64,Azure 2603:1063:2202:6c::/64,Azure 2603:1063:2202:70::/64,Azure 2603:1063:2202:74::/64,Azure 2603:1063:2202:78::/64,Azure 2603:1063:2202:7c::/64,Azure
+	2603:1063:2204::/64,Azure 2603:1063:2204:4::/64,Azure 2603:1063:2204:8::/64,Azure 2603:1063:2204:c::/64,Azure 2603:1063:2204:10::/64,Azure 2603:1063:2204:14::/64,Azure
+	2603:1063:2204:18::/64,Azure 2603:1063:2206::/64,Azure 2603:1063:2206:4::/64,Azure 2603:1063:2206:8::/64,Azure 2603:1063:2206:c::/64,Azure 2603:1063:2206:10::/64,Azure
+	2603:1063:2206:14::/64,Azure 2603:1063:2206:18::/64,Azure 2603:1063:2206:1c::/64,Azure 2603:1063:2206:20::/64,Azure 2603:1063:2206:24::/64,Azure 2603:1063:2206:28::/64,Azure
+	2603:1063:2206:2c::/64,Azure 2603:1063:2206:30::/64,Azure 2603:1063:2206:34::/64,Azure 2603:1063:2206:38::/64,Azure 2603:1063:2206:3c::/64,Azure 2603:1063:2206:40::/64,Azure
+	2603:1063:2206:44::/64,Azure 2603:1063:2206:48::/64,Azure 2603:1063:2206:4c::/64,Azure 2603:1063:2206:50::/64,Azure 2603:1063:2206:54::/64,Azure 2603:1063:2206:5c::/64,Azure
+	2603:1063:2400::-2603:1063:2408:0:ffff:ffff:ffff:ffff,Azure 2603:1063:2409::/64,Azure 2603:1063:240a::-2603:1063:243d:ffff:ffff:ffff:ffff:ffff,Azure 2603:1063:2600::-2603:1063:2608:ffff:ffff:ffff:ffff:ffff,Azure 2603:1063:260a::-2603:1063:263c:ffff:ffff:ffff:ffff:ffff,Azure 2603:1063:2800::-2603:1063:283b:ffff:ffff:ffff:ffff:ffff,Azure
+	2603:1063:2a00::-2603:1063:2a3b:ffff:ffff:ffff:ffff:ffff,Azure 2603:1063:c000::/44,Azure 2603:10e1:0:100::/56,Azure 2603:10e1:1::/48,Azure 2603:10e1:100:2::1415:e48/128,Azure 2603:10e1:100:2::1435:5552/128,Azure
+	2603:10e1:100:2::1448:bca0/128,Azure 2603:10e1:100:2::144c:f22d/128,Azure 2603:10e1:100:2::1458:b0aa/128,Azure 2603:10e1:100:2::1458:e0aa/128,Azure 2603:10e1:100:2::1476:62f3/128,Azure 2603:10e1:100:2::1479:6172/128,Azure
+	2603:10e1:100:2::14c3:6100/128,Azure 2603:10e1:100:2::14d7:8032/128,Azure 2603:10e1:100:2::14d7:80d6/128,Azure 2603:10e1:100:2::287d:67fb/128,Azure 2603:10e1:100:2::3368:a5a2/128,Azure 2603:10e1:100:2::348b:476/128,Azure
+	2603:10e1:100:2::34ba:290f/128,Azure 2603:10e1:100:2::34bf:e4f5/128,Azure 2604:2dc0::/32,OVH 2604:a880:0:1010::-2604:a880:0:1019:ffff:ffff:ffff:ffff,DigitalOcean 2604:a880:0:1020::-2604:a880:0:1029:ffff:ffff:ffff:ffff,DigitalOcean 2604:a880:0:2010::-2604:a880:0:2019:ffff:ffff:ffff:ffff,DigitalOcean
+	2604:a880:0:2020::-2604:a880:0:202a:ffff:ffff:ffff:ffff,DigitalOcean 2604:a880:1:20::/64,DigitalOcean 2604:a880:1:4a::/64,DigitalOcean 2604:a880:2:d0::/63,DigitalOcean 2604:a880:4:1d0::/64,DigitalOcean 2604:a880:5:1::/64,DigitalOcean
+	2604:a880:6:7::/64,DigitalOcean 2604:a880:7:7::/64,DigitalOcean 2604:a880:8:7::/64,DigitalOcean 2604:a880:400:d0::/63,DigitalOcean 2604:a880:800:10::-2604:a880:800:14:ffff:ffff:ffff:ffff,DigitalOcean 2604:a880:800:a1::/64,DigitalOcean
+	2604:a880:800:c1::/64,DigitalOcean 2604:a880:803::/48,DigitalOcean 2604:a880:cad:d0::/64,DigitalOcean 2605:9cc0:1ff0::/56,AWS 2605:9cc0:1ff0:400::-2605:9cc0:1ff0:9ff:ffff:ffff:ffff:ffff,AWS 2605:9cc0:1ff0:1000::/52,AWS
+	2605:9cc0:1ff0:2300::/56,AWS 2605:9cc0:1ff0:2800::/56,AWS 2605:9cc0:1ff0:2d00::/56,AWS 2605:9cc0:1ff0:3c00::/56,AWS 2605:9cc0:1ff0:4000::/54,AWS 2605:9cc0:1ff0:6000::/56,AWS
+	2605:9cc0:1ff0:7200::/56,AWS 2605:9cc0:1ff0:8000::/56,AWS 2605:9cc0:1ff0:8200::/56,AWS 2605:9cc0:1ff0:f800::/56,AWS 2605:9cc0:1fff:fc00::/54,AWS 2605:b140:1000::/48,AWS
+	2605:b140:9800::-2605:b140:9805:ffff:ffff:ffff:ffff:ffff,AWS 2605:b140:9900::-2605:b140:9906:ffff:ffff:ffff:ffff:ffff,AWS 2605:b140:9a00::-2605:b140:9a05:ffff:ffff:ffff:ffff:ffff,AWS 2606:f40::/39,AWS 2606:f40:400::/39,AWS 2606:f40:800::/40,AWS
+	2606:f40:a00::/40,AWS 2606:f40:1000::/39,AWS 2606:f40:1400::/40,AWS 2606:f40:1800::/40,AWS 2606:f40:2100::-2606:f40:22ff:ffff:ffff:ffff:ffff:ffff,AWS 2606:f40:2400::/40,AWS
+	2606:f40:2800::/40,AWS 2606:f40:2a00::/40,AWS 2606:f40:3200::/40,AWS 2606:f40:3e00::/40,AWS 2606:f40:4200::-2606:f40:47ff:ffff:ffff:ffff:ffff:ffff,AWS 2606:f40:4900::-2606:f40:4904:ffff:ffff:ffff:ffff:ffff,AWS
 	2606:f40:4b00::/40,AWS 2606:f40:5000::/39,AWS 2606:f40:5800::/40,AWS 2606:f40:6100::/40,AWS 2606:f40:8000::/39,AWS 2606:f40:8400::/39,AWS
-	2606:f40:8800::/40,AWS 2606:f40:8a00::/40,AWS 2606:f40:9000::/39,AWS 2606:f40:9400::/40,AWS 2606:f40:9800::/40,AWS 2606:f40:a100::/40,AWS
-	2606:f40:a200::/40,AWS 2606:f40:a400::/40,AWS 2606:f40:a800::/40,AWS 2606:f40:aa00::/40,AWS 2606:f40:b200::/40,AWS 2606:f40:be00::/40,AWS
-	2606:f40:c400::/39,AWS 2606:f40:d000::/39,AWS 2606:f40:d800::/40,AWS 2606:f40:e100::/40,AWS 2606:f40:ffd2::/48,AWS 2606:f40:ffd4::/48,AWS
-	2606:f40:ffe0::/48,AWS 2606:f40:ffe2::/48,AWS 2606:f40:ffe4::/48,AWS 2606:f40:ffe6::/48,AWS 2606:f40:ffe8::/48,AWS 2606:f40:ffeb::/48,AWS
-	2606:f40:ffed::/48,AWS 2606:f40:ffef::/48,AWS 2606:f40:fff0::/48,AWS 2606:f40:fff2::/48,AWS 2606:f40:fff5::/48,AWS 2606:f40:fff6::/47,AWS
-	2606:f40:fff8::/45,AWS 2606:7b40:901::/48,AWS 2606:7b40:903::/48,AWS 2606:7b40:9fc::/47,AWS 2606:7b40:a3e:200::/60,AWS 2606:7b40:a3e:270::/60,AWS
-	2606:7b40:a3e:280::/60,AWS 2606:7b40:1000:2000::/55,AWS 2606:7b40:1000:2270::/60,AWS 2606:7b40:1000:3000::/56,AWS 2606:7b40:1000:6200::/60,AWS 2606:7b40:1000:6220::/60,AWS
-	2606:7b40:1000:6240::/60,AWS 2606:7b40:1000:6280::/60,AWS 2606:7b40:1000:62a0::/60,AWS 2606:7b40:1000:62d0::/60,AWS 2606:7b40:1000:7000::/56,AWS 2606:7b40:1000:7200::/59,AWS
-	2606:7b40:1000:7220::/60,AWS 2606:7b40:1000:7240::/60,AWS 2606:7b40:1000:7270::/60,AWS 2606:7b40:1000:7280::/60,AWS 2606:7b40:1000:72a0::/60,AWS 2606:7b40:1000:72d0::/60,AWS
-	2606:7b40:1000:72e0::/60,AWS 2606:7b40:1000:8000::/56,AWS 2606:7b40:1042::/56,AWS 2606:7b40:1042:3200::/60,AWS 2606:7b40:10f4:3200::/60,AWS 2606:7b40:10f4:3220::/60,AWS
-	2606:7b40:10f4:3240::/60,AWS 2606:7b40:10f4:3280::/60,AWS 2606:7b40:10f4:32a0::/60,AWS 2606:7b40:10f4:32c0::/59,AWS 2606:7b40:10f4:32e0::/60,AWS 2606:7b40:10f8::/46,AWS
-	2606:7b40:10ff::/56,AWS 2606:7b40:10ff:7000::/56,AWS 2606:7b40:10ff:7270::/60,AWS 2606:7b40:10ff:8000::/56,AWS 2606:7b40:10ff:9000::/56,AWS 2606:7b40:10ff:a000::/56,AWS
-	2606:7b40:10ff:a200::/58,AWS 2606:7b40:10ff:a240::/59,AWS 2606:7b40:10ff:a270::/60,AWS 2606:7b40:10ff:a280::/59,AWS 2606:7b40:10ff:a2d0::/60,AWS 2606:7b40:10ff:a2e0::/60,AWS
-	2606:7b40:10ff:b000::/56,AWS 2606:7b40:10ff:c000::/56,AWS 2606:7b40:10ff:d000::/56,AWS 2606:7b40:10ff:d200::/58,AWS 2606:7b40:10ff:d240::/59,AWS 2606:7b40:10ff:d270::/60,AWS
-	2606:7b40:10ff:d280::/59,AWS 2606:7b40:10ff:d2d0::/60,AWS 2606:7b40:10ff:d2f0::/60,AWS 2606:7b40:10ff:e000::/56,AWS 2606:7b40:10ff:e270::/60,AWS 2606:7b40:10ff:f000::/56,AWS
-	2606:7b40:10ff:f270::/60,AWS 2606:7b40:10ff:ff00::/56,AWS 2606:7b40:1800::/40,AWS 2606:7b40:1a0f:c000::/60,AWS 2606:7b40:1a1f:c000::/60,AWS 2606:7b40:1a1f:c340::/60,AWS
-	2606:7b40:1a2c::/60,AWS 2606:7b40:1a2c:330::/60,AWS 2606:7b40:1a2f::/60,AWS 2606:7b40:1a2f:c000::/60,AWS 2606:7b40:1a2f:c200::/60,AWS 2606:7b40:1a2f:c240::/60,AWS
-	2606:7b40:1a2f:c310::/60,AWS 2606:7b40:1a2f:c320::/59,AWS 2606:7b40:1a2f:c340::/60,AWS 2606:7b40:1a3c:330::/60,AWS 2606:7b40:1a3f:4200::/60,AWS 2606:7b40:1a3f:4240::/60,AWS
-	2606:7b40:1a3f:c000::/60,AWS 2606:7b40:1a3f:c200::/60,AWS 2606:7b40:1a3f:c240::/60,AWS 2606:7b40:1a3f:c310::/60,AWS 2606:7b40:1a3f:c320::/59,AWS 2606:7b40:1a3f:c340::/60,AWS
+	2606:f40:8800::/40,AWS 2606:f40:8a00::/40,AWS 2606:f40:9000::/39,AWS 2606:f40:9400::/40,AWS 2606:f40:9800::/40,AWS 2606:f40:a100::-2606:f40:a2ff:ffff:ffff:ffff:ffff:ffff,AWS
+	2606:f40:a400::/40,AWS 2606:f40:a800::/40,AWS 2606:f40:aa00::/40,AWS 2606:f40:b200::/40,AWS 2606:f40:be00::/40,AWS 2606:f40:c400::/39,AWS
+	2606:f40:d000::/39,AWS 2606:f40:d800::/40,AWS 2606:f40:e100::/40,AWS 2606:f40:ffd2::/48,AWS 2606:f40:ffd4::/48,AWS 2606:f40:ffe0::/48,AWS
+	2606:f40:ffe2::/48,AWS 2606:f40:ffe4::/48,AWS 2606:f40:ffe6::/48,AWS 2606:f40:ffe8::/48,AWS 2606:f40:ffeb::/48,AWS 2606:f40:ffed::/48,AWS
+	2606:f40:ffef::-2606:f40:fff0:ffff:ffff:ffff:ffff:ffff,AWS 2606:f40:fff2::/48,AWS 2606:f40:fff5::-2606:f40:ffff:ffff:ffff:ffff:ffff:ffff,AWS 2606:7b40:901::/48,AWS 2606:7b40:903::/48,AWS 2606:7b40:9fc::/47,AWS
+	2606:7b40:a3e:200::/60,AWS 2606:7b40:a3e:270::-2606:7b40:a3e:28f:ffff:ffff:ffff:ffff,AWS 2606:7b40:1000:2000::/55,AWS 2606:7b40:1000:2270::/60,AWS 2606:7b40:1000:3000::/56,AWS 2606:7b40:1000:6200::/60,AWS
+	2606:7b40:1000:6220::/60,AWS 2606:7b40:1000:6240::/60,AWS 2606:7b40:1000:6280::/60,AWS 2606:7b40:1000:62a0::/60,AWS 2606:7b40:1000:62d0::/60,AWS 2606:7b40:1000:7000::/56,AWS
+	2606:7b40:1000:7200::-2606:7b40:1000:722f:ffff:ffff:ffff:ffff,AWS 2606:7b40:1000:7240::/60,AWS 2606:7b40:1000:7270::-2606:7b40:1000:728f:ffff:ffff:ffff:ffff,AWS 2606:7b40:1000:72a0::/60,AWS 2606:7b40:1000:72d0::-2606:7b40:1000:72ef:ffff:ffff:ffff:ffff,AWS 2606:7b40:1000:8000::/56,AWS
+	2606:7b40:1042::/56,AWS 2606:7b40:1042:3200::/60,AWS 2606:7b40:10f4:3200::/60,AWS 2606:7b40:10f4:3220::/60,AWS 2606:7b40:10f4:3240::/60,AWS 2606:7b40:10f4:3280::/60,AWS
+	2606:7b40:10f4:32a0::/60,AWS 2606:7b40:10f4:32c0::-2606:7b40:10f4:32ef:ffff:ffff:ffff:ffff,AWS 2606:7b40:10f8::/46,AWS 2606:7b40:10ff::/56,AWS 2606:7b40:10ff:7000::/56,AWS 2606:7b40:10ff:7270::/60,AWS
+	2606:7b40:10ff:8000::/56,AWS 2606:7b40:10ff:9000::/56,AWS 2606:7b40:10ff:a000::/56,AWS 2606:7b40:10ff:a200::-2606:7b40:10ff:a25f:ffff:ffff:ffff:ffff,AWS 2606:7b40:10ff:a270::-2606:7b40:10ff:a29f:ffff:ffff:ffff:ffff,AWS 2606:7b40:10ff:a2d0::-2606:7b40:10ff:a2ef:ffff:ffff:ffff:ffff,AWS
+	2606:7b40:10ff:b000::/56,AWS 2606:7b40:10ff:c000::/56,AWS 2606:7b40:10ff:d000::/56,AWS 2606:7b40:10ff:d200::-2606:7b40:10ff:d25f:ffff:ffff:ffff:ffff,AWS 2606:7b40:10ff:d270::-2606:7b40:10ff:d29f:ffff:ffff:ffff:ffff,AWS 2606:7b40:10ff:d2d0::/60,AWS
+	2606:7b40:10ff:d2f0::/60,AWS 2606:7b40:10ff:e000::/56,AWS 2606:7b40:10ff:e270::/60,AWS 2606:7b40:10ff:f000::/56,AWS 2606:7b40:10ff:f270::/60,AWS 2606:7b40:10ff:ff00::/56,AWS
+	2606:7b40:1800::/40,AWS 2606:7b40:1a0f:c000::/60,AWS 2606:7b40:1a1f:c000::/60,AWS 2606:7b40:1a1f:c340::/60,AWS 2606:7b40:1a2c::/60,AWS 2606:7b40:1a2c:330::/60,AWS
+	2606:7b40:1a2f::/60,AWS 2606:7b40:1a2f:c000::/60,AWS 2606:7b40:1a2f:c200::/60,AWS 2606:7b40:1a2f:c240::/60,AWS 2606:7b40:1a2f:c310::-2606:7b40:1a2f:c34f:ffff:ffff:ffff:ffff,AWS 2606:7b40:1a3c:330::/60,AWS
+	2606:7b40:1a3f:4200::/60,AWS 2606:7b40:1a3f:4240::/60,AWS 2606:7b40:1a3f:c000::/60,AWS 2606:7b40:1a3f:c200::/60,AWS 2606:7b40:1a3f:c240::/60,AWS 2606:7b40:1a3f:c310::-2606:7b40:1a3f:c34f:ffff:ffff:ffff:ffff,AWS
 	2606:7b40:1a45:c000::/60,AWS 2606:7b40:1a45:c340::/60,AWS 2606:7b40:1a46:8000::/60,AWS 2606:7b40:1a46:c000::/60,AWS 2606:7b40:1a47:4000::/60,AWS 2606:7b40:1a47:4340::/60,AWS
-	2606:7b40:1a47:c000::/60,AWS 2606:7b40:1a49::/60,AWS 2606:7b40:1a49:340::/60,AWS 2606:7b40:1a4c::/60,AWS 2606:7b40:1a4c:330::/60,AWS 2606:7b40:1a4c:340::/60,AWS
-	2606:7b40:1a4c:c000::/60,AWS 2606:7b40:1a4d::/60,AWS 2606:7b40:1a4d:4000::/60,AWS 2606:7b40:1a4d:4340::/60,AWS 2606:7b40:1a4d:8000::/60,AWS 2606:7b40:1a4d:8340::/60,AWS
-	2606:7b40:1a4d:c000::/60,AWS 2606:7b40:1a4d:c340::/60,AWS 2606:7b40:1a4e::/60,AWS 2606:7b40:1a4e:340::/60,AWS 2606:7b40:1a4e:4000::/60,AWS 2606:7b40:1a4f::/60,AWS
-	2606:7b40:1a4f:340::/60,AWS 2606:7b40:1a4f:4000::/60,AWS 2606:7b40:1a4f:4200::/60,AWS 2606:7b40:1a4f:4240::/60,AWS 2606:7b40:1a4f:4340::/60,AWS 2606:7b40:1a4f:8000::/60,AWS
-	2606:7b40:1a4f:c000::/60,AWS 2606:7b40:1a4f:c200::/60,AWS 2606:7b40:1a4f:c240::/60,AWS 2606:7b40:1a4f:c310::/60,AWS 2606:7b40:1a4f:c320::/59,AWS 2606:7b40:1a4f:c340::/60,AWS
-	2606:7b40:1b00:500::/56,AWS 2606:7b40:1b00:600::/55,AWS 2606:7b40:1b05::/56,AWS 2606:7b40:1b05:4000::/56,AWS 2606:7b40:1b05:c000::/55,AWS 2606:7b40:1b06::/56,AWS
-	2606:7b40:1b06:4000::/56,AWS 2606:7b40:1b06:8000::/55,AWS 2606:7b40:1b06:c000::/56,AWS 2606:7b40:1b07::/55,AWS 2606:7b40:1b07:200::/56,AWS 2606:7b40:1b07:4000::/55,AWS
-	2606:7b40:1b07:4200::/56,AWS 2606:7b40:1b07:8000::/56,AWS 2606:7b40:1b07:c000::/56,AWS 2606:7b40:1b08::/55,AWS 2606:7b40:1b08:200::/56,AWS 2606:7b40:1b09::/54,AWS
-	2606:7b40:1b09:400::/55,AWS 2606:7b40:1b0c::/54,AWS 2606:7b40:1b0c:4000::/55,AWS 2606:7b40:1b0c:c000::/55,AWS 2606:7b40:1b0d::/55,AWS 2606:7b40:1b0d:4000::/55,AWS
-	2606:7b40:1b0d:4200::/56,AWS 2606:7b40:1b0d:8000::/54,AWS 2606:7b40:1b0d:c000::/55,AWS 2606:7b40:1b0d:c200::/56,AWS 2606:7b40:1b0e::/55,AWS 2606:7b40:1b0e:200::/56,AWS
-	2606:7b40:1b0e:4000::/56,AWS 2606:7b40:1b0f::/53,AWS 2606:7b40:1b0f:800::/56,AWS 2606:7b40:1b0f:4000::/54,AWS 2606:7b40:1b0f:8000::/55,AWS 2606:7b40:1b0f:c000::/54,AWS
-	2606:7b40:1b0f:ef00::/56,AWS 2606:7b40:1b0f:f000::/54,AWS 2606:7b40:1b0f:f400::/56,AWS 2606:7b40:1b0f:f800::/55,AWS 2606:7b40:1b0f:fa00::/56,AWS 2606:7b40:1b0f:fd00::/56,AWS
-	2606:7b40:1b10::/44,AWS 2606:7b40:1b20::/43,AWS 2606:7b40:1b40::/42,AWS 2606:7b40:1b80::/44,AWS 2606:7b40:1f00::/40,AWS 2606:7b40:3000:8000::/52,AWS
-	2606:7b40:3001:8000::/52,AWS 2606:7b40:3002::/56,AWS 2606:7b40:300a::/56,AWS 2606:7b40:300b::/54,AWS 2606:7b40:f000:1500::/56,AWS 2606:7b40:f000:1600::/55,AWS
-	2606:8140:100::/40,AWS 2606:8140:200::/40,AWS 2606:8140:400::/39,AWS 2606:8140:900::/40,AWS 2607:5300::/32,OVH 2620:107:3000::/47,AWS
-	2620:107:3002::/64,AWS 2620:107:300f::/48,AWS 2620:107:4000::/64,AWS 2620:107:4000:2::/63,AWS 2620:107:4000:4::/63,AWS 2620:107:4000:9::/64,AWS
-	2620:107:4000:a::/64,AWS 2620:107:4000:40::/64,AWS 2620:107:4000:ff::/64,AWS 2620:107:4000:2000::/63,AWS 2620:107:4000:4000::/59,AWS 2620:107:4000:4100::/62,AWS
-	2620:107:4000:4104::/63,AWS 2620:107:4000:4200::/61,AWS 2620:107:4000:4208::/63,AWS 2620:107:4000:4700::/62,AWS 2620:107:4000:4704::/63,AWS 2620:107:4000:4800::/62,AWS
-	2620:107:4000:4c00::/56,AWS 2620:107:4000:7000::/55,AWS 2620:107:4000:7200::/56,AWS 2620:107:4000:7400::/56,AWS 2620:107:4000:7700::/56,AWS 2620:107:4000:7800::/56,AWS
-	2620:107:4000:7a00::/56,AWS 2620:107:4000:7c00::/56,AWS 2620:107:4000:8001::/64,AWS 2620:107:4000:8002::/64,AWS 2620:107:4000:8004::/64,AWS 2620:107:4000:8300::/56,AWS
-	2620:107:4000:8400::/54,AWS 2620:107:4000:8800::/55,AWS 2620:107:4000:8a00::/56,AWS 2620:107:4000:9000::/62,AWS 2620:107:4000:9005::/64,AWS 2620:107:4000:9006::/64,AWS
-	2620:107:4000:9009::/64,AWS 2620:107:4000:900a::/63,AWS 2620:107:4000:900c::/62,AWS 2620:107:4000:9010::/61,AWS 2620:107:4000:9018::/63,AWS 2620:107:4000:901b::/64,AWS
-	2620:107:4000:9900:50:80::/94,AWS 2620:107:4000:9900:50:84::/96,AWS 2620:107:4000:a080::/58,AWS 2620:107:4000:a840::/58,AWS 2620:107:4000:a880::/57,AWS 2620:107:4000:a900::/57,AWS
-	2620:107:4002::/47,AWS 2620:107:4004::/47,AWS 2620:107:4007::/64,AWS 2620:107:4008::/45,AWS 2620:108:7000::/44,AWS 2620:108:d000::/44,AWS
-	2620:1ec:4::/46,Azure 2620:1ec:a::/47,Azure 2620:1ec:c::/47,Azure 2620:1ec:12::/47,Azure 2620:1ec:21::/48,Azure 2620:1ec:22::/48,Azure
-	2620:1ec:26::/63,Azure 2620:1ec:26:2::/64,Azure 2620:1ec:27::/48,Azure 2620:1ec:28::/47,Azure 2620:1ec:32::/48,Azure 2620:1ec:33::/62,Azure
-	2620:1ec:34::/48,Azure 2620:1ec:39::/48,Azure 2620:1ec:3e::/47,Azure 2620:1ec:40::/44,Azure 2620:1ec:50::/47,Azure 2620:1ec:8f0::/44,Azure
-	2620:1ec:900::/44,Azure 2620:1ec:911::/48,Azure 2620:1ec:a92::/48,Azure 2620:1ec:bdf::/48,Azure 2620:1ec:c11::/48,Azure 2804:800::/48,AWS
-	2804:800:ff00::/48,AWS 2a01:111:20a::/48,Azure 2a01:111:2003::/48,Azure 2a01:111:202c::/46,Azure 2a01:111:2050::/44,Azure 2a01:111:f100:1000::/62,Azure
-	2a01:111:f100:1004::/63,Azure 2a01:111:f100:2000::/51,Azure 2a01:111:f100:4000::4625:492b/128,Azure 2a01:111:f100:4000::4625:4934/127,Azure 2a01:111:f100:4002::/64,Azure 2a01:111:f100:5000::/52,Azure
-	2a01:111:f100:6000::/64,Azure 2a01:111:f100:7000::6fdd:5343/128,Azure 2a01:111:f100:7000::6fdd:5431/128,Azure 2a01:111:f100:9001::1761:914d/128,Azure 2a01:111:f100:9001::1761:91b4/128,Azure 2a01:111:f100:9001::1761:91e4/128,Azure
-	2a01:111:f100:9001::1761:9323/128,Azure 2a01:111:f100:9001::1761:93a4/128,Azure 2a01:111:f100:9001::1761:953a/128,Azure 2a01:111:f100:9001::1761:958a/128,Azure 2a01:111:f100:9001::1761:9638/128,Azure 2a01:111:f100:9001::1761:9696/128,Azure
-	2a01:111:f100:9001::1761:970b/128,Azure 2a01:111:f100:9001::1761:970c/128,Azure 2a01:111:f100:9001::1761:970e/127,Azure 2a01:111:f100:9001::1761:97ac/128,Azure 2a01:111:f100:a000::/63,Azure 2a01:111:f100:a002::/64,Azure
-	2a01:111:f100:a004::/64,Azure 2a01:111:f102:8001::1761:4c10/128,Azure 2a01:111:f102:8001::1761:4f3b/128,Azure 2a01:111:f400::/48,Azure 2a01:111:f403::/48,Azure 2a01:4f8::/31,Hetzner
-	2a01:4ff::/39,Hetzner 2a01:4ff:ff01::/48,Hetzner 2a01:578:0:12::/63,AWS 2a01:578:0:7000::/55,AWS 2a01:578:0:7200::/56,AWS 2a01:578:0:7300::1/128,AWS
-	2a01:578:0:7301::1/128,AWS 2a01:578:0:7302::1/128,AWS 2a01:578:0:7400::/56,AWS 2a01:578:0:7500::1/128,AWS 2a01:578:0:7501::1/128,AWS 2a01:578:0:7502::1/128,AWS
-	2a01:578:0:7600::1/128,AWS 2a01:578:0:7601::1/128,AWS 2a01:578:0:7602::1/128,AWS 2a01:578:0:7700::/56,AWS 2a01:578:0:7800::/55,AWS 2a01:578:0:7a00::/56,AWS
-	2a01:578:3::/48,AWS 2a01:578:13::/48,AWS 2a01:7e00::/30,Linode 2a01:7e04::/32,Linode 2a03:b0c0:0:108::/64,DigitalOcean 2a03:b0c0:0:126::/63,DigitalOcean
-	2a03:b0c0:0:128::/63,DigitalOcean 2a03:b0c0:0:130::/61,DigitalOcean 2a03:b0c0:0:138::/63,DigitalOcean 2a03:b0c0:0:140::/61,DigitalOcean 2a03:b0c0:0:148::/63,DigitalOcean 2a03:b0c0:0:150::/62,DigitalOcean
-	2a03:b0c0:0:154::/63,DigitalOcean 2a03:b0c0:0:1010::/61,DigitalOcean 2a03:b0c0:0:1018::/63,DigitalOcean 2a03:b0c0:0:1020::/61,DigitalOcean 2a03:b0c0:0:1028::/63,DigitalOcean 2a03:b0c0:0:1030::/61,DigitalOcean
-	2a03:b0c0:0:1038::/63,DigitalOcean 2a03:b0c0:0:1040::/61,DigitalOcean 2a03:b0c0:0:1048::/63,DigitalOcean 2a03:b0c0:0:1050::/63,DigitalOcean 2a03:b0c0:1:a1::/64,DigitalOcean 2a03:b0c0:1:d0::/64,DigitalOcean
-	2a03:b0c0:1:e0::/64,DigitalOcean 2a03:b0c0:2:d0::/64,DigitalOcean 2a03:b0c0:2:f0::/64,DigitalOcean 2a03:b0c0:3:d0::/64,DigitalOcean 2a03:b0c0:3:e0::/64,DigitalOcean 2a03:b0c0:3:f0::/64,DigitalOcean
-	2a05:d000:800::/40,AWS 2a05:d000:1000::/40,AWS 2a05:d000:2000::/40,AWS 2a05:d000:4000::/40,AWS 2a05:d000:5000::/40,AWS 2a05:d000:6000::/40,AWS
-	2a05:d000:8000::/40,AWS 2a05:d000:9000::/40,AWS 2a05:d000:a000::/40,AWS 2a05:d000:c000::/40,AWS 2a05:d000:e000::/40,AWS 2a05:d010:8000::/36,AWS
-	2a05:d011::/36,AWS 2a05:d012::/36,AWS 2a05:d014::/35,AWS 2a05:d015::/36,AWS 2a05:d016::/36,AWS 2a05:d017::/36,AWS
-	2a05:d018::/35,AWS 2a05:d018:8000::/36,AWS 2a05:d019::/36,AWS 2a05:d01a::/36,AWS 2a05:d01b::/36,AWS 2a05:d01c::/35,AWS
-	2a05:d01d::/36,AWS 2a05:d01e::/36,AWS 2a05:d01f::/36,AWS 2a05:d020:8000::/36,AWS 2a05:d021::/36,AWS 2a05:d022::/36,AWS
-	2a05:d024::/36,AWS 2a05:d024:8000::/36,AWS 2a05:d025::/35,AWS 2a05:d026::/36,AWS 2a05:d027::/36,AWS 2a05:d028::/36,AWS
-	2a05:d028:8000::/36,AWS 2a05:d029::/36,AWS 2a05:d02a::/36,AWS 2a05:d02b::/36,AWS 2a05:d02c::/36,AWS 2a05:d02d::/36,AWS
-	2a05:d02e::/36,AWS 2a05:d02f::/36,AWS 2a05:d030:800::/40,AWS 2a05:d030:1000::/40,AWS 2a05:d030:2000::/40,AWS 2a05:d030:4000::/40,AWS
-	2a05:d030:5000::/40,AWS 2a05:d030:6000::/40,AWS 2a05:d030:8000::/40,AWS 2a05:d030:8800::/40,AWS 2a05:d030:9000::/40,AWS 2a05:d030:a000::/40,AWS
-	2a05:d030:b000::/40,AWS 2a05:d030:c000::/40,AWS 2a05:d030:e000::/40,AWS 2a05:d031:800::/40,AWS 2a05:d031:1000::/40,AWS 2a05:d031:2000::/40,AWS
-	2a05:d031:4000::/40,AWS 2a05:d031:5000::/40,AWS 2a05:d031:6000::/40,AWS 2a05:d031:8000::/40,AWS 2a05:d031:9000::/40,AWS 2a05:d031:a000::/40,AWS
-	2a05:d031:b000::/40,AWS 2a05:d031:c000::/40,AWS 2a05:d031:e000::/40,AWS 2a05:d032:800::/40,AWS 2a05:d032:1000::/40,AWS 2a05:d032:2000::/40,AWS
-	2a05:d032:4000::/40,AWS 2a05:d032:5000::/40,AWS 2a05:d032:6000::/40,AWS 2a05:d032:8000::/40,AWS 2a05:d032:9000::/40,AWS 2a05:d032:a000::/40,AWS
-	2a05:d032:b000::/40,AWS 2a05:d032:c000::/40,AWS 2a05:d032:e000::/40,AWS 2a05:d033:800::/40,AWS 2a05:d033:1000::/40,AWS 2a05:d033:2000::/40,AWS
-	2a05:d033:4000::/40,AWS 2a05:d033:5000::/40,AWS 2a05:d033:6000::/40,AWS 2a05:d033:8000::/40,AWS 2a05:d033:9000::/40,AWS 2a05:d033:a000::/40,AWS
-	2a05:d033:b000::/40,AWS 2a05:d033:c000::/40,AWS 2a05:d033:e000::/40,AWS 2a05:d036:800::/40,AWS 2a05:d036:1000::/40,AWS 2a05:d036:2000::/40,AWS
-	2a05:d036:4000::/40,AWS 2a05:d036:5000::/40,AWS 2a05:d036:6000::/40,AWS 2a05:d036:8000::/40,AWS 2a05:d036:9000::/40,AWS 2a05:d036:a000::/40,AWS
-	2a05:d036:b000::/40,AWS 2a05:d036:c000::/40,AWS 2a05:d036:e000::/40,AWS 2a05:d038:800::/40,AWS 2a05:d038:1000::/40,AWS 2a05:d038:2000::/40,AWS
-	2a05:d038:4000::/40,AWS 2a05:d038:5000::/40,AWS 2a05:d038:6000::/40,AWS 2a05:d038:8000::/40,AWS 2a05:d038:9000::/40,AWS 2a05:d038:a000::/40,AWS
-	2a05:d038:b000::/40,AWS 2a05:d038:c000::/40,AWS 2a05:d038:e000::/40,AWS 2a05:d03a:800::/40,AWS 2a05:d03a:1000::/40,AWS 2a05:d03a:2000::/40,AWS
-	2a05:d03a:4000::/40,AWS 2a05:d03a:5000::/40,AWS 2a05:d03a:6000::/40,AWS 2a05:d03a:8000::/40,AWS 2a05:d03a:9000::/40,AWS 2a05:d03a:a000::/40,AWS
-	2a05:d03a:b000::/40,AWS 2a05:d03a:c000::/40,AWS 2a05:d03a:e000::/40,AWS 2a05:d040:800::/40,AWS 2a05:d040:1000::/40,AWS 2a05:d040:2000::/40,AWS
-	2a05:d040:4000::/40,AWS 2a05:d040:5000::/40,AWS 2a05:d040:6000::/40,AWS 2a05:d040:8000::/40,AWS 2a05:d040:9000::/40,AWS 2a05:d040:a000::/40,AWS
-	2a05:d040:b000::/40,AWS 2a05:d040:c000::/40,AWS 2a05:d040:e000::/40,AWS 2a05:d050:800::/40,AWS 2a05:d050:1000::/40,AWS 2a05:d050:2000::/40,AWS
-	2a05:d050:4000::/40,AWS 2a05:d050:5000::/40,AWS 2a05:d050:6000::/40,AWS 2a05:d050:8000::/40,AWS 2a05:d050:9000::/40,AWS 2a05:d050:a000::/40,AWS
-	2a05:d050:b000::/40,AWS 2a05:d050:c000::/40,AWS 2a05:d050:e000::/40,AWS 2a05:d050:f000::/40,AWS 2a05:d059:800::/40,AWS 2a05:d059:1000::/40,AWS
-	2a05:d059:2000::/40,AWS 2a05:d059:4000::/40,AWS 2a05:d059:5000::/40,AWS 2a05:d059:6000::/40,AWS 2a05:d059:8000::/40,AWS 2a05:d059:9000::/40,AWS
-	2a05:d059:a000::/40,AWS 2a05:d059:b000::/40,AWS 2a05:d059:c000::/40,AWS 2a05:d059:e000::/40,AWS 2a05:d05a:800::/40,AWS 2a05:d05a:1000::/40,AWS
-	2a05:d05a:2000::/40,AWS 2a05:d05a:4000::/40,AWS 2a05:d05a:5000::/40,AWS 2a05:d05a:6000::/40,AWS 2a05:d05a:8000::/40,AWS 2a05:d05a:9000::/40,AWS
-	2a05:d05a:a000::/40,AWS 2a05:d05a:b000::/40,AWS 2a05:d05a:c000::/40,AWS 2a05:d05a:e000::/40,AWS 2a05:d05b:800::/40,AWS 2a05:d05b:1000::/40,AWS
-	2a05:d05b:2000::/40,AWS 2a05:d05b:4000::/40,AWS 2a05:d05b:5000::/40,AWS 2a05:d05b:6000::/40,AWS 2a05:d05b:8000::/40,AWS 2a05:d05b:9000::/40,AWS
-	2a05:d05b:a000::/40,AWS 2a05:d05b:b000::/40,AWS 2a05:d05b:c000::/40,AWS 2a05:d05b:e000::/40,AWS 2a05:d068:800::/40,AWS 2a05:d068:1000::/40,AWS
-	2a05:d068:2000::/40,AWS 2a05:d068:4000::/40,AWS 2a05:d068:5000::/40,AWS 2a05:d068:6000::/40,AWS 2a05:d068:8000::/40,AWS 2a05:d068:9000::/40,AWS
-	2a05:d068:a000::/40,AWS 2a05:d068:b000::/40,AWS 2a05:d068:c000::/40,AWS 2a05:d068:e000::/40,AWS 2a05:d06a:800::/40,AWS 2a05:d06a:1000::/40,AWS
-	2a05:d06a:2000::/40,AWS 2a05:d06a:4000::/40,AWS 2a05:d06a:5000::/40,AWS 2a05:d06a:6000::/40,AWS 2a05:d06a:8000::/40,AWS 2a05:d06a:9000::/40,AWS
-	2a05:d06a:a000::/40,AWS 2a05:d06a:b000::/40,AWS 2a05:d06a:c000::/40,AWS 2a05:d06a:e000::/40,AWS 2a05:d06b:800::/40,AWS 2a05:d06b:1000::/40,AWS
-	2a05:d06b:2000::/40,AWS 2a05:d06b:4000::/40,AWS 2a05:d06b:5000::/40,AWS 2a05:d06b:6000::/40,AWS 2a05:d06b:8000::/40,AWS 2a05:d06b:9000::/40,AWS
-	2a05:d06b:a000::/40,AWS 2a05:d06b:b000::/40,AWS 2a05:d06b:c000::/40,AWS 2a05:d06b:e000::/40,AWS 2a05:d06f:800::/40,AWS 2a05:d06f:1000::/40,AWS
-	2a05:d06f:2000::/40,AWS 2a05:d06f:4000::/40,AWS 2a05:d06f:5000::/40,AWS 2a05:d06f:6000::/40,AWS 2a05:d06f:8000::/40,AWS 2a05:d06f:9000::/40,AWS
-	2a05:d06f:a000::/40,AWS 2a05:d06f:b000::/40,AWS 2a05:d06f:c000::/40,AWS 2a05:d06f:e000::/40,AWS 2a05:d070:800::/40,AWS 2a05:d070:1000::/40,AWS
-	2a05:d070:2000::/40,AWS 2a05:d070:4000::/40,AWS 2a05:d070:5000::/40,AWS 2a05:d070:6000::/40,AWS 2a05:d070:8000::/40,AWS 2a05:d070:8800::/40,AWS
-	2a05:d070:9000::/40,AWS 2a05:d070:a000::/40,AWS 2a05:d070:b000::/40,AWS 2a05:d070:c000::/40,AWS 2a05:d070:e000::/40,AWS 2a05:d072:800::/40,AWS
-	2a05:d072:1000::/40,AWS 2a05:d072:2000::/40,AWS 2a05:d072:4000::/40,AWS 2a05:d072:5000::/40,AWS 2a05:d072:6000::/40,AWS 2a05:d072:8000::/40,AWS
-	2a05:d072:9000::/40,AWS 2a05:d072:a000::/40,AWS 2a05:d072:b000::/40,AWS 2a05:d072:c000::/40,AWS 2a05:d072:e000::/40,AWS 2a05:d073:800::/40,AWS
-	2a05:d073:1000::/40,AWS 2a05:d073:2000::/40,AWS 2a05:d073:4000::/40,AWS 2a05:d073:5000::/40,AWS 2a05:d073:6000::/40,AWS 2a05:d073:8000::/40,AWS
-	2a05:d073:9000::/40,AWS 2a05:d073:a000::/40,AWS 2a05:d073:b000::/40,AWS 2a05:d073:c000::/40,AWS 2a05:d073:e000::/40,AWS 2a05:d074:800::/40,AWS
-	2a05:d074:1000::/40,AWS 2a05:d074:2000::/40,AWS 2a05:d074:4000::/40,AWS 2a05:d074:5000::/40,AWS 2a05:d074:6000::/40,AWS 2a05:d074:8000::/40,AWS
-	2a05:d074:9000::/40,AWS 2a05:d074:a000::/40,AWS 2a05:d074:b000::/40,AWS 2a05:d074:c000::/40,AWS 2a05:d074:e000::/40,AWS 2a05:d076:800::/40,AWS
-	2a05:d076:1000::/40,AWS 2a05:d076:2000::/40,AWS 2a05:d076:4000::/40,AWS 2a05:d076:5000::/40,AWS 2a05:d076:6000::/40,AWS 2a05:d076:8000::/40,AWS
-	2a05:d076:9000::/40,AWS 2a05:d076:a000::/40,AWS 2a05:d076:b000::/40,AWS 2a05:d076:c000::/40,AWS 2a05:d076:e000::/40,AWS 2a05:d078:800::/40,AWS
-	2a05:d078:1000::/40,AWS 2a05:d078:2000::/40,AWS 2a05:d078:4000::/40,AWS 2a05:d078:5000::/40,AWS 2a05:d078:6000::/40,AWS 2a05:d078:8000::/40,AWS
-	2a05:d078:9000::/40,AWS 2a05:d078:a000::/40,AWS 2a05:d078:b000::/40,AWS 2a05:d078:c000::/40,AWS 2a05:d078:e000::/40,AWS 2a05:d078:f000::/40,AWS
-	2a05:d079:800::/40,AWS 2a05:d079:1000::/40,AWS 2a05:d079:2000::/40,AWS 2a05:d079:4000::/40,AWS 2a05:d079:5000::/40,AWS 2a05:d079:6000::/40,AWS
-	2a05:d079:8000::/40,AWS 2a05:d079:9000::/40,AWS 2a05:d079:a000::/40,AWS 2a05:d079:b000::/40,AWS 2a05:d079:c000::/40,AWS 2a05:d079:e000::/40,AWS
-	2a05:d079:f000::/40,AWS 2a05:d07a:2000::/40,AWS 2a05:d07a:4000::/40,AWS 2a05:d07a:6000::/40,AWS 2a05:d07a:8000::/40,AWS 2a05:d07a:a000::/40,AWS
-	2a05:d07a:c000::/40,AWS 2a05:d07a:e000::/40,AWS 2a05:d07c:1000::/40,AWS 2a05:d07c:2000::/40,AWS 2a05:d07c:4000::/40,AWS 2a05:d07c:5000::/40,AWS
-	2a05:d07c:6000::/40,AWS 2a05:d07c:8000::/40,AWS 2a05:d07c:9000::/40,AWS 2a05:d07c:a000::/40,AWS 2a05:d07c:c000::/40,AWS 2a05:d07c:e000::/40,AWS
-	2a05:d07d:800::/40,AWS 2a05:d07d:1000::/40,AWS 2a05:d07d:2000::/40,AWS 2a05:d07d:4000::/40,AWS 2a05:d07d:5000::/40,AWS 2a05:d07d:6000::/40,AWS
-	2a05:d07d:8000::/40,AWS 2a05:d07d:9000::/40,AWS 2a05:d07d:a000::/40,AWS 2a05:d07d:b000::/40,AWS 2a05:d07d:c000::/40,AWS 2a05:d07d:e000::/40,AWS
-	2a05:d07e:800::/40,AWS 2a05:d07e:1000::/40,AWS 2a05:d07e:2000::/40,AWS 2a05:d07e:4000::/40,AWS 2a05:d07e:5000::/40,AWS 2a05:d07e:6000::/40,AWS
-	2a05:d07e:8000::/40,AWS 2a05:d07e:9000::/40,AWS 2a05:d07e:a000::/40,AWS 2a05:d07e:b000::/40,AWS 2a05:d07e:c000::/40,AWS 2a05:d07e:e000::/40,AWS
-	2a05:d07e:f000::/40,AWS 2a05:d07f:800::/40,AWS 2a05:d07f:1000::/40,AWS 2a05:d07f:2000::/40,AWS 2a05:d07f:4000::/40,AWS 2a05:d07f:5000::/40,AWS
-	2a05:d07f:6000::/40,AWS 2a05:d07f:8000::/40,AWS 2a05:d07f:8800::/40,AWS 2a05:d07f:9000::/40,AWS 2a05:d07f:a000::/40,AWS 2a05:d07f:b000::/40,AWS
-	2a05:d07f:c000::/40,AWS 2a05:d07f:e000::/40,AWS 2a05:d07f:f000::/40,AWS 2a06:be80::/29,Hetzner 2a12:2240::/29,Hetzner
+	2606:7b40:1a47:c000::/60,AWS 2606:7b40:1a49::/60,AWS 2606:7b40:1a49:340::/60,AWS 2606:7b40:1a4c::/60,AWS 2606:7b40:1a4c:330::-2606:7b40:1a4c:34f:ffff:ffff:ffff:ffff,AWS 2606:7b40:1a4c:c000::/60,AWS
+	2606:7b40:1a4d::/60,AWS 2606:7b40:1a4d:4000::/60,AWS 2606:7b40:1a4d:4340::/60,AWS 2606:7b40:1a4d:8000::/60,AWS 2606:7b40:1a4d:8340::/60,AWS 2606:7b40:1a4d:c000::/60,AWS
+	2606:7b40:1a4d:c340::/60,AWS 2606:7b40:1a4e::/60,AWS 2606:7b40:1a4e:340::/60,AWS 2606:7b40:1a4e:4000::/60,AWS 2606:7b40:1a4f::/60,AWS 2606:7b40:1a4f:340::/60,AWS
+	2606:7b40:1a4f:4000::/60,AWS 2606:7b40:1a4f:4200::/60,AWS 2606:7b40:1a4f:4240::/60,AWS 2606:7b40:1a4f:4340::/60,AWS 2606:7b40:1a4f:8000::/60,AWS 2606:7b40:1a4f:c000::/60,AWS
+	2606:7b40:1a4f:c200::/60,AWS 2606:7b40:1a4f:c240::/60,AWS 2606:7b40:1a4f:c310::-2606:7b40:1a4f:c34f:ffff:ffff:ffff:ffff,AWS 2606:7b40:1b00:500::-2606:7b40:1b00:7ff:ffff:ffff:ffff:ffff,AWS 2606:7b40:1b05::/56,AWS 2606:7b40:1b05:4000::/56,AWS
+	2606:7b40:1b05:c000::/55,AWS 2606:7b40:1b06::/56,AWS 2606:7b40:1b06:4000::/56,AWS 2606:7b40:1b06:8000::/55,AWS 2606:7b40:1b06:c000::/56,AWS 2606:7b40:1b07::-2606:7b40:1b07:2ff:ffff:ffff:ffff:ffff,AWS
+	2606:7b40:1b07:4000::-2606:7b40:1b07:42ff:ffff:ffff:ffff:ffff,AWS 2606:7b40:1b07:8000::/56,AWS 2606:7b40:1b07:c000::/56,AWS 2606:7b40:1b08::-2606:7b40:1b08:2ff:ffff:ffff:ffff:ffff,AWS 2606:7b40:1b09::-2606:7b40:1b09:5ff:ffff:ffff:ffff:ffff,AWS 2606:7b40:1b0c::/54,AWS
+	2606:7b40:1b0c:4000::/55,AWS 2606:7b40:1b0c:c000::/55,AWS 2606:7b40:1b0d::/55,AWS 2606:7b40:1b0d:4000::-2606:7b40:1b0d:42ff:ffff:ffff:ffff:ffff,AWS 2606:7b40:1b0d:8000::/54,AWS 2606:7b40:1b0d:c000::-2606:7b40:1b0d:c2ff:ffff:ffff:ffff:ffff,AWS
+	2606:7b40:1b0e::-2606:7b40:1b0e:2ff:ffff:ffff:ffff:ffff,AWS 2606:7b40:1b0e:4000::/56,AWS 2606:7b40:1b0f::-2606:7b40:1b0f:8ff:ffff:ffff:ffff:ffff,AWS 2606:7b40:1b0f:4000::/54,AWS 2606:7b40:1b0f:8000::/55,AWS 2606:7b40:1b0f:c000::/54,AWS
+	2606:7b40:1b0f:ef00::-2606:7b40:1b0f:f4ff:ffff:ffff:ffff:ffff,AWS 2606:7b40:1b0f:f800::-2606:7b40:1b0f:faff:ffff:ffff:ffff:ffff,AWS 2606:7b40:1b0f:fd00::/56,AWS 2606:7b40:1b10::-2606:7b40:1b8f:ffff:ffff:ffff:ffff:ffff,AWS 2606:7b40:1f00::/40,AWS 2606:7b40:3000:8000::/52,AWS
+	2606:7b40:3001:8000::/52,AWS 2606:7b40:3002::/56,AWS 2606:7b40:300a::/56,AWS 2606:7b40:300b::/54,AWS 2606:7b40:f000:1500::-2606:7b40:f000:17ff:ffff:ffff:ffff:ffff,AWS 2606:8140:100::-2606:8140:2ff:ffff:ffff:ffff:ffff:ffff,AWS
+	2606:8140:400::/39,AWS 2606:8140:900::/40,AWS 2607:5300::/32,OVH 2607:f0d0::/32,IBMCloud 2620:107:3000::-2620:107:3002:0:ffff:ffff:ffff:ffff,AWS 2620:107:300f::/48,AWS
+	2620:107:4000::/64,AWS 2620:107:4000:2::-2620:107:4000:5:ffff:ffff:ffff:ffff,AWS 2620:107:4000:9::-2620:107:4000:a:ffff:ffff:ffff:ffff,AWS 2620:107:4000:40::/64,AWS 2620:107:4000:ff::/64,AWS 2620:107:4000:2000::/63,AWS
+	2620:107:4000:4000::/59,AWS 2620:107:4000:4100::-2620:107:4000:4105:ffff:ffff:ffff:ffff,AWS 2620:107:4000:4200::-2620:107:4000:4209:ffff:ffff:ffff:ffff,AWS 2620:107:4000:4700::-2620:107:4000:4705:ffff:ffff:ffff:ffff,AWS 2620:107:4000:4800::/62,AWS 2620:107:4000:4c00::/56,AWS
+	2620:107:4000:7000::-2620:107:4000:72ff:ffff:ffff:ffff:ffff,AWS 2620:107:4000:7400::/56,AWS 2620:107:4000:7700::-2620:107:4000:78ff:ffff:ffff:ffff:ffff,AWS 2620:107:4000:7a00::/56,AWS 2620:107:4000:7c00::/56,AWS 2620:107:4000:8001::-2620:107:4000:8002:ffff:ffff:ffff:ffff,AWS
+	2620:107:4000:8004::/64,AWS 2620:107:4000:8300::-2620:107:4000:8aff:ffff:ffff:ffff:ffff,AWS 2620:107:4000:9000::/62,AWS 2620:107:4000:9005::-2620:107:4000:9006:ffff:ffff:ffff:ffff,AWS 2620:107:4000:9009::-2620:107:4000:9019:ffff:ffff:ffff:ffff,AWS 2620:107:4000:901b::/64,AWS
+	2620:107:4000:9900:50:80::-2620:107:4000:9900:50:84:ffff:ffff,AWS 2620:107:4000:a080::/58,AWS 2620:107:4000:a840::-2620:107:4000:a97f:ffff:ffff:ffff:ffff,AWS 2620:107:4002::-2620:107:4005:ffff:ffff:ffff:ffff:ffff,AWS 2620:107:4007::/64,AWS 2620:107:4008::/45,AWS
+	2620:108:7000::/44,AWS 2620:108:d000::/44,AWS 2620:1ec:4::/46,Azure 2620:1ec:a::-2620:1ec:d:ffff:ffff:ffff:ffff:ffff,Azure 2620:1ec:12::/47,Azure 2620:1ec:21::-2620:1ec:22:ffff:ffff:ffff:ffff:ffff,Azure
+	2620:1ec:26::-2620:1ec:26:2:ffff:ffff:ffff:ffff,Azure 2620:1ec:27::-2620:1ec:29:ffff:ffff:ffff:ffff:ffff,Azure 2620:1ec:32::-2620:1ec:33:3:ffff:ffff:ffff:ffff,Azure 2620:1ec:34::/48,Azure 2620:1ec:39::/48,Azure 2620:1ec:3e::-2620:1ec:51:ffff:ffff:ffff:ffff:ffff,Azure
+	2620:1ec:8f0::-2620:1ec:90f:ffff:ffff:ffff:ffff:ffff,Azure 2620:1ec:911::/48,Azure 2620:1ec:a92::/48,Azure 2620:1ec:bdf::/48,Azure 2620:1ec:c11::/48,Azure 2804:800::/48,AWS
+	2804:800:ff00::/48,AWS 2a01:111:20a::/48,Azure 2a01:111:2003::/48,Azure 2a01:111:202c::/46,Azure 2a01:111:2050::/44,Azure 2a01:111:f100:1000::-2a01:111:f100:1005:ffff:ffff:ffff:ffff,Azure
+	2a01:111:f100:2000::/51,Azure 2a01:111:f100:4000::4625:492b/128,Azure 2a01:111:f100:4000::4625:4934/127,Azure 2a01:111:f100:4002::/64,Azure 2a01:111:f100:5000::-2a01:111:f100:6000:ffff:ffff:ffff:ffff,Azure 2a01:111:f100:7000::6fdd:5343/128,Azure
+	2a01:111:f100:7000::6fdd:5431/128,Azure 2a01:111:f100:9001::1761:914d/128,Azure 2a01:111:f100:9001::1761:91b4/128,Azure 2a01:111:f100:9001::1761:91e4/128,Azure 2a01:111:f100:9001::1761:9323/128,Azure 2a01:111:f100:9001::1761:93a4/128,Azure
+	2a01:111:f100:9001::1761:953a/128,Azure 2a01:111:f100:9001::1761:958a/128,Azure 2a01:111:f100:9001::1761:9638/128,Azure 2a01:111:f100:9001::1761:9696/128,Azure 2a01:111:f100:9001::1761:970b-2a01:111:f100:9001::1761:970c,Azure 2a01:111:f100:9001::1761:970e/127,Azure
+	2a01:111:f100:9001::1761:97ac/128,Azure 2a01:111:f100:a000::-2a01:111:f100:a002:ffff:ffff:ffff:ffff,Azure 2a01:111:f100:a004::/64,Azure 2a01:111:f102:8001::1761:4c10/128,Azure 2a01:111:f102:8001::1761:4f3b/128,Azure 2a01:111:f400::/48,Azure
+	2a01:111:f403::/48,Azure 2a01:4f8::/31,Hetzner 2a01:4ff::/39,Hetzner 2a01:4ff:ff01::/48,Hetzner 2a01:578:0:12::/63,AWS 2a01:578:0:7000::-2a01:578:0:72ff:ffff:ffff:ffff:ffff,AWS
+	2a01:578:0:7300::1/128,AWS 2a01:578:0:7301::1/128,AWS 2a01:578:0:7302::1/128,AWS 2a01:578:0:7400::/56,AWS 2a01:578:0:7500::1/128,AWS 2a01:578:0:7501::1/128,AWS
+	2a01:578:0:7502::1/128,AWS 2a01:578:0:7600::1/128,AWS 2a01:578:0:7601::1/128,AWS 2a01:578:0:7602::1/128,AWS 2a01:578:0:7700::-2a01:578:0:7aff:ffff:ffff:ffff:ffff,AWS 2a01:578:3::/48,AWS
+	2a01:578:13::/48,AWS 2a01:7e00::-2a01:7e04:ffff:ffff:ffff:ffff:ffff:ffff,Linode 2a02:c207::/32,Contabo 2a03:b0c0:0:108::/64,DigitalOcean 2a03:b0c0:0:126::-2a03:b0c0:0:129:ffff:ffff:ffff:ffff,DigitalOcean 2a03:b0c0:0:130::-2a03:b0c0:0:139:ffff:ffff:ffff:ffff,DigitalOcean
+	2a03:b0c0:0:140::-2a03:b0c0:0:149:ffff:ffff:ffff:ffff,DigitalOcean 2a03:b0c0:0:150::-2a03:b0c0:0:155:ffff:ffff:ffff:ffff,DigitalOcean 2a03:b0c0:0:1010::-2a03:b0c0:0:1019:ffff:ffff:ffff:ffff,DigitalOcean 2a03:b0c0:0:1020::-2a03:b0c0:0:1029:ffff:ffff:ffff:ffff,DigitalOcean 2a03:b0c0:0:1030::-2a03:b0c0:0:1039:ffff:ffff:ffff:ffff,DigitalOcean 2a03:b0c0:0:1040::-2a03:b0c0:0:1049:ffff:ffff:ffff:ffff,DigitalOcean
+	2a03:b0c0:0:1050::/63,DigitalOcean 2a03:b0c0:1:a1::/64,DigitalOcean 2a03:b0c0:1:d0::/64,DigitalOcean 2a03:b0c0:1:e0::/64,DigitalOcean 2a03:b0c0:2:d0::/64,DigitalOcean 2a03:b0c0:2:f0::/64,DigitalOcean
+	2a03:b0c0:3:d0::/64,DigitalOcean 2a03:b0c0:3:e0::/64,DigitalOcean 2a03:b0c0:3:f0::/64,DigitalOcean 2a05:d000:800::/40,AWS 2a05:d000:1000::/40,AWS 2a05:d000:2000::/40,AWS
+	2a05:d000:4000::/40,AWS 2a05:d000:5000::/40,AWS 2a05:d000:6000::/40,AWS 2a05:d000:8000::/40,AWS 2a05:d000:9000::/40,AWS 2a05:d000:a000::/40,AWS
+	2a05:d000:c000::/40,AWS 2a05:d000:e000::/40,AWS 2a05:d010:8000::/36,AWS 2a05:d011::/36,AWS 2a05:d012::/36,AWS 2a05:d014::/35,AWS
+	2a05:d015::/36,AWS 2a05:d016::/36,AWS 2a05:d017::/36,AWS 2a05:d018::/35,AWS 2a05:d018:8000::/36,AWS 2a05:d019::/36,AWS
+	2a05:d01a::/36,AWS 2a05:d01b::/36,AWS 2a05:d01c::/35,AWS 2a05:d01d::/36,AWS 2a05:d01e::/36,AWS 2a05:d01f::/36,AWS
+	2a05:d020:8000::/36,AWS 2a05:d021::/36,AWS 2a05:d022::/36,AWS 2a05:d024::/36,AWS 2a05:d024:8000::/36,AWS 2a05:d025::/35,AWS
+	2a05:d026::/36,AWS 2a05:d027::/36,AWS 2a05:d028::/36,AWS 2a05:d028:8000::/36,AWS 2a05:d029::/36,AWS 2a05:d02a::/36,AWS
+	2a05:d02b::/36,AWS 2a05:d02c::/36,AWS 2a05:d02d::/36,AWS 2a05:d02e::/36,AWS 2a05:d02f::/36,AWS 2a05:d030:800::/40,AWS
+	2a05:d030:1000::/40,AWS 2a05:d030:2000::/40,AWS 2a05:d030:4000::/40,AWS 2a05:d030:5000::/40,AWS 2a05:d030:6000::/40,AWS 2a05:d030:8000::/40,AWS
+	2a05:d030:8800::/40,AWS 2a05:d030:9000::/40,AWS 2a05:d030:a000::/40,AWS 2a05:d030:b000::/40,AWS 2a05:d030:c000::/40,AWS 2a05:d030:e000::/40,AWS
+	2a05:d031:800::/40,AWS 2a05:d031:1000::/40,AWS 2a05:d031:2000::/40,AWS 2a05:d031:4000::/40,AWS 2a05:d031:5000::/40,AWS 2a05:d031:6000::/40,AWS
+	2a05:d031:8000::/40,AWS 2a05:d031:9000::/40,AWS 2a05:d031:a000::/40,AWS 2a05:d031:b000::/40,AWS 2a05:d031:c000::/40,AWS 2a05:d031:e000::/40,AWS
+	2a05:d032:800::/40,AWS 2a05:d032:1000::/40,AWS 2a05:d032:2000::/40,AWS 2a05:d032:4000::/40,AWS 2a05:d032:5000::/40,AWS 2a05:d032:6000::/40,AWS
+	2a05:d032:8000::/40,AWS 2a05:d032:9000::/40,AWS 2a05:d032:a000::/40,AWS 2a05:d032:b000::/40,AWS 2a05:d032:c000::/40,AWS 2a05:d032:e000::/40,AWS
+	2a05:d033:800::/40,AWS 2a05:d033:1000::/40,AWS 2a05:d033:2000::/40,AWS 2a05:d033:4000::/40,AWS 2a05:d033:5000::/40,AWS 2a05:d033:6000::/40,AWS
+	2a05:d033:8000::/40,AWS 2a05:d033:9000::/40,AWS 2a05:d033:a000::/40,AWS 2a05:d033:b000::/40,AWS 2a05:d033:c000::/40,AWS 2a05:d033:e000::/40,AWS
+	2a05:d036:800::/40,AWS 2a05:d036:1000::/40,AWS 2a05:d036:2000::/40,AWS 2a05:d036:4000::/40,AWS 2a05:d036:5000::/40,AWS 2a05:d036:6000::/40,AWS
+	2a05:d036:8000::/40,AWS 2a05:d036:9000::/40,AWS 2a05:d036:a000::/40,AWS 2a05:d036:b000::/40,AWS 2a05:d036:c000::/40,AWS 2a05:d036:e000::/40,AWS
+	2a05:d038:800::/40,AWS 2a05:d038:1000::/40,AWS 2a05:d038:2000::/40,AWS 2a05:d038:4000::/40,AWS 2a05:d038:5000::/40,AWS 2a05:d038:6000::/40,AWS
+	2a05:d038:8000::/40,AWS 2a05:d038:9000::/40,AWS 2a05:d038:a000::/40,AWS 2a05:d038:b000::/40,AWS 2a05:d038:c000::/40,AWS 2a05:d038:e000::/40,AWS
+	2a05:d03a:800::/40,AWS 2a05:d03a:1000::/40,AWS 2a05:d03a:2000::/40,AWS 2a05:d03a:4000::/40,AWS 2a05:d03a:5000::/40,AWS 2a05:d03a:6000::/40,AWS
+	2a05:d03a:8000::/40,AWS 2a05:d03a:9000::/40,AWS 2a05:d03a:a000::/40,AWS 2a05:d03a:b000::/40,AWS 2a05:d03a:c000::/40,AWS 2a05:d03a:e000::/40,AWS
+	2a05:d040:800::/40,AWS 2a05:d040:1000::/40,AWS 2a05:d040:2000::/40,AWS 2a05:d040:4000::/40,AWS 2a05:d040:5000::/40,AWS 2a05:d040:6000::/40,AWS
+	2a05:d040:8000::/40,AWS 2a05:d040:9000::/40,AWS 2a05:d040:a000::/40,AWS 2a05:d040:b000::/40,AWS 2a05:d040:c000::/40,AWS 2a05:d040:e000::/40,AWS
+	2a05:d050:800::/40,AWS 2a05:d050:1000::/40,AWS 2a05:d050:2000::/40,AWS 2a05:d050:4000::/40,AWS 2a05:d050:5000::/40,AWS 2a05:d050:6000::/40,AWS
+	2a05:d050:8000::/40,AWS 2a05:d050:9000::/40,AWS 2a05:d050:a000::/40,AWS 2a05:d050:b000::/40,AWS 2a05:d050:c000::/40,AWS 2a05:d050:e000::/40,AWS
+	2a05:d050:f000::/40,AWS 2a05:d059:800::/40,AWS 2a05:d059:1000::/40,AWS 2a05:d059:2000::/40,AWS 2a05:d059:4000::/40,AWS 2a05:d059:5000::/40,AWS
+	2a05:d059:6000::/40,AWS 2a05:d059:8000::/40,AWS 2a05:d059:9000::/40,AWS 2a05:d059:a000::/40,AWS 2a05:d059:b000::/40,AWS 2a05:d059:c000::/40,AWS
+	2a05:d059:e000::/40,AWS 2a05:d05a:800::/40,AWS 2a05:d05a:1000::/40,AWS 2a05:d05a:2000::/40,AWS 2a05:d05a:4000::/40,AWS 2a05:d05a:5000::/40,AWS
+	2a05:d05a:6000::/40,AWS 2a05:d05a:8000::/40,AWS 2a05:d05a:9000::/40,AWS 2a05:d05a:a000::/40,AWS 2a05:d05a:b000::/40,AWS 2a05:d05a:c000::/40,AWS
+	2a05:d05a:e000::/40,AWS 2a05:d05b:800::/40,AWS 2a05:d05b:1000::/40,AWS 2a05:d05b:2000::/40,AWS 2a05:d05b:4000::/40,AWS 2a05:d05b:5000::/40,AWS
+	2a05:d05b:6000::/40,AWS 2a05:d05b:8000::/40,AWS 2a05:d05b:9000::/40,AWS 2a05:d05b:a000::/40,AWS 2a05:d05b:b000::/40,AWS 2a05:d05b:c000::/40,AWS
+	2a05:d05b:e000::/40,AWS 2a05:d068:800::/40,AWS 2a05:d068:1000::/40,AWS 2a05:d068:2000::/40,AWS 2a05:d068:4000::/40,AWS 2a05:d068:5000::/40,AWS
+	2a05:d068:6000::/40,AWS 2a05:d068:8000::/40,AWS 2a05:d068:9000::/40,AWS 2a05:d068:a000::/40,AWS 2a05:d068:b000::/40,AWS 2a05:d068:c000::/40,AWS
+	2a05:d068:e000::/40,AWS 2a05:d06a:800::/40,AWS 2a05:d06a:1000::/40,AWS 2a05:d06a:2000::/40,AWS 2a05:d06a:4000::/40,AWS 2a05:d06a:5000::/40,AWS
+	2a05:d06a:6000::/40,AWS 2a05:d06a:8000::/40,AWS 2a05:d06a:9000::/40,AWS 2a05:d06a:a000::/40,AWS 2a05:d06a:b000::/40,AWS 2a05:d06a:c000::/40,AWS
+	2a05:d06a:e000::/40,AWS 2a05:d06b:800::/40,AWS 2a05:d06b:1000::/40,AWS 2a05:d06b:2000::/40,AWS 2a05:d06b:4000::/40,AWS 2a05:d06b:5000::/40,AWS
+	2a05:d06b:6000::/40,AWS 2a05:d06b:8000::/40,AWS 2a05:d06b:9000::/40,AWS 2a05:d06b:a000::/40,AWS 2a05:d06b:b000::/40,AWS 2a05:d06b:c000::/40,AWS
+	2a05:d06b:e000::/40,AWS 2a05:d06f:800::/40,AWS 2a05:d06f:1000::/40,AWS 2a05:d06f:2000::/40,AWS 2a05:d06f:4000::/40,AWS 2a05:d06f:5000::/40,AWS
+	2a05:d06f:6000::/40,AWS 2a05:d06f:8000::/40,AWS 2a05:d06f:9000::/40,AWS 2a05:d06f:a000::/40,AWS 2a05:d06f:b000::/40,AWS 2a05:d06f:c000::/40,AWS
+	2a05:d06f:e000::/40,AWS 2a05:d070:800::/40,AWS 2a05:d070:1000::/40,AWS 2a05:d070:2000::/40,AWS 2a05:d070:4000::/40,AWS 2a05:d070:5000::/40,AWS
+	2a05:d070:6000::/40,AWS 2a05:d070:8000::/40,AWS 2a05:d070:8800::/40,AWS 2a05:d070:9000::/40,AWS 2a05:d070:a000::/40,AWS 2a05:d070:b000::/40,AWS
+	2a05:d070:c000::/40,AWS 2a05:d070:e000::/40,AWS 2a05:d072:800::/40,AWS 2a05:d072:1000::/40,AWS 2a05:d072:2000::/40,AWS 2a05:d072:4000::/40,AWS
+	2a05:d072:5000::/40,AWS 2a05:d072:6000::/40,AWS 2a05:d072:8000::/40,AWS 2a05:d072:9000::/40,AWS 2a05:d072:a000::/40,AWS 2a05:d072:b000::/40,AWS
+	2a05:d072:c000::/40,AWS 2a05:d072:e000::/40,AWS 2a05:d073:800::/40,AWS 2a05:d073:1000::/40,AWS 2a05:d073:2000::/40,AWS 2a05:d073:4000::/40,AWS
+	2a05:d073:5000::/40,AWS 2a05:d073:6000::/40,AWS 2a05:d073:8000::/40,AWS 2a05:d073:9000::/40,AWS 2a05:d073:a000::/40,AWS 2a05:d073:b000::/40,AWS
+	2a05:d073:c000::/40,AWS 2a05:d073:e000::/40,AWS 2a05:d074:800::/40,AWS 2a05:d074:1000::/40,AWS 2a05:d074:2000::/40,AWS 2a05:d074:4000::/40,AWS
+	2a05:d074:5000::/40,AWS 2a05:d074:6000::/40,AWS 2a05:d074:8000::/40,AWS 2a05:d074:9000::/40,AWS 2a05:d074:a000::/40,AWS 2a05:d074:b000::/40,AWS
+	2a05:d074:c000::/40,AWS 2a05:d074:e000::/40,AWS 2a05:d076:800::/40,AWS 2a05:d076:1000::/40,AWS 2a05:d076:2000::/40,AWS 2a05:d076:4000::/40,AWS
+	2a05:d076:5000::/40,AWS 2a05:d076:6000::/40,AWS 2a05:d076:8000::/40,AWS 2a05:d076:9000::/40,AWS 2a05:d076:a000::/40,AWS 2a05:d076:b000::/40,AWS
+	2a05:d076:c000::/40,AWS 2a05:d076:e000::/40,AWS 2a05:d078:800::/40,AWS 2a05:d078:1000::/40,AWS 2a05:d078:2000::/40,AWS 2a05:d078:4000::/40,AWS
+	2a05:d078:5000::/40,AWS 2a05:d078:6000::/40,AWS 2a05:d078:8000::/40,AWS 2a05:d078:9000::/40,AWS 2a05:d078:a000::/40,AWS 2a05:d078:b000::/40,AWS
+	2a05:d078:c000::/40,AWS 2a05:d078:e000::/40,AWS 2a05:d078:f000::/40,AWS 2a05:d079:800::/40,AWS 2a05:d079:1000::/40,AWS 2a05:d079:2000::/40,AWS
+	2a05:d079:4000::/40,AWS 2a05:d079:5000::/40,AWS 2a05:d079:6000::/40,AWS 2a05:d079:8000::/40,AWS 2a05:d079:9000::/40,AWS 2a05:d079:a000::/40,AWS
+	2a05:d079:b000::/40,AWS 2a05:d079:c000::/40,AWS 2a05:d079:e000::/40,AWS 2a05:d079:f000::/40,AWS 2a05:d07a:2000::/40,AWS 2a05:d07a:4000::/40,AWS
+	2a05:d07a:6000::/40,AWS 2a05:d07a:8000::/40,AWS 2a05:d07a:a000::/40,AWS 2a05:d07a:c000::/40,AWS 2a05:d07a:e000::/40,AWS 2a05:d07c:1000::/40,AWS
+	2a05:d07c:2000::/40,AWS 2a05:d07c:4000::/40,AWS 2a05:d07c:5000::/40,AWS 2a05:d07c:6000::/40,AWS 2a05:d07c:8000::/40,AWS 2a05:d07c:9000::/40,AWS
+	2a05:d07c:a000::/40,AWS 2a05:d07c:c000::/40,AWS 2a05:d07c:e000::/40,AWS 2a05:d07d:800::/40,AWS 2a05:d07d:1000::/40,AWS 2a05:d07d:2000::/40,AWS
+	2a05:d07d:4000::/40,AWS 2a05:d07d:5000::/40,AWS 2a05:d07d:6000::/40,AWS 2a05:d07d:8000::/40,AWS 2a05:d07d:9000::/40,AWS 2a05:d07d:a000::/40,AWS
+	2a05:d07d:b000::/40,AWS 2a05:d07d:c000::/40,AWS 2a05:d07d:e000::/40,AWS 2a05:d07e:800::/40,AWS 2a05:d07e:1000::/40,AWS 2a05:d07e:2000::/40,AWS
+	2a05:d07e:4000::/40,AWS 2a05:d07e:5000::/40,AWS 2a05:d07e:6000::/40,AWS 2a05:d07e:8000::/40,AWS 2a05:d07e:9000::/40,AWS 2a05:d07e:a000::/40,AWS
+	2a05:d07e:b000::/40,AWS 2a05:d07e:c000::/40,AWS 2a05:d07e:e000::/40,AWS 2a05:d07e:f000::/40,AWS 2a05:d07f:800::/40,AWS 2a05:d07f:1000::/40,AWS
+	2a05:d07f:2000::/40,AWS 2a05:d07f:4000::/40,AWS 2a05:d07f:5000::/40,AWS 2a05:d07f:6000::/40,AWS 2a05:d07f:8000::/40,AWS 2a05:d07f:8800::/40,AWS
+	2a05:d07f:9000::/40,AWS 2a05:d07f:a000::/40,AWS 2a05:d07f:b000::/40,AWS 2a05:d07f:c000::/40,AWS 2a05:d07f:e000::/40,AWS 2a05:d07f:f000::/40,AWS
+	2a06:98c0:3600::/40,CloudflareWorkers 2a06:be80::/29,Hetzner 2a09:8280::/32,FlyIO 2a12:2240::/29,Hetzner
 `
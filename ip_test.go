@@ -0,0 +1,27 @@
+package isbot
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIPRangeAddr(t *testing.T) {
+	tests := []struct {
+		in   netip.Addr
+		want Result
+	}{
+		{netip.Addr{}, NoBotKnown},
+		{netip.MustParseAddr("3.0.0.1"), BotRangeAWS},
+		{netip.MustParseAddr("::ffff:3.0.0.1"), BotRangeAWS},
+		{netip.MustParseAddr("2600:1fff:5000::1"), BotRangeAWS},
+		{netip.MustParseAddr("2600:1fff:5000::1%eth0"), BotRangeAWS},
+		{netip.MustParseAddr("fe80::1%eth0"), UnknownLinkLocal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in.String(), func(t *testing.T) {
+			if got := IPRangeAddr(tt.in); got != tt.want {
+				t.Errorf("got %s; want %s", got, tt.want)
+			}
+		})
+	}
+}
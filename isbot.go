@@ -13,6 +13,9 @@ import (
 type Result uint8
 
 func (r Result) String() string {
+	if p, ok := providers[r]; ok {
+		return strconv.Itoa(int(r)) + ": BotRange" + p
+	}
 	return strconv.Itoa(int(r)) + ": " + map[Result]string{
 		0:   "NoBotKnown",
 		1:   "NoBotNoMatch",
@@ -22,20 +25,36 @@ func (r Result) String() string {
 		5:   "BotKnownBot",
 		6:   "BotBoty",
 		7:   "BotShort",
-		8:   "BotRangeAWS",
-		9:   "BotRangeDigitalOcean",
-		10:  "BotRangeServersCom",
-		11:  "BotRangeGoogleCloud",
-		12:  "BotRangeHetzner",
-		13:  "BotRangeAzure",
-		14:  "BotRangeAlibaba",
-		15:  "BotRangeLinode",
-		16:  "BotRangeOracle",
-		17:  "BotRangeOVH",
+		60:  "BotRangeHosting",
+		70:  "BotRate",
+		71:  "BotBurst",
+		72:  "BotRegular",
+		73:  "BotNoAssets",
+		74:  "BotCluster",
+		75:  "BotSubnet",
+		76:  "BotHoneypot",
+		77:  "BotRobots",
+		78:  "BotBlocklist",
+		80:  "BotEdgeVerified",
+		81:  "BotEdgeScore",
+		90:  "UnknownLoopback",
+		91:  "UnknownPrivate",
+		92:  "UnknownCGNAT",
+		93:  "UnknownLinkLocal",
+		94:  "UnknownMulticast",
+		95:  "UnknownReserved",
+		100: "NoBotPrivacyPass",
+		101: "NoBotChallenge",
 		150: "BotJSPhanton",
 		151: "BotJSNightmare",
 		152: "BotJSSelenium",
 		153: "BotJSWebDriver",
+		154: "BotJSPuppeteer",
+		155: "BotJSPlaywright",
+		156: "BotJSCDP",
+		157: "BotJSNavigator",
+		158: "BotJSNoInteraction",
+		159: "BotJSInhuman",
 	}[r]
 }
 
@@ -43,6 +62,9 @@ func (r Result) String() string {
 const (
 	NoBotKnown   = 0 // Known to not be a bot.
 	NoBotNoMatch = 1 // None of the rules matches, so probably not a bot.
+
+	NoBotPrivacyPass = 100 // Presented a valid Privacy Pass token.
+	NoBotChallenge   = 101 // Passed a proof-of-work challenge.
 )
 
 // Bots identified by User-Agent.
@@ -55,44 +77,73 @@ const (
 	BotShort         = 7 // User-Agent is short of strangely formatted.
 )
 
-// Bots identified by IP.
+// Bots identified by IP; the BotRange* constants for cloud providers are in
+// ip_providers.go.
 const (
-	BotRangeAWS          = 8  // AWS cloud
-	BotRangeDigitalOcean = 9  // Digital Ocean
-	BotRangeServersCom   = 10 // servers.com
-	BotRangeGoogleCloud  = 11 // Google Cloud
-	BotRangeHetzner      = 12 // hetzner.de
-	BotRangeAzure        = 13 // Azure Cloud
-	BotRangeAlibaba      = 14 // Alibaba cloud
-	BotRangeLinode       = 15 // Linode
-	BotRangeOracle       = 16 // Oracle cloud
-	BotRangeOVH          = 17 // OVH Cloud
+	BotRangeHosting = 60 // Other hosting provider; see ASNDB.
 )
 
-// These are never set by isbot, but can be used to send signals from JS; for
-// example:
-//
-//	var is_bot = function() {
-//	    var w = window, d = document
-//	    if (w.callPhantom || w._phantom || w.phantom)
-//	        return 150
-//	    if (w.__nightmare)
-//	        return 151
-//	    if (d.__selenium_unwrapped || d.__webdriver_evaluate || d.__driver_evaluate)
-//	        return 152
-//	    if (navigator.webdriver)
-//	        return 153
-//	    return 0
-//	}
+// Bots identified by their requests over time; these are never set by Bot(),
+// but by Tracker, Sessions, etc.
+const (
+	BotRate      = 70 // Too many requests.
+	BotBurst     = 71 // Too many requests in a short time.
+	BotRegular   = 72 // Requests at very regular intervals.
+	BotNoAssets  = 73 // Loads pages, but never any CSS, images, etc.
+	BotCluster   = 74 // Same uncommon User-Agent and headers from many IPs.
+	BotSubnet    = 75 // Too many requests or clients from the same subnet.
+	BotHoneypot  = 76 // Requested a honeypot URL.
+	BotRobots    = 77 // Crawler that doesn't follow robots.txt.
+	BotBlocklist = 78 // On a Blocklist.
+)
+
+// Bots identified by a CDN; these are never set by Bot(), but by Edge.
 const (
-	BotJSPhanton   = 150 // Phantom headless browser.
-	BotJSNightmare = 151 // Nightmare headless browser.
-	BotJSSelenium  = 152 // Selenium headless browser.
-	BotJSWebDriver = 153 // Generic WebDriver-based headless browser.
+	BotEdgeVerified = 80 // Verified bot according to the CDN.
+	BotEdgeScore    = 81 // Low bot score from the CDN.
+)
+
+// Addresses that aren't publicly routable, so we can't say anything about them.
+// Seeing these usually means that the client address isn't resolved correctly,
+// for example because the Detector has no Resolver when running behind a load
+// balancer; see IsUnknown().
+const (
+	UnknownLoopback  = 90 // Loopback address (127.0.0.0/8, ::1).
+	UnknownPrivate   = 91 // Private address (RFC 1918, fc00::/7).
+	UnknownCGNAT     = 92 // Carrier-grade NAT (100.64.0.0/10).
+	UnknownLinkLocal = 93 // Link-local address (169.254.0.0/16, fe80::/10).
+	UnknownMulticast = 94 // Multicast address.
+	UnknownReserved  = 95 // Reserved, documentation, or unspecified address.
+)
+
+// These are never set by isbot, but can be used to send signals from JS; see
+// JS and Beacon.
+const (
+	BotJSPhanton    = 150 // Phantom headless browser.
+	BotJSNightmare  = 151 // Nightmare headless browser.
+	BotJSSelenium   = 152 // Selenium headless browser.
+	BotJSWebDriver  = 153 // Generic WebDriver-based headless browser.
+	BotJSPuppeteer  = 154 // Puppeteer.
+	BotJSPlaywright = 155 // Playwright.
+	BotJSCDP        = 156 // Controlled with the Chrome DevTools Protocol.
+	BotJSNavigator  = 157 // Inconsistent navigator properties.
+)
+
+// Bots identified by their behaviour in the browser; see Behaviour.
+const (
+	BotJSNoInteraction = 158 // No interaction at all.
+	BotJSInhuman       = 159 // Timing doesn't look human.
 )
 
 // Is this constant a bot?
-func Is(r Result) bool { return r != NoBotKnown && r != NoBotNoMatch }
+func Is(r Result) bool {
+	switch r {
+	case NoBotKnown, NoBotNoMatch, NoBotPrivacyPass, NoBotChallenge,
+		UnknownLoopback, UnknownPrivate, UnknownCGNAT, UnknownLinkLocal, UnknownMulticast, UnknownReserved:
+		return false
+	}
+	return true
+}
 
 // IsNot is the inverse of Is().
 func IsNot(r Result) bool { return !Is(r) }
@@ -103,12 +154,21 @@ func IsUserAgent(r Result) bool {
 	return r == BotLink || r == BotClientLibrary || r == BotKnownBot || r == BotBoty || r == BotShort
 }
 
+// IsUnknown reports if this is one of the Unknown* constants; it's not a bot,
+// but we also can't say anything about it as the address isn't publicly
+// routable.
+func IsUnknown(r Result) bool { return r >= UnknownLoopback && r <= UnknownReserved }
+
+// IsJS reports if this is a result sent from JS.
+func IsJS(r Result) bool { return r >= BotJSPhanton && r <= BotJSInhuman }
+
 // Bot checks if this HTTP request looks like a bot.
 //
 // It returns one of the constants as the reason we think this is a bot.
 //
 // This assumes that r.RemoteAddr is set to the real IP and does not check
-// X-Forwarded-For or X-Real-IP.
+// X-Forwarded-For or X-Real-IP; use a Detector with Proxies as the Resolver
+// for that.
 //
 // Note that both 0 and 1 may indicate that it's *not* a bot; use Is() and
 // IsNot() to check.
@@ -121,7 +181,7 @@ func Bot(r *http.Request) Result {
 	if Is(bot) {
 		return bot
 	}
-	return IPRange(r.RemoteAddr)
+	return IPRangeAddr(remoteAddr(r))
 }
 
 // Prefetch checks if this request is a browser "pre-fetch" request.
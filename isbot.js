@@ -0,0 +1,89 @@
+// isbot.js detects headless browsers and browser automation tools, and sends
+// the result to the server.
+//
+// Load it with the beacon URL and a nonce from Beacon.Nonce():
+//
+//   <script src="/isbot.js" data-beacon="/isbot" data-nonce="…"></script>
+//
+// The result code is one of the BotJS* constants, or 0 if it looks like a
+// regular browser. Without data-beacon nothing is sent, and you can call
+// window.isbot() yourself.
+//
+// Add data-cdp to also check for the Chrome DevTools Protocol (156). This logs
+// an empty error to the console, and also flags real users who have the
+// DevTools open, so it's not done by default.
+(function() {
+	'use strict';
+
+	var w = window, d = document, n = navigator, s = d.currentScript
+
+	// Chrome DevTools Protocol: tools that enable the Runtime domain (such as
+	// Puppeteer, Playwright, and most other CDP-based tools) serialize objects
+	// passed to console.*, which accesses the stack property. Note this is
+	// also true if the DevTools are open.
+	var cdp = function() {
+		if (!s || !('cdp' in s.dataset))
+			return false
+		var leak = false, e = new Error()
+		Object.defineProperty(e, 'stack', {get: function() {
+			leak = true
+			return ''
+		}})
+		console.debug(e)
+		return leak
+	}
+
+	// Properties that real browsers never get wrong, but which automation tools
+	// often forget to fake.
+	var inconsistent = function() {
+		var ua = n.userAgent || '', p = n.platform || ''
+		if (/HeadlessChrome/.test(ua))
+			return true
+		if (n.languages && n.languages.length === 0)
+			return true
+		if (/Windows/.test(ua) && !/^Win/.test(p))
+			return true
+		if (/Mac OS X/.test(ua) && !/^(Mac|iPhone|iPad|iPod)/.test(p))
+			return true
+		// Desktop Chrome always has window.chrome. Don't check navigator.plugins:
+		// it's empty if the PDF viewer is disabled.
+		if (/Chrome\//.test(ua) && !/Mobile|Android|CriOS|EdgiOS/.test(ua) && !w.chrome)
+			return true
+		return false
+	}
+
+	var isbot = function() {
+		var stack = new Error().stack || ''
+		var keys = Object.keys(w), dkeys = Object.keys(d)
+		var has = function(list, prefix) {
+			return list.some(function(k) { return k.indexOf(prefix) === 0 })
+		}
+
+		if (w.callPhantom || w._phantom || w.phantom)
+			return 150
+		if (w.__nightmare)
+			return 151
+		if (d.__selenium_unwrapped || d.__webdriver_evaluate || d.__driver_evaluate)
+			return 152
+		if (has(dkeys, '$cdc_') || has(keys, 'cdc_'))  // ChromeDriver
+			return 152
+		if (/__puppeteer_evaluation_script__|pptr:/.test(stack))
+			return 154
+		if (/__playwright_evaluation_script__/.test(stack) || has(keys, '__playwright') || has(keys, '__pw'))
+			return 155
+		if (n.webdriver)
+			return 153
+		if (cdp())
+			return 156
+		if (inconsistent())
+			return 157
+		return 0
+	}
+	w.isbot = isbot
+
+	if (!s || !s.dataset.beacon)
+		return
+	var body = new URLSearchParams({nonce: s.dataset.nonce || '', code: isbot()})
+	if (!n.sendBeacon || !n.sendBeacon(s.dataset.beacon, body))
+		fetch(s.dataset.beacon, {method: 'POST', body: body, keepalive: true})
+})();
@@ -3,6 +3,7 @@ package isbot
 import (
 	"bufio"
 	"net/http"
+	"net/netip"
 	"os"
 	"sort"
 	"strings"
@@ -152,6 +153,36 @@ func TestBotIP(t *testing.T) {
 		{"2a01:4f8:140:21ee::2", BotRangeHetzner},
 
 		{"88.213.0.0", NoBotNoMatch},
+
+		{"2a06:98c0:3600::103", BotRangeCloudflareWorkers},
+		{"108.61.1.1", BotRangeVultr},
+		{"2001:bc8::1", BotRangeScaleway},
+		{"169.60.1.1", BotRangeIBMCloud},
+		{"2a09:8280::1", BotRangeFlyIO},
+
+		{"35.180.1.1:1234", BotRangeAWS},
+		{"[2600:1fff:5000::1]:443", BotRangeAWS},
+		{"[::ffff:35.180.1.1]:443", BotRangeAWS},
+		{"64:ff9b::23b4:101", BotRangeAWS},
+		{"[2002:23b4:101::1]:443", BotRangeAWS},
+		{"2001:0:4136:e378:8000:63bf:dc4b:fefe", BotRangeAWS},
+		{"[fe80::1%eth0]:443", UnknownLinkLocal},
+
+		{"127.0.0.1:80", UnknownLoopback},
+		{"[::1]:80", UnknownLoopback},
+		{"10.1.2.3", UnknownPrivate},
+		{"192.168.1.1", UnknownPrivate},
+		{"fd00::1", UnknownPrivate},
+		{"100.64.0.1", UnknownCGNAT},
+		{"169.254.1.1", UnknownLinkLocal},
+		{"224.0.0.1", UnknownMulticast},
+		{"ff02::1", UnknownMulticast},
+		{"0.0.0.0", UnknownReserved},
+		{"198.51.100.1", UnknownReserved},
+		{"2001:db8::1", UnknownReserved},
+		{"255.255.255.255", UnknownReserved},
+		{"", NoBotKnown},
+		{"xxx:80", NoBotKnown},
 	}
 
 	for _, tt := range tests {
@@ -166,6 +197,103 @@ func TestBotIP(t *testing.T) {
 	}
 }
 
+func TestParseRanges(t *testing.T) {
+	tbl := parseRanges(`
+		3.2.34.0/26,AWS,af-south-1,EC2 13.32.0.0/15,AWS,GLOBAL
+		52.94.76.0/22,AWS,,AMAZON 2600:1f14::/35,AWS
+		3.4.12.1-3.4.12.6,AWS,us-east-1 2600:1f15::-2600:1f15:2fff:ffff:ffff:ffff:ffff:ffff,AWS
+	`)
+	tests := []struct {
+		addr, prefix, region, service string
+	}{
+		{"3.2.34.1", "3.2.34.0/26", "af-south-1", "EC2"},
+		{"13.33.0.1", "13.32.0.0/15", "GLOBAL", ""},
+		{"52.94.76.1", "52.94.76.0/22", "", "AMAZON"},
+		{"2600:1f14::1", "2600:1f14::/35", "", ""},
+		{"3.4.12.1", "3.4.12.1/32", "us-east-1", ""},
+		{"3.4.12.3", "3.4.12.2/31", "us-east-1", ""},
+		{"3.4.12.6", "3.4.12.6/32", "us-east-1", ""},
+		{"2600:1f15:2000::1", "2600:1f15:2000::/36", "", ""},
+		{"3.4.12.0", "", "", ""},
+		{"3.4.12.7", "", "", ""},
+		{"::ffff:3.4.12.0", "", "", ""},
+	}
+	for _, tt := range tests {
+		r, ok := tbl.lookup(netip.MustParseAddr(tt.addr))
+		if tt.prefix == "" {
+			if ok {
+				t.Errorf("%s: %v", tt.addr, r)
+			}
+			continue
+		}
+		if !ok || r.Prefix.String() != tt.prefix || r.Result != BotRangeAWS || r.Region != tt.region || r.Service != tt.service {
+			t.Errorf("%s: %v %t", tt.addr, r, ok)
+		}
+	}
+
+	var all []string
+	for _, r := range tbl.all() {
+		all = append(all, r.Prefix.String())
+	}
+	want := "3.2.34.0/26 3.4.12.1/32 3.4.12.2/31 3.4.12.4/31 3.4.12.6/32 13.32.0.0/15 52.94.76.0/22 " +
+		"2600:1f14::/35 2600:1f15::/35 2600:1f15:2000::/36"
+	if g := strings.Join(all, " "); g != want {
+		t.Errorf("all:\ngot:  %s\nwant: %s", g, want)
+	}
+}
+
+func TestResultString(t *testing.T) {
+	tests := []struct {
+		in   Result
+		want string
+	}{
+		{NoBotNoMatch, "1: NoBotNoMatch"},
+		{BotRangeAWS, "8: BotRangeAWS"},
+		{BotRangeCloudflareWorkers, "26: BotRangeCloudflareWorkers"},
+		{BotRangeFlyIO, "27: BotRangeFlyIO"},
+		{BotRangeHosting, "60: BotRangeHosting"},
+		{UnknownPrivate, "91: UnknownPrivate"},
+	}
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("got %q; want %q", got, tt.want)
+		}
+	}
+}
+
+func TestParseAddr(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"192.0.2.1", "192.0.2.1"},
+		{" 192.0.2.1:80 ", "192.0.2.1"},
+		{"2001:db8::1", "2001:db8::1"},
+		{"[2001:db8::1]", "2001:db8::1"},
+		{"[2001:db8::1]:80", "2001:db8::1"},
+		{"fe80::1%eth0", "fe80::1"},
+		{"[fe80::1%eth0]:80", "fe80::1"},
+		{"::ffff:192.0.2.1", "192.0.2.1"},
+		{"64:ff9b::192.0.2.1", "192.0.2.1"},
+		{"2002:c000:201::1", "192.0.2.1"},
+		{"2001:0:4136:e378:8000:63bf:3fff:fdfe", "192.0.2.1"},
+
+		{"", "invalid IP"},
+		{"[192.0.2.1", "invalid IP"},
+		{"example.com:80", "invalid IP"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseAddr(tt.in)
+			if got.String() != tt.want {
+				t.Errorf("got %q; want %q", got, tt.want)
+			}
+			if (err != nil) != !got.IsValid() {
+				t.Errorf("err: %v", err)
+			}
+		})
+	}
+}
+
 func TestBotUA(t *testing.T) {
 	var fail []string
 	for _, b := range bots {
@@ -0,0 +1,167 @@
+package isbot
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// List is a list of IP ranges, such as an abuse or reputation feed.
+//
+// List implements Ranges, so it can be added to Detector.Ranges.
+type List struct {
+	name   string
+	result Result
+	tab    rangeTable
+}
+
+// LoadList loads a list from a file, using the extension for the format; see
+// ParseList.
+//
+// Every address in the list gets the given name and result.
+func LoadList(path, name string, result Result) (*List, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("isbot.LoadList: %w", err)
+	}
+	defer fp.Close()
+
+	l, err := ParseList(fp, strings.TrimPrefix(filepath.Ext(path), "."), name, result)
+	if err != nil {
+		return nil, fmt.Errorf("isbot.LoadList: %s: %w", path, err)
+	}
+	return l, nil
+}
+
+// ParseList parses a list of addresses or CIDR prefixes.
+//
+// The supported formats are:
+//
+//   - "txt", "netset", "ipset": one address or prefix per line, as used by
+//     FireHOL and most other lists. Everything after a "#" or ";" is a
+//     comment.
+//   - "csv": the address or prefix is in the first column. A header row is
+//     skipped.
+//   - "json": an array of strings, or an array of objects with the address or
+//     prefix in the "prefix", "cidr", "network", or "ip" key.
+func ParseList(r io.Reader, format, name string, result Result) (*List, error) {
+	var (
+		l   = &List{name: name, result: result}
+		err error
+	)
+	switch strings.ToLower(format) {
+	case "txt", "netset", "ipset", "":
+		err = l.parseText(r)
+	case "csv":
+		err = l.parseCSV(r)
+	case "json":
+		err = l.parseJSON(r)
+	default:
+		err = fmt.Errorf("unknown format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Lookup implements Ranges.
+func (l *List) Lookup(addr netip.Addr) (Range, bool) {
+	if !addr.IsValid() {
+		return Range{}, false
+	}
+	return l.tab.lookup(addr)
+}
+
+// Len returns the number of ranges in the list.
+func (l *List) Len() int { return l.tab.len() }
+
+func (l *List) add(s string) error {
+	s = strings.TrimSpace(s)
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		addr, err2 := netip.ParseAddr(s)
+		if err2 != nil {
+			return err
+		}
+		p = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	p = p.Masked()
+	if p.Addr().Is4In6() && p.Bits() >= 96 {
+		p = netip.PrefixFrom(p.Addr().Unmap(), p.Bits()-96)
+	}
+	l.tab.add(Range{Prefix: p, Result: l.result, Name: l.name})
+	return nil
+}
+
+func (l *List) parseText(r io.Reader) error {
+	scan := bufio.NewScanner(r)
+	for i := 1; scan.Scan(); i++ {
+		line := scan.Text()
+		if c := strings.IndexAny(line, "#;"); c > -1 {
+			line = line[:c]
+		}
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		if err := l.add(line); err != nil {
+			return fmt.Errorf("line %d: %w", i, err)
+		}
+	}
+	return scan.Err()
+}
+
+func (l *List) parseCSV(r io.Reader) error {
+	c := csv.NewReader(r)
+	c.FieldsPerRecord, c.Comment, c.TrimLeadingSpace = -1, '#', true
+	for i := 1; ; i++ {
+		rec, err := c.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(rec) == 0 || strings.TrimSpace(rec[0]) == "" {
+			continue
+		}
+		if err := l.add(rec[0]); err != nil {
+			if i == 1 { // Header
+				continue
+			}
+			line, _ := c.FieldPos(0)
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+func (l *List) parseJSON(r io.Reader) error {
+	var list []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&list); err != nil {
+		return err
+	}
+	for i, raw := range list {
+		var s string
+		if json.Unmarshal(raw, &s) != nil {
+			var obj struct{ Prefix, CIDR, Network, IP string }
+			if err := json.Unmarshal(raw, &obj); err != nil {
+				return fmt.Errorf("entry %d: %w", i, err)
+			}
+			s = cmp.Or(obj.Prefix, obj.CIDR, obj.Network, obj.IP)
+			if s == "" {
+				return fmt.Errorf("entry %d: no prefix, cidr, network, or ip key", i)
+			}
+		}
+		if err := l.add(s); err != nil {
+			return fmt.Errorf("entry %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package isbot
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestParseList(t *testing.T) {
+	tests := []struct {
+		format, in string
+	}{
+		{"txt", "# Comment\n192.0.2.0/24 ; Another comment\n\n  198.51.100.7\n2001:db8::/32\n::ffff:203.0.113.0/120\n"},
+		{"csv", "network,country\n192.0.2.0/24,NL\n198.51.100.7,NL\n# Comment\n2001:db8::/32,DE\n203.0.113.0/24,US\n"},
+		{"json", `["192.0.2.0/24", {"ip": "198.51.100.7"}, {"CIDR": "2001:db8::/32"}, {"network": "203.0.113.0/24"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			l, err := ParseList(strings.NewReader(tt.in), tt.format, "Test", BotRate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if l.Len() != 4 {
+				t.Errorf("len: %d", l.Len())
+			}
+			for _, a := range []string{"192.0.2.1", "198.51.100.7", "2001:db8::1", "203.0.113.255", "::ffff:192.0.2.1"} {
+				r, ok := l.Lookup(netip.MustParseAddr(a))
+				if !ok || r.Result != BotRate || r.Name != "Test" {
+					t.Errorf("%s: %v %v", a, r, ok)
+				}
+			}
+			for _, a := range []string{"198.51.100.8", "2001:db9::1", "10.0.0.1"} {
+				if r, ok := l.Lookup(netip.MustParseAddr(a)); ok {
+					t.Errorf("%s: %v", a, r)
+				}
+			}
+		})
+	}
+
+	for _, tt := range []struct{ format, in string }{
+		{"txt", "192.0.2.0/24\nxxx\n"},
+		{"csv", "network\n192.0.2.0/24\nxxx\n"},
+		{"json", `[{"asn": 1}]`},
+		{"xml", ""},
+	} {
+		if _, err := ParseList(strings.NewReader(tt.in), tt.format, "", BotRate); err == nil {
+			t.Errorf("no error for %s: %q", tt.format, tt.in)
+		}
+	}
+}
+
+func TestLoadList(t *testing.T) {
+	l, err := LoadList("testdata/list.netset", "Abuse", BotHoneypot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := Detector{Ranges: []Ranges{l}}
+	if got := d.IPRange(netip.MustParseAddr("198.51.100.7")); got != BotHoneypot {
+		t.Errorf("got %s", got)
+	}
+	if got := d.IPRange(netip.MustParseAddr("198.51.100.8")); got != UnknownReserved {
+		t.Errorf("got %s", got)
+	}
+
+	// Wide prefixes.
+	l, _ = ParseList(strings.NewReader("0.0.0.0/1\n2000::/3"), "txt", "", BotRate)
+	for _, a := range []string{"1.2.3.4", "2a00::1"} {
+		if _, ok := l.Lookup(netip.MustParseAddr(a)); !ok {
+			t.Errorf("%s: no match", a)
+		}
+	}
+}
@@ -0,0 +1,389 @@
+package isbot
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// ASNDB is an ASN database in the MaxMind DB (MMDB) format, such as
+// GeoLite2-ASN, or the ASN databases from IPinfo and DB-IP.
+//
+// ASNDB implements Ranges; Lookup() only finds addresses from hosting
+// providers, which are listed in hostingASN.
+type ASNDB struct {
+	// Type and build time from the metadata.
+	Type  string
+	Built time.Time
+
+	data       []byte
+	nodeCount  uint32
+	recordSize int
+	ipVersion  int
+	treeSize   int
+	ipv4Start  uint32
+	ipv4Depth  int
+}
+
+var asnDB atomic.Pointer[ASNDB]
+
+// SetASNDB sets the ASN database that IPRange() uses for addresses that aren't
+// in any of the ranges from the DB. It's not used if a is nil, which is the
+// default.
+func SetASNDB(a *ASNDB) { asnDB.Store(a) }
+
+// LookupIP finds the range for this address in the active DB, or the ASN
+// database set with SetASNDB().
+//
+// This is like IPRangeAddr(), but returns more details. The Region and Service
+// are only set if the DB has them, which depends on the data it was generated
+// from; the built-in DB may not have them. The ASN and Org are only set for
+// matches from the ASN database.
+func LookupIP(addr netip.Addr) (Range, bool) {
+	if !addr.IsValid() {
+		return Range{}, false
+	}
+	addr = normalizeAddr(addr)
+	if r, ok := ActiveDB().Lookup(addr); ok {
+		return r, true
+	}
+	if a := asnDB.Load(); a != nil {
+		return a.Lookup(addr)
+	}
+	return Range{}, false
+}
+
+// LoadASNDB loads an ASN database from a file.
+func LoadASNDB(path string) (*ASNDB, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("isbot.LoadASNDB: %w", err)
+	}
+	a, err := ParseASNDB(data)
+	if err != nil {
+		return nil, fmt.Errorf("isbot.LoadASNDB: %s: %w", path, err)
+	}
+	return a, nil
+}
+
+var mmdbMarker = []byte("\xab\xcd\xefMaxMind.com")
+
+// ParseASNDB parses an ASN database. The data is used as-is, and shouldn't be
+// modified afterwards.
+func ParseASNDB(data []byte) (*ASNDB, error) {
+	start := max(0, len(data)-128*1024)
+	i := bytes.LastIndex(data[start:], mmdbMarker)
+	if i == -1 {
+		return nil, errors.New("not an MMDB file: no metadata")
+	}
+	meta, _, err := mmdbDecoder(data[start+i+len(mmdbMarker):]).decode(0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("reading metadata: %w", err)
+	}
+	m, ok := meta.(map[string]any)
+	if !ok {
+		return nil, errors.New("metadata is not a map")
+	}
+
+	a := &ASNDB{data: data}
+	a.Type, _ = m["database_type"].(string)
+	if e, ok := m["build_epoch"].(uint64); ok {
+		a.Built = time.Unix(int64(e), 0).UTC()
+	}
+	nc, _ := m["node_count"].(uint64)
+	rs, _ := m["record_size"].(uint64)
+	iv, _ := m["ip_version"].(uint64)
+	if nc == 0 || nc > math.MaxUint32/2 || (rs != 24 && rs != 28 && rs != 32) || (iv != 4 && iv != 6) {
+		return nil, fmt.Errorf("invalid metadata: node_count=%d record_size=%d ip_version=%d", nc, rs, iv)
+	}
+	a.nodeCount, a.recordSize, a.ipVersion = uint32(nc), int(rs), int(iv)
+	a.treeSize = int(nc) * int(rs) / 4
+	if a.treeSize+16 > start+i {
+		return nil, errors.New("search tree is larger than the file")
+	}
+
+	if a.ipVersion == 6 {
+		for ; a.ipv4Depth < 96 && a.ipv4Start < a.nodeCount; a.ipv4Depth++ {
+			a.ipv4Start = a.record(a.ipv4Start, 0)
+		}
+	}
+	return a, nil
+}
+
+// record gets the left (0) or right (1) record of a node.
+func (a *ASNDB) record(node uint32, bit byte) uint32 {
+	off := int(node) * a.recordSize / 4
+	b := a.data[off : off+a.recordSize/4]
+	switch a.recordSize {
+	case 24:
+		b = b[bit*3:]
+		return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+	case 28:
+		if bit == 0 {
+			return uint32(b[3]&0xf0)<<20 | uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+		}
+		return uint32(b[3]&0x0f)<<24 | uint32(b[4])<<16 | uint32(b[5])<<8 | uint32(b[6])
+	default:
+		return binary.BigEndian.Uint32(b[bit*4:])
+	}
+}
+
+// find the data for this address, and the prefix it's in.
+func (a *ASNDB) find(addr netip.Addr) (any, netip.Prefix, bool) {
+	addr = addr.Unmap()
+	var (
+		ip    []byte
+		node  uint32
+		depth int
+	)
+	switch {
+	case addr.Is4() && a.ipVersion == 6:
+		a4 := addr.As4()
+		ip, node, depth = a4[:], a.ipv4Start, a.ipv4Depth
+	case addr.Is4():
+		a4 := addr.As4()
+		ip = a4[:]
+	case a.ipVersion == 6:
+		a16 := addr.As16()
+		ip = a16[:]
+	default:
+		return nil, netip.Prefix{}, false
+	}
+
+	start := depth
+	for i := 0; i < len(ip)*8 && node < a.nodeCount; i++ {
+		node = a.record(node, ip[i>>3]>>(7-i&7)&1)
+		depth++
+	}
+	if node <= a.nodeCount {
+		return nil, netip.Prefix{}, false
+	}
+
+	off := a.treeSize + int(node-a.nodeCount)
+	if off >= len(a.data) {
+		return nil, netip.Prefix{}, false
+	}
+	v, _, err := mmdbDecoder(a.data[a.treeSize+16:]).decode(off-a.treeSize-16, 0)
+	if err != nil {
+		return nil, netip.Prefix{}, false
+	}
+	p, _ := addr.Prefix(depth - start)
+	return v, p, true
+}
+
+// ASN gets the autonomous system number and organisation for this address.
+func (a *ASNDB) ASN(addr netip.Addr) (uint32, string, bool) {
+	asn, org, _, ok := a.asn(addr)
+	return asn, org, ok
+}
+
+func (a *ASNDB) asn(addr netip.Addr) (uint32, string, netip.Prefix, bool) {
+	if !addr.IsValid() {
+		return 0, "", netip.Prefix{}, false
+	}
+	v, p, ok := a.find(addr)
+	m, _ := v.(map[string]any)
+	if !ok || m == nil {
+		return 0, "", netip.Prefix{}, false
+	}
+
+	var asn uint32
+	switch n := cmpAny(m, "autonomous_system_number", "asn").(type) {
+	case uint64:
+		asn = uint32(n)
+	case string: // IPinfo uses "AS123".
+		x, _ := strconv.ParseUint(strings.TrimPrefix(strings.ToUpper(n), "AS"), 10, 32)
+		asn = uint32(x)
+	}
+	org, _ := cmpAny(m, "autonomous_system_organization", "as_name", "name").(string)
+	return asn, org, p, asn > 0
+}
+
+// cmpAny gets the first key from the map that exists.
+func cmpAny(m map[string]any, keys ...string) any {
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// Lookup implements Ranges.
+//
+// This only returns a Range if the address is from a hosting provider, with
+// BotRangeHosting as the Result.
+func (a *ASNDB) Lookup(addr netip.Addr) (Range, bool) {
+	asn, org, p, ok := a.asn(addr)
+	if !ok {
+		return Range{}, false
+	}
+	name, ok := hostingASN[asn]
+	if !ok {
+		return Range{}, false
+	}
+	return Range{Prefix: p, Result: BotRangeHosting, Name: name, ASN: asn, Org: org}, true
+}
+
+// mmdbDecoder decodes the MMDB data section format.
+type mmdbDecoder []byte
+
+const (
+	mmdbPointer = 1
+	mmdbString  = 2
+	mmdbDouble  = 3
+	mmdbBytes   = 4
+	mmdbUint16  = 5
+	mmdbUint32  = 6
+	mmdbMap     = 7
+	mmdbInt32   = 8
+	mmdbUint64  = 9
+	mmdbUint128 = 10
+	mmdbArray   = 11
+	mmdbBool    = 14
+	mmdbFloat   = 15
+)
+
+var errMMDBData = errors.New("invalid data section")
+
+// decode the value at off, returning the value and the offset of the next
+// value.
+func (d mmdbDecoder) decode(off, depth int) (any, int, error) {
+	if depth > 32 {
+		return nil, 0, errors.New("data structure is too deeply nested")
+	}
+	if off < 0 || off >= len(d) {
+		return nil, 0, errMMDBData
+	}
+	ctrl := d[off]
+	off++
+	typ := int(ctrl >> 5)
+
+	if typ == mmdbPointer {
+		ss, vvv := int(ctrl>>3)&3, int(ctrl&7)
+		if off+ss+1 > len(d) {
+			return nil, 0, errMMDBData
+		}
+		b := d[off : off+ss+1]
+		var p int
+		switch ss {
+		case 0:
+			p = vvv<<8 | int(b[0])
+		case 1:
+			p = (vvv<<16 | int(b[0])<<8 | int(b[1])) + 2048
+		case 2:
+			p = (vvv<<24 | int(b[0])<<16 | int(b[1])<<8 | int(b[2])) + 526336
+		case 3:
+			p = int(binary.BigEndian.Uint32(b))
+		}
+		if p < len(d) && d[p]>>5 == mmdbPointer {
+			return nil, 0, errors.New("pointer to a pointer")
+		}
+		v, _, err := d.decode(p, depth+1)
+		return v, off + ss + 1, err
+	}
+
+	if typ == 0 {
+		if off >= len(d) {
+			return nil, 0, errMMDBData
+		}
+		typ = 7 + int(d[off])
+		off++
+	}
+	size := int(ctrl & 0x1f)
+	if size >= 29 {
+		n := size - 28
+		if off+n > len(d) {
+			return nil, 0, errMMDBData
+		}
+		x := 0
+		for _, c := range d[off : off+n] {
+			x = x<<8 | int(c)
+		}
+		size = []int{29, 285, 65821}[n-1] + x
+		off += n
+	}
+
+	switch typ {
+	case mmdbMap:
+		// Every key and value is at least one byte.
+		if size > (len(d)-off)/2 {
+			return nil, 0, errMMDBData
+		}
+		m := make(map[string]any, size)
+		for range size {
+			k, next, err := d.decode(off, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			ks, ok := k.(string)
+			if !ok {
+				return nil, 0, errors.New("map key is not a string")
+			}
+			m[ks], off, err = d.decode(next, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		return m, off, nil
+	case mmdbArray:
+		if size > len(d)-off {
+			return nil, 0, errMMDBData
+		}
+		a := make([]any, 0, min(size, 1024))
+		for range size {
+			v, next, err := d.decode(off, depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			a, off = append(a, v), next
+		}
+		return a, off, nil
+	case mmdbBool:
+		return size != 0, off, nil
+	}
+
+	if off+size > len(d) {
+		return nil, 0, errMMDBData
+	}
+	b := d[off : off+size]
+	switch typ {
+	case mmdbString:
+		return string(b), off + size, nil
+	case mmdbBytes:
+		return bytes.Clone(b), off + size, nil
+	case mmdbDouble:
+		if size != 8 {
+			return nil, 0, errMMDBData
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), off + size, nil
+	case mmdbFloat:
+		if size != 4 {
+			return nil, 0, errMMDBData
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), off + size, nil
+	case mmdbUint16, mmdbUint32, mmdbUint64, mmdbInt32:
+		if size > 8 {
+			return nil, 0, errMMDBData
+		}
+		var x uint64
+		for _, c := range b {
+			x = x<<8 | uint64(c)
+		}
+		if typ == mmdbInt32 {
+			return int64(int32(x)), off + size, nil
+		}
+		return x, off + size, nil
+	case mmdbUint128:
+		return bytes.Clone(b), off + size, nil
+	}
+	return nil, 0, fmt.Errorf("unknown data type %d", typ)
+}
@@ -0,0 +1,287 @@
+package isbot
+
+import (
+	"bytes"
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+// writeMMDB writes a minimal MMDB file.
+func writeMMDB(t *testing.T, recordSize, ipVersion int, entries map[string]map[string]any) []byte {
+	t.Helper()
+
+	type tnode struct {
+		kids [2]*tnode
+		leaf [2]int // Data offset + 1
+	}
+	var (
+		root = new(tnode)
+		data = new(bytes.Buffer)
+		strs = make(map[string]int) // Offsets of strings, to test pointers.
+	)
+	data.WriteByte(0) // So that offsets are never 0.
+
+	var enc func(v any)
+	ctrl := func(typ, size int) {
+		s, extra := size, []byte{}
+		switch {
+		case size >= 285:
+			s, extra = 30, []byte{byte((size - 285) >> 8), byte(size - 285)}
+		case size >= 29:
+			s, extra = 29, []byte{byte(size - 29)}
+		}
+		if typ > 7 {
+			data.Write([]byte{byte(s), byte(typ - 7)})
+		} else {
+			data.WriteByte(byte(typ<<5 | s))
+		}
+		data.Write(extra)
+	}
+	enc = func(v any) {
+		switch v := v.(type) {
+		case string:
+			if p, ok := strs[v]; ok && p < 2048 {
+				data.Write([]byte{byte(mmdbPointer<<5 | p>>8), byte(p)})
+				return
+			}
+			strs[v] = data.Len()
+			ctrl(mmdbString, len(v))
+			data.WriteString(v)
+		case uint64, uint32, uint16:
+			var (
+				x   uint64
+				typ = mmdbUint64
+			)
+			switch v := v.(type) {
+			case uint64:
+				x = v
+			case uint32:
+				x, typ = uint64(v), mmdbUint32
+			case uint16:
+				x, typ = uint64(v), mmdbUint16
+			}
+			var b []byte
+			for ; x > 0; x >>= 8 {
+				b = append([]byte{byte(x)}, b...)
+			}
+			ctrl(typ, len(b))
+			data.Write(b)
+		case map[string]any:
+			ctrl(mmdbMap, len(v))
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			slices.Sort(keys)
+			for _, k := range keys {
+				enc(k)
+				enc(v[k])
+			}
+		default:
+			t.Fatalf("can't encode %T", v)
+		}
+	}
+
+	keys := make([]string, 0, len(entries))
+	for k := range entries {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		off := data.Len()
+		enc(entries[k])
+
+		p := netip.MustParsePrefix(k)
+		ip, bits := p.Addr().AsSlice(), p.Bits()
+		if p.Addr().Is4() && ipVersion == 6 {
+			ip, bits = append(make([]byte, 12), ip...), bits+96
+		}
+		cur := root
+		for i := range bits {
+			b := ip[i/8] >> (7 - i%8) & 1
+			if i == bits-1 {
+				cur.leaf[b] = off + 1
+				break
+			}
+			if cur.kids[b] == nil {
+				cur.kids[b] = new(tnode)
+			}
+			cur = cur.kids[b]
+		}
+	}
+
+	// Number the nodes breadth-first.
+	var (
+		nodes = []*tnode{root}
+		index = map[*tnode]int{root: 0}
+	)
+	for i := 0; i < len(nodes); i++ {
+		for _, k := range nodes[i].kids {
+			if k != nil {
+				index[k] = len(nodes)
+				nodes = append(nodes, k)
+			}
+		}
+	}
+
+	out := new(bytes.Buffer)
+	n := len(nodes)
+	for _, nd := range nodes {
+		var rec [2]uint32
+		for b := range 2 {
+			switch {
+			case nd.kids[b] != nil:
+				rec[b] = uint32(index[nd.kids[b]])
+			case nd.leaf[b] > 0:
+				rec[b] = uint32(n + 16 + nd.leaf[b] - 1)
+			default:
+				rec[b] = uint32(n)
+			}
+		}
+		l, r := rec[0], rec[1]
+		switch recordSize {
+		case 24:
+			out.Write([]byte{byte(l >> 16), byte(l >> 8), byte(l), byte(r >> 16), byte(r >> 8), byte(r)})
+		case 28:
+			out.Write([]byte{byte(l >> 16), byte(l >> 8), byte(l), byte(l>>24)<<4 | byte(r>>24)&0x0f, byte(r >> 16), byte(r >> 8), byte(r)})
+		case 32:
+			out.Write([]byte{byte(l >> 24), byte(l >> 16), byte(l >> 8), byte(l), byte(r >> 24), byte(r >> 16), byte(r >> 8), byte(r)})
+		}
+	}
+	out.Write(make([]byte, 16))
+	out.Write(data.Bytes())
+	out.Write(mmdbMarker)
+
+	data.Reset()
+	clear(strs)
+	enc(map[string]any{
+		"node_count":                  uint32(n),
+		"record_size":                 uint16(recordSize),
+		"ip_version":                  uint16(ipVersion),
+		"database_type":               "Test-ASN",
+		"build_epoch":                 uint64(1717243200),
+		"binary_format_major_version": uint16(2),
+	})
+	out.Write(data.Bytes())
+	return out.Bytes()
+}
+
+func TestLookupIP(t *testing.T) {
+	defer SetDB(nil)
+
+	if _, ok := LookupIP(netip.Addr{}); ok {
+		t.Error("invalid address found")
+	}
+	r, ok := LookupIP(netip.MustParseAddr("::ffff:3.0.0.1"))
+	if !ok || r.Result != BotRangeAWS || r.Name != "AWS" || !r.Prefix.Contains(netip.MustParseAddr("3.0.0.1")) {
+		t.Errorf("built-in: %v %v", r, ok)
+	}
+
+	SetDB(ActiveDB().WithRanges("test", []Range{
+		{Prefix: netip.MustParsePrefix("3.2.34.0/26"), Result: BotRangeAWS, Name: "AWS", Region: "af-south-1", Service: "EC2"},
+		{Prefix: netip.MustParsePrefix("3.5.0.0/24"), Result: BotRangeAWS, Name: "AWS"},
+	}))
+	for addr, want := range map[string]Range{
+		"3.2.34.1": {Prefix: netip.MustParsePrefix("3.2.34.0/26"), Result: BotRangeAWS, Name: "AWS", Region: "af-south-1", Service: "EC2"},
+		"3.5.0.1":  {Prefix: netip.MustParsePrefix("3.5.0.0/24"), Result: BotRangeAWS, Name: "AWS"},
+	} {
+		if r, ok := LookupIP(netip.MustParseAddr(addr)); !ok || r != want {
+			t.Errorf("%s: %v %v", addr, r, ok)
+		}
+	}
+	if r, ok := LookupIP(netip.MustParseAddr("3.0.0.1")); ok {
+		t.Errorf("not in DB: %v", r)
+	}
+}
+
+func TestASNDB(t *testing.T) {
+	entries := map[string]map[string]any{
+		"1.2.3.0/24":     {"autonomous_system_number": uint32(20473), "autonomous_system_organization": "The Constant Company, LLC"},
+		"1.2.4.0/24":     {"autonomous_system_number": uint32(20473), "autonomous_system_organization": "The Constant Company, LLC"},
+		"5.6.0.0/16":     {"autonomous_system_number": uint32(3320), "autonomous_system_organization": "Deutsche Telekom AG"},
+		"2a00:1234::/32": {"asn": "AS51167", "name": "Contabo GmbH"},
+	}
+
+	for _, tt := range []struct{ size, version int }{{24, 6}, {28, 6}, {32, 6}, {24, 4}} {
+		t.Run("", func(t *testing.T) {
+			a, err := ParseASNDB(writeMMDB(t, tt.size, tt.version, entries))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if a.Type != "Test-ASN" || a.Built.Year() != 2024 {
+				t.Errorf("%q %s", a.Type, a.Built)
+			}
+
+			asn, org, ok := a.ASN(netip.MustParseAddr("5.6.7.8"))
+			if !ok || asn != 3320 || org != "Deutsche Telekom AG" {
+				t.Errorf("%d %q %t", asn, org, ok)
+			}
+			if _, ok := a.Lookup(netip.MustParseAddr("5.6.7.8")); ok {
+				t.Error("not hosting")
+			}
+			for _, addr := range []string{"1.2.5.1", "9.9.9.9", "2a00:1235::1"} {
+				if asn, _, ok := a.ASN(netip.MustParseAddr(addr)); ok {
+					t.Errorf("%s: %d", addr, asn)
+				}
+			}
+
+			r, ok := a.Lookup(netip.MustParseAddr("::ffff:1.2.4.5"))
+			want := Range{Prefix: netip.MustParsePrefix("1.2.4.0/24"), Result: BotRangeHosting,
+				Name: "Vultr", ASN: 20473, Org: "The Constant Company, LLC"}
+			if !ok || r != want {
+				t.Errorf("\nhave: %v\nwant: %v", r, want)
+			}
+
+			r, ok = a.Lookup(netip.MustParseAddr("2a00:1234::1"))
+			if tt.version == 4 {
+				if ok {
+					t.Errorf("IPv6 in IPv4 database: %v", r)
+				}
+				return
+			}
+			want = Range{Prefix: netip.MustParsePrefix("2a00:1234::/32"), Result: BotRangeHosting,
+				Name: "Contabo", ASN: 51167, Org: "Contabo GmbH"}
+			if !ok || r != want {
+				t.Errorf("\nhave: %v\nwant: %v", r, want)
+			}
+		})
+	}
+
+	t.Run("IPRange", func(t *testing.T) {
+		a, _ := ParseASNDB(writeMMDB(t, 24, 6, entries))
+		SetASNDB(a)
+		defer SetASNDB(nil)
+
+		if got := IPRange("1.2.3.4"); got != BotRangeHosting {
+			t.Errorf("got %s", got)
+		}
+		if got := IPRange("5.6.7.8"); got != NoBotNoMatch {
+			t.Errorf("got %s", got)
+		}
+		if got := IPRange("35.180.1.1"); got != BotRangeAWS {
+			t.Errorf("got %s", got)
+		}
+		if r, ok := LookupIP(netip.MustParseAddr("1.2.3.4")); !ok || r.ASN != 20473 {
+			t.Errorf("%v %t", r, ok)
+		}
+	})
+
+	for _, data := range [][]byte{nil, []byte("xxx"), writeMMDB(t, 24, 6, entries)[:100]} {
+		if _, err := ParseASNDB(data); err == nil {
+			t.Errorf("no error for %q", data)
+		}
+	}
+}
+
+func TestMMDBDecodeSize(t *testing.T) {
+	for _, data := range [][]byte{
+		{mmdbMap<<5 | 31, 0xff, 0xff, 0xff},             // Map with 16M entries.
+		{mmdbMap<<5 | 2, mmdbString<<5 | 1, 'a'},        // Map with 2 entries, but only data for one key.
+		{0<<5 | 31, mmdbArray - 7, 0xff, 0xff, 0xff, 1}, // Array with 16M entries.
+	} {
+		if _, _, err := mmdbDecoder(data).decode(0, 0); err == nil {
+			t.Errorf("no error for %x", data)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package isbot
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Token type 0x0002: Blind RSA (2048-bit); this is the publicly verifiable
+// token type that's used by Apple's Private Access Tokens and others.
+//
+// https://www.rfc-editor.org/rfc/rfc9578#section-8.2
+const (
+	ppTokenType = 0x0002
+	ppNk        = 256 // Size of the authenticator (RSA modulus).
+	ppTokenLen  = 2 + 32 + 32 + 32 + ppNk
+)
+
+// PrivacyPass verifies Privacy Pass tokens (RFC 9577), also known as Private
+// Access Tokens.
+//
+// Clients that support this (such as recent Apple devices) can redeem a token
+// from an issuer which attests that this is a real device, without revealing
+// who the user is. This is a fairly strong signal that it's not a bot.
+//
+// Use Challenge() to ask for a token, and Verify() to check the token the
+// client sends back. Only the publicly verifiable token type (0x0002, Blind
+// RSA) is supported.
+//
+// Every challenge has a redemption context that changes every 10 minutes, and
+// tokens are accepted for challenges from the last 20 minutes. The nonces of
+// spent tokens are kept in the Store, and a token can only be redeemed once.
+type PrivacyPass struct {
+	// Key to derive the redemption context from. This is set to a random key
+	// by NewPrivacyPass(); set it to the same value on all servers if a token
+	// can be redeemed on a different server than the one that sent the
+	// challenge.
+	Key []byte
+
+	// Store to keep the spent tokens in; the default is a MemoryStore with
+	// NoEvict set. Tokens are refused if the Store is full.
+	Store Store
+
+	once      sync.Once
+	store     Store
+	now       func() time.Time
+	issuer    string
+	origin    string
+	keys      map[[32]byte]*rsa.PublicKey
+	tokenKeys []string // base64url-encoded SPKI, for the challenge.
+}
+
+// How long a redemption context is used for.
+const ppWindow = 10 * time.Minute
+
+// NewPrivacyPass creates a new Privacy Pass verifier.
+//
+// The issuer is the issuer name, such as "demo-pat.issuer.cloudflare.com". The
+// origin is optional, and restricts tokens to this origin (e.g.
+// "example.com").
+//
+// The keys are the DER-encoded SubjectPublicKeyInfo as published in the
+// "token-keys" of the issuer directory (after base64-decoding).
+func NewPrivacyPass(issuer, origin string, keys ...[]byte) (*PrivacyPass, error) {
+	if issuer == "" {
+		return nil, errors.New("isbot.NewPrivacyPass: issuer is empty")
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("isbot.NewPrivacyPass: no keys")
+	}
+
+	p := &PrivacyPass{
+		Key:    make([]byte, 32),
+		issuer: issuer,
+		origin: origin,
+		keys:   make(map[[32]byte]*rsa.PublicKey, len(keys)),
+	}
+	_, _ = rand.Read(p.Key)
+	for i, k := range keys {
+		pub, err := parsePPKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("isbot.NewPrivacyPass: key %d: %w", i, err)
+		}
+		p.keys[sha256.Sum256(k)] = pub
+		p.tokenKeys = append(p.tokenKeys, base64.RawURLEncoding.EncodeToString(k))
+	}
+
+	return p, nil
+}
+
+func (p *PrivacyPass) init() {
+	p.once.Do(func() {
+		p.store = spentStore(p.Store, 100_000)
+		if p.now == nil {
+			p.now = time.Now
+		}
+	})
+}
+
+// tokenChallenge gets the TokenChallenge for the window that t is in.
+func (p *PrivacyPass) tokenChallenge(t time.Time) []byte {
+	mac := hmac.New(sha256.New, p.Key)
+	mac.Write(binary.BigEndian.AppendUint64(nil, uint64(t.UnixNano()/int64(ppWindow))))
+
+	// struct {
+	//     uint16_t token_type;
+	//     opaque issuer_name<1..2^16-1>;
+	//     opaque redemption_context<0..32>;
+	//     opaque origin_info<0..2^16-1>;
+	// } TokenChallenge;
+	c := binary.BigEndian.AppendUint16(nil, ppTokenType)
+	c = binary.BigEndian.AppendUint16(c, uint16(len(p.issuer)))
+	c = append(c, p.issuer...)
+	c = append(c, 32)
+	c = mac.Sum(c)
+	c = binary.BigEndian.AppendUint16(c, uint16(len(p.origin)))
+	return append(c, p.origin...)
+}
+
+// parsePPKey parses the SubjectPublicKeyInfo. The algorithm is usually
+// id-RSASSA-PSS, which x509.ParsePKIXPublicKey() doesn't support, so decode it
+// manually.
+func parsePPKey(der []byte) (*rsa.PublicKey, error) {
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	rest, err := asn1.Unmarshal(der, &spki)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("trailing data after SubjectPublicKeyInfo")
+	}
+	pub, err := x509.ParsePKCS1PublicKey(spki.PublicKey.RightAlign())
+	if err != nil {
+		return nil, err
+	}
+	if pub.Size() != ppNk {
+		return nil, fmt.Errorf("key is %d bits; must be %d bits", pub.Size()*8, ppNk*8)
+	}
+	return pub, nil
+}
+
+// Challenge adds the WWW-Authenticate header to ask the client for a token.
+//
+// This doesn't write the status code; you usually want to send a 401 along
+// with a regular page, as clients that don't support Privacy Pass will just
+// display the page.
+func (p *PrivacyPass) Challenge(w http.ResponseWriter) {
+	p.init()
+	c := base64.RawURLEncoding.EncodeToString(p.tokenChallenge(p.now()))
+	for _, k := range p.tokenKeys {
+		w.Header().Add("WWW-Authenticate",
+			`PrivateToken challenge="`+c+`", token-key="`+k+`"`)
+	}
+}
+
+// Verify the token in the Authorization header.
+//
+// It returns NoBotPrivacyPass if the token is valid, or NoBotNoMatch if there
+// is no token, if it's invalid, or if it was already spent.
+func (p *PrivacyPass) Verify(r *http.Request) Result {
+	p.init()
+	if p.valid(r.Header.Get("Authorization")) {
+		return NoBotPrivacyPass
+	}
+	return NoBotNoMatch
+}
+
+// Check implements Checker.
+func (p *PrivacyPass) Check(r *http.Request, _ netip.Addr) Result { return p.Verify(r) }
+
+func (p *PrivacyPass) valid(auth string) bool {
+	scheme, params, ok := strings.Cut(auth, " ")
+	if !ok || !strings.EqualFold(scheme, "PrivateToken") {
+		return false
+	}
+	var tok string
+	for param := range strings.SplitSeq(params, ",") {
+		k, v, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if strings.EqualFold(k, "token") {
+			tok = strings.Trim(v, `"`)
+		}
+	}
+	// Should be base64url, but be liberal in what we accept.
+	tok = strings.TrimRight(tok, "=")
+	token, err := base64.RawURLEncoding.DecodeString(tok)
+	if err != nil {
+		token, err = base64.RawStdEncoding.DecodeString(tok)
+		if err != nil {
+			return false
+		}
+	}
+
+	// struct {
+	//     uint16_t token_type = 0x0002;
+	//     uint8_t nonce[32];
+	//     uint8_t challenge_digest[32];
+	//     uint8_t token_key_id[32];
+	//     uint8_t authenticator[Nk];
+	// } Token;
+	if len(token) != ppTokenLen || binary.BigEndian.Uint16(token) != ppTokenType {
+		return false
+	}
+	now := p.now()
+	cur, prev := sha256.Sum256(p.tokenChallenge(now)), sha256.Sum256(p.tokenChallenge(now.Add(-ppWindow)))
+	if subtle.ConstantTimeCompare(token[34:66], cur[:]) != 1 && subtle.ConstantTimeCompare(token[34:66], prev[:]) != 1 {
+		return false
+	}
+	pub, ok := p.keys[[32]byte(token[66:98])]
+	if !ok {
+		return false
+	}
+
+	// RSABSSA-SHA384-PSS-Deterministic; the finalized blind signature is a
+	// regular RSASSA-PSS signature.
+	h := sha512.Sum384(token[:98])
+	err = rsa.VerifyPSS(pub, crypto.SHA384, h[:], token[98:],
+		&rsa.PSSOptions{SaltLength: sha512.Size384, Hash: crypto.SHA384})
+	if err != nil {
+		return false
+	}
+
+	// The challenge is valid for at most two windows, so there's no need to
+	// keep the nonce for longer than that.
+	return incr(p.store, "pp:"+string(token[2:34]), 1, 2*ppWindow) == 1
+}
@@ -0,0 +1,148 @@
+package isbot
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPrivacyPass(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spki, err := asn1.Marshal(struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}{
+		pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 10}},
+		asn1.BitString{Bytes: x509.MarshalPKCS1PublicKey(&key.PublicKey)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewPrivacyPass("issuer.example.com", "example.com", spki)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+
+	challenge := func() []byte {
+		rec := httptest.NewRecorder()
+		p.Challenge(rec)
+		h := rec.Header().Get("WWW-Authenticate")
+		if !strings.HasPrefix(h, `PrivateToken challenge="`) {
+			t.Fatalf("wrong header: %q", h)
+		}
+		h = strings.TrimPrefix(h, `PrivateToken challenge="`)
+		c, err := base64.RawURLEncoding.DecodeString(h[:strings.IndexByte(h, '"')])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	c := challenge()
+
+	token := func(digest []byte) string {
+		tok := binary.BigEndian.AppendUint16(nil, 0x0002)
+		tok = append(tok, make([]byte, 32)...)
+		_, _ = rand.Read(tok[2:])
+		tok = append(tok, digest...)
+		id := sha256.Sum256(spki)
+		tok = append(tok, id[:]...)
+		h := sha512.Sum384(tok)
+		sig, err := rsa.SignPSS(rand.Reader, key, crypto.SHA384, h[:],
+			&rsa.PSSOptions{SaltLength: sha512.Size384, Hash: crypto.SHA384})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(append(tok, sig...))
+	}
+
+	good := sha256.Sum256(c)
+	wrong := sha256.Sum256([]byte("other challenge"))
+	tokGood := token(good[:])
+	tampered := []byte(tokGood)
+	tampered[len(tampered)-4] ^= 'A' ^ 'B'
+
+	tests := []struct {
+		auth string
+		want Result
+	}{
+		{``, NoBotNoMatch},
+		{`Basic dXNlcjpwYXNz`, NoBotNoMatch},
+		{`PrivateToken token="` + tokGood + `"`, NoBotPrivacyPass},
+		{`privatetoken token=` + token(good[:]), NoBotPrivacyPass},
+		{`PrivateToken token="` + token(wrong[:]) + `"`, NoBotNoMatch},
+		{`PrivateToken token="` + string(tampered) + `"`, NoBotNoMatch},
+		{`PrivateToken token="AAAA"`, NoBotNoMatch},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			r := &http.Request{Header: make(http.Header)}
+			r.Header.Set("Authorization", tt.auth)
+			if got := p.Verify(r); got != tt.want {
+				t.Errorf("got %s; want %s", got, tt.want)
+			}
+		})
+	}
+
+	verify := func(tok string) Result {
+		r := &http.Request{Header: make(http.Header)}
+		r.Header.Set("Authorization", `PrivateToken token="`+tok+`"`)
+		return p.Verify(r)
+	}
+	t.Run("replay", func(t *testing.T) {
+		if got := verify(tokGood); got != NoBotNoMatch {
+			t.Errorf("spent token accepted: %s", got)
+		}
+	})
+	t.Run("expire", func(t *testing.T) {
+		tok := token(good[:])
+		now = now.Add(ppWindow)
+		if c2 := challenge(); bytes.Equal(c, c2) {
+			t.Error("same challenge in next window")
+		}
+		if got := verify(tok); got != NoBotPrivacyPass {
+			t.Errorf("previous window: %s", got)
+		}
+		tok = token(good[:])
+		now = now.Add(ppWindow)
+		if got := verify(tok); got != NoBotNoMatch {
+			t.Errorf("expired: %s", got)
+		}
+	})
+	t.Run("full", func(t *testing.T) {
+		ms := NewMemoryStore(1)
+		ms.now, ms.NoEvict = p.now, true
+		p.store = ms
+
+		d := sha256.Sum256(challenge())
+		tok := token(d[:])
+		if got := verify(tok); got != NoBotPrivacyPass {
+			t.Errorf("first token: %s", got)
+		}
+		if got := verify(token(d[:])); got != NoBotNoMatch {
+			t.Errorf("accepted with full store: %s", got)
+		}
+		if got := verify(tok); got != NoBotNoMatch {
+			t.Errorf("spent token accepted: %s", got)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package isbot
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Resolver gets the client address for a request.
+type Resolver interface {
+	// ClientIP gets the client address, which may be invalid if it can't be
+	// determined.
+	ClientIP(r *http.Request) netip.Addr
+}
+
+// Proxies is a Resolver that gets the client address from a header set by
+// trusted proxies, such as load balancers.
+//
+// The header is only used if r.RemoteAddr is one of the Trusted proxies, and
+// only the Header that the proxies set is read:
+//
+//   - Forwarded (RFC 7239) and X-Forwarded-For list all the proxies the request
+//     went through; these are read from right to left, skipping addresses of
+//     Trusted proxies. The first address that's not trusted is the client.
+//   - Any other header, such as X-Real-IP, has just the client address.
+//
+// The peer address is used if the header has no valid client address.
+//
+// Clients can set the header themselves, so make sure that all of the Trusted
+// proxies either set or remove it.
+type Proxies struct {
+	Trusted []netip.Prefix
+
+	// Header to get the client address from; the default is X-Forwarded-For.
+	Header string
+}
+
+// ClientIP implements Resolver.
+func (p Proxies) ClientIP(r *http.Request) netip.Addr {
+	peer := remoteAddr(r)
+	if !p.trusted(peer) {
+		return peer
+	}
+
+	var addr netip.Addr
+	switch h := http.CanonicalHeaderKey(p.Header); h {
+	case "Forwarded":
+		addr = p.walk(forwardedFor(r.Header.Values(h)))
+	case "", "X-Forwarded-For":
+		var hops []string
+		for _, v := range r.Header.Values("X-Forwarded-For") {
+			for hop := range strings.SplitSeq(v, ",") {
+				if hop = strings.TrimSpace(hop); hop != "" {
+					hops = append(hops, hop)
+				}
+			}
+		}
+		addr = p.walk(hops)
+	default:
+		addr = parseAddr(strings.TrimSpace(r.Header.Get(h)))
+	}
+	if !addr.IsValid() {
+		return peer
+	}
+	return addr
+}
+
+func (p Proxies) trusted(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, t := range p.Trusted {
+		if t.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// walk the hops from the right, and return the first one that isn't trusted.
+// If all hops are trusted the leftmost one is returned, as that's as close to
+// the client as we can get.
+func (p Proxies) walk(hops []string) netip.Addr {
+	var addr netip.Addr
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr = parseAddr(hops[i])
+		if !addr.IsValid() || !p.trusted(addr) {
+			return addr
+		}
+	}
+	return addr
+}
+
+// forwardedFor gets the "for" parameters from the Forwarded headers.
+func forwardedFor(h []string) []string {
+	var hops []string
+	for _, v := range h {
+		for elem := range strings.SplitSeq(v, ",") {
+			for pair := range strings.SplitSeq(elem, ";") {
+				k, v, _ := strings.Cut(strings.TrimSpace(pair), "=")
+				if strings.EqualFold(k, "for") {
+					hops = append(hops, strings.Trim(v, `"`))
+				}
+			}
+		}
+	}
+	return hops
+}
@@ -0,0 +1,104 @@
+package isbot
+
+import (
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestProxies(t *testing.T) {
+	p := Proxies{Trusted: []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("2001:db8:1::/48"),
+	}}
+
+	tests := []struct {
+		remote string
+		header string
+		h      map[string]string
+		want   string
+	}{
+		{"192.0.2.1:1234", "", nil, "192.0.2.1"},
+		{"10.0.0.1:1234", "", nil, "10.0.0.1"},
+
+		// Not trusted: ignore headers.
+		{"192.0.2.1:1234", "", map[string]string{"X-Forwarded-For": "198.51.100.1"}, "192.0.2.1"},
+		{"192.0.2.1:1234", "X-Real-IP", map[string]string{"X-Real-IP": "198.51.100.1"}, "192.0.2.1"},
+
+		// X-Forwarded-For
+		{"10.0.0.1:1234", "", map[string]string{"X-Forwarded-For": "198.51.100.1"}, "198.51.100.1"},
+		{"10.0.0.1:1234", "x-forwarded-for", map[string]string{"X-Forwarded-For": "198.51.100.1"}, "198.51.100.1"},
+		{"10.0.0.1:1234", "", map[string]string{"X-Forwarded-For": "6.6.6.6, 198.51.100.1, 10.0.0.2"}, "198.51.100.1"},
+		{"10.0.0.1:1234", "", map[string]string{"X-Forwarded-For": "10.0.0.3, 10.0.0.2"}, "10.0.0.3"},
+		{"[2001:db8:1::1]:1234", "", map[string]string{"X-Forwarded-For": "2001:db8:2::1,2001:db8:1::2"}, "2001:db8:2::1"},
+		{"[::ffff:10.0.0.1]:1234", "", map[string]string{"X-Forwarded-For": "198.51.100.1"}, "198.51.100.1"},
+		{"10.0.0.1:1234", "", map[string]string{"X-Forwarded-For": "198.51.100.1, garbage"}, "10.0.0.1"},
+		{"10.0.0.1:1234", "", map[string]string{"X-Forwarded-For": " , "}, "10.0.0.1"},
+
+		// Forwarded
+		{"10.0.0.1:1234", "Forwarded", map[string]string{"Forwarded": "for=198.51.100.1"}, "198.51.100.1"},
+		{"10.0.0.1:1234", "Forwarded", map[string]string{"Forwarded": `for=6.6.6.6, For="[2001:db8:cafe::17]:4711";proto=https, for=10.0.0.2;by=10.0.0.1`}, "2001:db8:cafe::17"},
+		{"10.0.0.1:1234", "Forwarded", map[string]string{"Forwarded": "for=unknown"}, "10.0.0.1"},
+		{"10.0.0.1:1234", "Forwarded", map[string]string{"Forwarded": "for=10.0.0.3, for=10.0.0.2"}, "10.0.0.3"},
+		{"10.0.0.1:1234", "Forwarded", map[string]string{"Forwarded": "proto=https;by=10.0.0.1"}, "10.0.0.1"},
+
+		// X-Real-IP
+		{"10.0.0.1:1234", "X-Real-IP", map[string]string{"X-Real-IP": " 198.51.100.1 "}, "198.51.100.1"},
+		{"10.0.0.1:1234", "X-Real-IP", map[string]string{"X-Real-IP": "garbage"}, "10.0.0.1"},
+
+		// Only the configured header is read.
+		{"10.0.0.1:1234", "", map[string]string{"Forwarded": "for=198.51.100.1", "X-Forwarded-For": "6.6.6.6"}, "6.6.6.6"},
+		{"10.0.0.1:1234", "", map[string]string{"Forwarded": "for=198.51.100.1"}, "10.0.0.1"},
+		{"10.0.0.1:1234", "", map[string]string{"X-Real-IP": "198.51.100.1"}, "10.0.0.1"},
+		{"10.0.0.1:1234", "Forwarded", map[string]string{"X-Forwarded-For": "198.51.100.1"}, "10.0.0.1"},
+		{"10.0.0.1:1234", "X-Real-IP", map[string]string{"X-Real-IP": "198.51.100.1", "X-Forwarded-For": "198.51.100.2"}, "198.51.100.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remote
+			for k, v := range tt.h {
+				r.Header.Set(k, v)
+			}
+			p := p
+			p.Header = tt.header
+			if got := p.ClientIP(r).String(); got != tt.want {
+				t.Errorf("got %q; want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectorResolver(t *testing.T) {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:126.0) Gecko/20100101 Firefox/126.0")
+	r.Header.Set("X-Forwarded-For", "3.0.0.1")
+	r.RemoteAddr = "10.0.0.1:1234"
+
+	d := &Detector{}
+	if got := d.Bot(r); got != UnknownPrivate {
+		t.Errorf("got %s", got)
+	}
+	d.Resolver = Proxies{Trusted: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}}
+	if got := d.Bot(r); got != BotRangeAWS {
+		t.Errorf("got %s", got)
+	}
+
+	// Headers other than the configured one are ignored, and headers without a
+	// valid address don't make it NoBotKnown.
+	r.Header.Set("Forwarded", "for=88.1.2.3")
+	r.Header.Set("X-Forwarded-For", "35.180.1.1")
+	if got := d.Bot(r); got != BotRangeAWS {
+		t.Errorf("spoofed Forwarded: %s", got)
+	}
+	r.Header.Set("Forwarded", "for=unknown")
+	d.Resolver = Proxies{Trusted: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, Header: "Forwarded"}
+	if got := d.Bot(r); got != UnknownPrivate {
+		t.Errorf("Forwarded: for=unknown: %s", got)
+	}
+	r.RemoteAddr = "garbage"
+	if got := d.Bot(r); got != NoBotNoMatch {
+		t.Errorf("invalid peer: %s", got)
+	}
+}
@@ -0,0 +1,191 @@
+package isbot
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Robots checks if crawlers follow the rules in robots.txt.
+//
+// This only applies to crawlers that identify themselves in the User-Agent,
+// either because they're in the list of known bots or because the User-Agent
+// contains something like "bot" or "crawler". It returns BotRobots if they
+// request a disallowed path, or crawl faster than the Crawl-delay.
+type Robots struct {
+	// Store to keep track of the Crawl-delay in; the default is a MemoryStore.
+	Store Store
+
+	groups []robotsGroup
+	once   sync.Once
+	store  Store
+}
+
+type robotsGroup struct {
+	agents []string // Lower-cased.
+	rules  []robotsRule
+	delay  time.Duration
+}
+
+type robotsRule struct {
+	allow   bool
+	pattern string
+}
+
+// ParseRobots parses a robots.txt file.
+//
+// This follows RFC 9309, with the addition of the Crawl-delay rule.
+func ParseRobots(r io.Reader) (*Robots, error) {
+	var (
+		rb      = &Robots{}
+		scan    = bufio.NewScanner(r)
+		g       *robotsGroup
+		inRules bool
+	)
+	for scan.Scan() {
+		line, _, _ := strings.Cut(scan.Text(), "#")
+		k, v, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		k, v = strings.ToLower(strings.TrimSpace(k)), strings.TrimSpace(v)
+
+		if k == "user-agent" {
+			if g == nil || inRules {
+				rb.groups = append(rb.groups, robotsGroup{})
+				g, inRules = &rb.groups[len(rb.groups)-1], false
+			}
+			g.agents = append(g.agents, strings.ToLower(v))
+			continue
+		}
+		if g == nil {
+			continue
+		}
+
+		switch k {
+		case "allow", "disallow":
+			inRules = true
+			if v != "" {
+				g.rules = append(g.rules, robotsRule{allow: k == "allow", pattern: v})
+			}
+		case "crawl-delay":
+			inRules = true
+			if d, err := strconv.ParseFloat(v, 64); err == nil && d > 0 {
+				g.delay = time.Duration(d * float64(time.Second))
+			}
+		}
+	}
+	return rb, scan.Err()
+}
+
+// group gets all the rules for this bot; groups for the same User-Agent are
+// merged, and the "*" groups are used if there are no groups for it.
+func (rb *Robots) group(name string) robotsGroup {
+	name = strings.ToLower(name)
+	var (
+		match, star robotsGroup
+		found       bool
+	)
+	for _, g := range rb.groups {
+		for _, a := range g.agents {
+			m := &match
+			if a == "*" {
+				m = &star
+			} else if a != name {
+				continue
+			} else {
+				found = true
+			}
+			m.rules = append(m.rules, g.rules...)
+			m.delay = max(m.delay, g.delay)
+			break
+		}
+	}
+	if !found {
+		return star
+	}
+	return match
+}
+
+// Allowed reports if the bot with this name is allowed to fetch the path.
+//
+// The path may include the query string. The longest matching rule is used,
+// and Allow wins over Disallow if they're of the same length.
+func (rb *Robots) Allowed(name, path string) bool {
+	if path == "/robots.txt" {
+		return true
+	}
+	var (
+		g       = rb.group(name)
+		allowed = true
+		longest = -1
+	)
+	for _, r := range g.rules {
+		if !robotsMatch(r.pattern, path) {
+			continue
+		}
+		if l := len(r.pattern); l > longest || (l == longest && r.allow) {
+			allowed, longest = r.allow, l
+		}
+	}
+	return allowed
+}
+
+// Check implements Checker.
+func (rb *Robots) Check(r *http.Request, addr netip.Addr) Result {
+	rb.once.Do(func() {
+		rb.store = storeOr(rb.Store, 100_000)
+	})
+	name := botName(r.UserAgent())
+	if name == "" {
+		return NoBotNoMatch
+	}
+
+	path := r.URL.EscapedPath()
+	if r.URL.RawQuery != "" {
+		path += "?" + r.URL.RawQuery
+	}
+	if !rb.Allowed(name, path) {
+		return BotRobots
+	}
+
+	if d := rb.group(name).delay; d > 0 && addr.IsValid() {
+		// The counter expires after the delay, so if it's higher than one
+		// there was another request in the last Crawl-delay.
+		if incr(rb.store, "robots:"+strings.ToLower(name)+":"+clientKey(addr), 1, d) > 1 {
+			return BotRobots
+		}
+	}
+	return NoBotNoMatch
+}
+
+// robotsMatch reports if the path matches the pattern; "*" matches any
+// sequence of characters, and "$" at the end matches the end of the path.
+func robotsMatch(pattern, path string) bool {
+	end := strings.HasSuffix(pattern, "$")
+	if end {
+		pattern = pattern[:len(pattern)-1]
+	}
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	path = path[len(parts[0]):]
+	for i, p := range parts[1:] {
+		if end && i == len(parts)-2 {
+			return strings.HasSuffix(path, p)
+		}
+		j := strings.Index(path, p)
+		if j == -1 {
+			return false
+		}
+		path = path[j+len(p):]
+	}
+	return !end || path == ""
+}
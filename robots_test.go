@@ -0,0 +1,123 @@
+package isbot
+
+import (
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBotName(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Mozilla/5.0 (X11; Linux x86_64; rv:75.0) Gecko/20100101 Firefox/75.0", ""},
+		{"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)", "Googlebot"},
+		{"Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)", "bingbot"},
+		{"Baiduspider+(+http://www.baidu.com/search/spider.htm)", "Baiduspider"},
+		{"Mozilla/5.0 (compatible; SemrushBot/7~bl; +http://www.semrush.com/bot.html)", "SemrushBot"},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) HeadlessChrome/79.0.3945.0 Safari/537.36", "HeadlessChrome"},
+		{"my-crawler/1.0", "my-crawler"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := botName(tt.in); got != tt.want {
+				t.Errorf("\ngot:  %q\nwant: %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRobots(t *testing.T) {
+	rb, err := ParseRobots(strings.NewReader(`
+		# Comment
+		User-agent: *
+		Disallow: /private/
+		Disallow: /*.pdf$
+		Allow: /private/public
+		Crawl-delay: 10
+
+		User-agent: Googlebot
+		User-agent: bingbot
+		Disallow: /nogoogle   # Comment
+		Disallow:
+
+		User-agent: BadBot
+		Disallow: /
+
+		User-agent: GoodBot
+		Disallow:
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name, path string
+		want       bool
+	}{
+		{"SomeBot", "/", true},
+		{"SomeBot", "/robots.txt", true},
+		{"SomeBot", "/private/", false},
+		{"SomeBot", "/private/x", false},
+		{"SomeBot", "/private/public", true},
+		{"SomeBot", "/private/publicx", true},
+		{"SomeBot", "/file.pdf", false},
+		{"SomeBot", "/file.pdf?x", true},
+		{"SomeBot", "/dir/file.pdf", false},
+		{"googlebot", "/private/", true},
+		{"Googlebot", "/nogoogle", false},
+		{"bingbot", "/nogoogle/x", false},
+		{"BadBot", "/", false},
+		{"BadBot", "/robots.txt", true},
+		{"GoodBot", "/private/", true},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := rb.Allowed(tt.name, tt.path); got != tt.want {
+				t.Errorf("%s %s: got %t; want %t", tt.name, tt.path, got, tt.want)
+			}
+		})
+	}
+
+	req := func(ua, path string) *http.Request {
+		u, _ := url.Parse(path)
+		r := &http.Request{Header: make(http.Header), URL: u}
+		r.Header.Set("User-Agent", ua)
+		return r
+	}
+	var (
+		addr    = netip.MustParseAddr("192.0.2.1")
+		browser = "Mozilla/5.0 (X11; Linux x86_64; rv:75.0) Gecko/20100101 Firefox/75.0"
+		google  = "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"
+		some    = "Mozilla/5.0 (compatible; SomeBot/1.0)"
+	)
+	if got := rb.Check(req(browser, "/private/"), addr); got != NoBotNoMatch {
+		t.Errorf("browser: %s", got)
+	}
+	if got := rb.Check(req(google, "/private/"), addr); got != NoBotNoMatch {
+		t.Errorf("google: %s", got)
+	}
+	if got := rb.Check(req(google, "/nogoogle"), addr); got != BotRobots {
+		t.Errorf("google: %s", got)
+	}
+	if got := rb.Check(req(some, "/"), addr); got != NoBotNoMatch {
+		t.Errorf("some: %s", got)
+	}
+
+	now := time.Now()
+	rb.store.(*MemoryStore).now = func() time.Time { return now }
+	if got := rb.Check(req(some, "/a"), netip.MustParseAddr("192.0.2.2")); got != NoBotNoMatch {
+		t.Errorf("crawl-delay: %s", got)
+	}
+	now = now.Add(5 * time.Second)
+	if got := rb.Check(req(some, "/b"), netip.MustParseAddr("192.0.2.2")); got != BotRobots {
+		t.Errorf("crawl-delay: %s", got)
+	}
+	now = now.Add(10 * time.Second)
+	if got := rb.Check(req(some, "/c"), netip.MustParseAddr("192.0.2.2")); got != NoBotNoMatch {
+		t.Errorf("crawl-delay: %s", got)
+	}
+}
@@ -0,0 +1,121 @@
+package isbot
+
+import (
+	"cmp"
+	"hash/fnv"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Sessions detects clients that load pages, but never load any of the CSS,
+// JavaScript, images, etc. the pages reference.
+//
+// Clients are identified by their IP address and User-Agent. This needs to see
+// all requests, and not just page views.
+//
+// Browsers won't load anything if it's all cached, but people clicking around
+// a site will send a same-origin Referer; so it's only considered a bot if
+// there are no subresource loads and no same-origin Referer headers.
+//
+// The zero value is usable, and uses the defaults as listed below.
+type Sessions struct {
+	// Number of page views before a client may be considered a bot; the
+	// default is 5.
+	MinPages int
+
+	// How long to remember a client; the default is 30 minutes.
+	Expire time.Duration
+
+	// Maximum number of clients to keep track of; the clients that were seen
+	// least recently are removed if there are more. The default is 100,000.
+	// This is only used if Store is nil.
+	MaxClients int
+
+	// Store to keep state in; the default is a MemoryStore.
+	Store Store
+
+	once  sync.Once
+	store Store
+}
+
+// Check implements Checker.
+//
+// It returns BotNoAssets if this client loaded MinPages pages, without loading
+// anything else.
+func (s *Sessions) Check(r *http.Request, addr netip.Addr) Result {
+	s.once.Do(func() {
+		s.store = storeOr(s.Store, 3*cmp.Or(s.MaxClients, 100_000))
+	})
+	kind := requestKind(r)
+	if kind == reqOther || !addr.IsValid() {
+		return NoBotNoMatch
+	}
+
+	var (
+		h = fnv.New64a()
+		e = cmp.Or(s.Expire, 30*time.Minute)
+	)
+	h.Write([]byte(r.UserAgent()))
+	k := "sess:" + addr.String() + ":" + strconv.FormatUint(h.Sum64(), 36) + ":"
+
+	if kind == reqSubresource {
+		incr(s.store, k+"sub", 1, e)
+		return NoBotNoMatch
+	}
+	if ref, err := url.Parse(r.Referer()); err == nil && ref.Host != "" && ref.Host == r.Host {
+		incr(s.store, k+"ref", 1, e)
+	}
+	if incr(s.store, k+"nav", 1, e) < int64(cmp.Or(s.MinPages, 5)) {
+		return NoBotNoMatch
+	}
+	if incr(s.store, k+"sub", 0, e) == 0 && incr(s.store, k+"ref", 0, e) == 0 {
+		return BotNoAssets
+	}
+	return NoBotNoMatch
+}
+
+const (
+	reqOther       = iota // Don't know.
+	reqNavigate           // Page view.
+	reqSubresource        // CSS, JS, image, fetch(), etc.
+)
+
+// requestKind guesses what kind of request this is: from the Sec-Fetch-Dest
+// header if it's set, or from the extension, Accept header, and method if it's
+// not.
+func requestKind(r *http.Request) int {
+	switch r.Header.Get("Sec-Fetch-Dest") {
+	case "":
+	case "document", "iframe", "frame":
+		return reqNavigate
+	default:
+		return reqSubresource
+	}
+
+	switch strings.ToLower(path.Ext(r.URL.Path)) {
+	case ".css", ".js", ".mjs", ".json", ".png", ".jpg", ".jpeg", ".gif", ".webp", ".avif",
+		".svg", ".ico", ".woff", ".woff2", ".ttf", ".otf", ".mp3", ".mp4", ".webm":
+		return reqSubresource
+	}
+
+	accept := r.Header.Get("Accept")
+	switch {
+	case strings.Contains(accept, "text/html"):
+		return reqNavigate
+	case strings.HasPrefix(accept, "image/"), strings.HasPrefix(accept, "text/css"),
+		strings.HasPrefix(accept, "font/"), strings.Contains(accept, "javascript"):
+		return reqSubresource
+	// Simple clients such as curl and many scrapers send "*/*" or nothing at
+	// all; a GET for a path without extension is almost always a page.
+	case (accept == "" || accept == "*/*") && (r.Method == http.MethodGet || r.Method == http.MethodHead) &&
+		path.Ext(r.URL.Path) == "":
+		return reqNavigate
+	}
+	return reqOther
+}
@@ -0,0 +1,58 @@
+package isbot
+
+import (
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestSessions(t *testing.T) {
+	type req struct{ path, dest, accept, referer string }
+	var (
+		page    = req{"/page", "document", "", ""}
+		pageRef = req{"/page", "document", "", "http://example.com/"}
+		pageOld = req{"/page", "", "text/html,application/xhtml+xml", ""}
+		pageAny = req{"/page", "", "*/*", ""}
+		api     = req{"/api", "", "application/json", ""}
+		css     = req{"/style.css", "", "", ""}
+		img     = req{"/img", "image", "", ""}
+	)
+	tests := []struct {
+		name string
+		reqs []req
+		want Result
+	}{
+		{"bot", []req{page, page, page, page, page}, BotNoAssets},
+		{"no Sec-Fetch", []req{pageOld, pageOld, pageOld, pageOld, pageOld}, BotNoAssets},
+		{"*/*", []req{pageAny, pageAny, pageAny, pageAny, pageAny}, BotNoAssets},
+		{"*/* with css", []req{pageAny, css, pageAny, pageAny, pageAny, pageAny}, NoBotNoMatch},
+		{"api", []req{api, api, api, api, api}, NoBotNoMatch},
+		{"too few", []req{page, page, page, page}, NoBotNoMatch},
+		{"css", []req{page, css, page, page, page, page}, NoBotNoMatch},
+		{"image", []req{page, img, page, page, page, page}, NoBotNoMatch},
+		{"referer", []req{page, pageRef, page, page, page}, NoBotNoMatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Sessions{}
+			var got Result
+			for _, rr := range tt.reqs {
+				r := httptest.NewRequest("GET", "http://example.com"+rr.path, nil)
+				r.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:75.0) Gecko/20100101 Firefox/75.0")
+				if rr.dest != "" {
+					r.Header.Set("Sec-Fetch-Dest", rr.dest)
+				}
+				if rr.accept != "" {
+					r.Header.Set("Accept", rr.accept)
+				}
+				if rr.referer != "" {
+					r.Header.Set("Referer", rr.referer)
+				}
+				got = s.Check(r, netip.MustParseAddr("1.2.3.4"))
+			}
+			if got != tt.want {
+				t.Errorf("got %s; want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package isbot
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Store stores counters and sets that expire.
+//
+// This is used by Tracker, Sessions, Cluster, Subnets, Blocklist, and Robots to
+// keep state; the same Store can be shared by all of them. An implementation
+// backed by a shared database can be used by several processes to get the same
+// results everywhere; MemoryStore and FileStore can only be used by one process.
+//
+// Implementations must be safe for concurrent use. If an operation fails the
+// detectors treat it as "no data", rather than flagging the request.
+//
+// PrivacyPass and Challenge record spent tokens in the Store, and refuse the
+// token if that fails. A token can be spent again if its key is removed before
+// it expires, so the Store for these should not evict keys early; the
+// MemoryStore they create by default has NoEvict set.
+type Store interface {
+	// Incr adds n to the counter key and returns the new value. The counter is
+	// created if it doesn't exist yet, and expires ttl after it's created.
+	//
+	// If n is 0 this returns the current value, without creating the counter.
+	Incr(key string, n int64, ttl time.Duration) (int64, error)
+
+	// Add a member to the set, which expires after ttl. If the member already
+	// exists the expiry is reset. It returns the number of members in the set.
+	Add(set, member string, ttl time.Duration) (int, error)
+
+	// Has reports if member is in the set.
+	Has(set, member string) (bool, error)
+
+	// Snapshot gets all entries that haven't expired yet.
+	Snapshot() ([]Entry, error)
+}
+
+// Entry is a counter or set in a Store.
+type Entry struct {
+	Key     string
+	Expires time.Time
+	Count   int64                // For counters.
+	Members map[string]time.Time // For sets; the value is the expiry.
+}
+
+// storeOr returns s, or a new MemoryStore with room for max keys if s is nil.
+func storeOr(s Store, max int) Store {
+	if s == nil {
+		return NewMemoryStore(max)
+	}
+	return s
+}
+
+// spentStore returns s, or a new MemoryStore with NoEvict set and room for max
+// keys if s is nil.
+func spentStore(s Store, max int) Store {
+	if s == nil {
+		m := NewMemoryStore(max)
+		m.NoEvict = true
+		return m
+	}
+	return s
+}
+
+// These wrap the Store operations, returning the zero value on errors.
+func incr(s Store, key string, n int64, ttl time.Duration) int64 {
+	v, _ := s.Incr(key, n, ttl)
+	return v
+}
+func add(s Store, set, member string, ttl time.Duration) int {
+	v, _ := s.Add(set, member, ttl)
+	return v
+}
+func has(s Store, set, member string) bool {
+	v, _ := s.Has(set, member)
+	return v
+}
+
+// MemoryStore is an in-memory Store.
+//
+// It keeps at most max keys; the least recently used key is removed if there
+// are more.
+type MemoryStore struct {
+	// NoEvict refuses new keys with ErrFull when the store is full, instead of
+	// removing the least recently used key that hasn't expired yet.
+	NoEvict bool
+
+	now func() time.Time
+
+	mu    sync.Mutex
+	max   int
+	keys  map[string]*list.Element
+	lru   list.List
+	sweep time.Time // Don't look for expired keys before this.
+}
+
+// ErrFull is returned by a MemoryStore with NoEvict if there is no room for a
+// new key.
+var ErrFull = errors.New("isbot.MemoryStore: store is full")
+
+type memEntry struct {
+	key     string
+	exp     time.Time
+	n       int64
+	members map[string]time.Time
+	queue   []memMember // Members in the order they were added.
+}
+
+type memMember struct {
+	member string
+	exp    time.Time
+}
+
+// NewMemoryStore creates a new in-memory Store with room for max keys; 0 means
+// there is no limit.
+func NewMemoryStore(max int) *MemoryStore {
+	return &MemoryStore{now: time.Now, max: max, keys: make(map[string]*list.Element)}
+}
+
+// get an entry, removing it if it's expired. Must hold the lock.
+func (s *MemoryStore) get(key string, now time.Time) *memEntry {
+	el, ok := s.keys[key]
+	if !ok {
+		return nil
+	}
+	e := el.Value.(*memEntry)
+	if now.After(e.exp) {
+		s.lru.Remove(el)
+		delete(s.keys, key)
+		return nil
+	}
+	s.lru.MoveToFront(el)
+	return e
+}
+
+// create a new entry, evicting the least recently used one if needed. It
+// returns nil if the store is full and NoEvict is set. Must hold the lock.
+func (s *MemoryStore) create(key string, exp, now time.Time) *memEntry {
+	if s.NoEvict && s.max > 0 && s.lru.Len() >= s.max && !now.Before(s.sweep) {
+		s.sweep = time.Time{}
+		for el := s.lru.Back(); el != nil; {
+			e, prev := el.Value.(*memEntry), el.Prev()
+			if now.After(e.exp) {
+				s.lru.Remove(el)
+				delete(s.keys, e.key)
+			} else if s.sweep.IsZero() || e.exp.Before(s.sweep) {
+				s.sweep = e.exp
+			}
+			el = prev
+		}
+	}
+	for s.max > 0 && s.lru.Len() >= s.max {
+		if s.NoEvict {
+			return nil
+		}
+		el := s.lru.Back()
+		s.lru.Remove(el)
+		delete(s.keys, el.Value.(*memEntry).key)
+	}
+	e := &memEntry{key: key, exp: exp}
+	s.keys[key] = s.lru.PushFront(e)
+	return e
+}
+
+// Incr implements Store.
+func (s *MemoryStore) Incr(key string, n int64, ttl time.Duration) (int64, error) {
+	v, _, err := s.incr(key, n, s.now().Add(ttl))
+	return v, err
+}
+
+// incr adds n to the counter, creating it with the given expiry if it doesn't
+// exist. It returns the new value and the expiry.
+func (s *MemoryStore) incr(key string, n int64, exp time.Time) (int64, time.Time, error) {
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.get(key, now)
+	if e == nil {
+		if n == 0 {
+			return 0, time.Time{}, nil
+		}
+		if e = s.create(key, exp, now); e == nil {
+			return 0, time.Time{}, ErrFull
+		}
+	}
+	e.n += n
+	return e.n, e.exp, nil
+}
+
+// Add implements Store.
+func (s *MemoryStore) Add(set, member string, ttl time.Duration) (int, error) {
+	return s.add(set, member, s.now().Add(ttl))
+}
+
+func (s *MemoryStore) add(set, member string, exp time.Time) (int, error) {
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.get(set, now)
+	if e == nil {
+		if e = s.create(set, exp, now); e == nil {
+			return 0, ErrFull
+		}
+		e.members = make(map[string]time.Time)
+	}
+	if _, ok := e.members[member]; !ok {
+		e.queue = append(e.queue, memMember{member, exp})
+	}
+	e.members[member] = exp
+	if exp.After(e.exp) {
+		e.exp = exp
+	}
+
+	// Remove expired members from the front of the queue; members that were
+	// added again since are moved to the back.
+	for len(e.queue) > 0 && now.After(e.queue[0].exp) {
+		m := e.queue[0]
+		e.queue = e.queue[1:]
+		if mexp := e.members[m.member]; now.After(mexp) {
+			delete(e.members, m.member)
+		} else {
+			e.queue = append(e.queue, memMember{m.member, mexp})
+		}
+	}
+	return len(e.members), nil
+}
+
+// Has implements Store.
+func (s *MemoryStore) Has(set, member string) (bool, error) {
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e := s.get(set, now)
+	if e == nil {
+		return false, nil
+	}
+	exp, ok := e.members[member]
+	return ok && !now.After(exp), nil
+}
+
+// Snapshot implements Store.
+func (s *MemoryStore) Snapshot() ([]Entry, error) {
+	now := s.now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := make([]Entry, 0, s.lru.Len())
+	for el := s.lru.Back(); el != nil; el = el.Prev() {
+		e := el.Value.(*memEntry)
+		if now.After(e.exp) {
+			continue
+		}
+		entry := Entry{Key: e.key, Expires: e.exp, Count: e.n}
+		if e.members != nil {
+			entry.Members = make(map[string]time.Time, len(e.members))
+			for m, exp := range e.members {
+				if !now.After(exp) {
+					entry.Members[m] = exp
+				}
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,186 @@
+package isbot
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fixedStore returns a MemoryStore without a size limit, with a clock that
+// returns *now.
+func fixedStore(now *time.Time) *MemoryStore {
+	ms := NewMemoryStore(0)
+	ms.now = func() time.Time { return *now }
+	return ms
+}
+
+func TestMemoryStore(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	s := NewMemoryStore(2)
+	s.now = func() time.Time { return now }
+
+	if n, _ := s.Incr("a", 0, time.Minute); n != 0 {
+		t.Errorf("incr 0: %d", n)
+	}
+	s.Incr("a", 2, time.Minute)
+	if n, _ := s.Incr("a", 3, time.Hour); n != 5 {
+		t.Errorf("incr: %d", n)
+	}
+	if n, _ := s.Add("s", "x", time.Minute); n != 1 {
+		t.Errorf("add: %d", n)
+	}
+	if n, _ := s.Add("s", "y", 2*time.Minute); n != 2 {
+		t.Errorf("add: %d", n)
+	}
+
+	now = now.Add(90 * time.Second)
+	if n, _ := s.Incr("a", 0, time.Minute); n != 0 {
+		t.Errorf("expired: %d", n)
+	}
+	if ok, _ := s.Has("s", "x"); ok {
+		t.Error("x not expired")
+	}
+	if ok, _ := s.Has("s", "y"); !ok {
+		t.Error("y expired")
+	}
+
+	// Evicts the least recently used key.
+	s.Incr("b", 1, time.Minute)
+	s.Incr("c", 1, time.Minute)
+	if ok, _ := s.Has("s", "y"); ok {
+		t.Error("s not evicted")
+	}
+	if e, _ := s.Snapshot(); len(e) != 2 || e[0].Key != "b" || e[1].Key != "c" {
+		t.Errorf("snapshot: %v", e)
+	}
+}
+
+func TestMemoryStoreNoEvict(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	s := NewMemoryStore(2)
+	s.now, s.NoEvict = func() time.Time { return now }, true
+
+	s.Incr("a", 1, time.Minute)
+	s.Add("s", "x", time.Hour)
+	if _, err := s.Incr("b", 1, time.Minute); err != ErrFull {
+		t.Errorf("incr: %v", err)
+	}
+	if _, err := s.Add("t", "x", time.Minute); err != ErrFull {
+		t.Errorf("add: %v", err)
+	}
+	if n, _ := s.Incr("a", 1, time.Minute); n != 2 {
+		t.Errorf("existing key: %d", n)
+	}
+
+	// Expired keys make room.
+	now = now.Add(90 * time.Second)
+	if n, err := s.Incr("b", 1, time.Minute); err != nil || n != 1 {
+		t.Errorf("after expiry: %d, %v", n, err)
+	}
+	if ok, _ := s.Has("s", "x"); !ok {
+		t.Error("s evicted")
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store")
+	s, err := OpenFileStore(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Incr("count", 2, time.Hour)
+	s.Incr("count", 3, time.Hour)
+	s.Incr("expired", 1, -time.Second)
+	s.Add("set \"x\"", "a b", time.Hour)
+	s.Add("set \"x\"", "c", time.Hour)
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Incr("count", 1, time.Hour); err == nil {
+		t.Error("no error after Close()")
+	}
+
+	// Incomplete last line.
+	fp, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	fp.WriteString(`+ 1 1 "cou`)
+	fp.Close()
+
+	s, err = OpenFileStore(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if n, _ := s.Incr("count", 0, time.Hour); n != 5 {
+		t.Errorf("count: %d", n)
+	}
+	if n, _ := s.Add("set \"x\"", "c", time.Hour); n != 2 {
+		t.Errorf("set: %d", n)
+	}
+	if ok, _ := s.Has("set \"x\"", "a b"); !ok {
+		t.Error("set: no member")
+	}
+	if e, _ := s.Snapshot(); len(e) != 2 {
+		t.Errorf("snapshot: %v", e)
+	}
+
+	os.WriteFile(path, []byte("x\n"), 0o644)
+	if _, err := OpenFileStore(path, 0); err == nil {
+		t.Error("no error for invalid file")
+	}
+}
+
+func TestFileStoreCompact(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store")
+	s, err := OpenFileStore(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	lines := func() int {
+		t.Helper()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return bytes.Count(data, []byte("\n"))
+	}
+
+	// Buffered until Flush().
+	s.Incr("count", 1, time.Hour)
+	if n := lines(); n != 0 {
+		t.Errorf("%d lines before Flush()", n)
+	}
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if n := lines(); n != 1 {
+		t.Errorf("%d lines after Flush()", n)
+	}
+
+	// Compacted automatically.
+	for range compactMin * 3 {
+		if _, err := s.Incr("count", 1, time.Hour); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if n := lines(); n >= compactMin {
+		t.Errorf("not compacted: %d lines", n)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = OpenFileStore(path, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := s.Incr("count", 0, time.Hour); n != compactMin*3+1 {
+		t.Errorf("count: %d", n)
+	}
+}
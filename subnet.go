@@ -0,0 +1,110 @@
+package isbot
+
+import (
+	"cmp"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Subnets detects bursts of requests from many addresses in the same subnet,
+// such as crawlers on hosting providers that aren't in the IP ranges from
+// IPRange().
+//
+// Subnets that send too many requests or have too many different clients are
+// flagged for Expire. Subnets also implements Ranges, so it can be added to
+// Detector.Ranges to flag all requests from these subnets.
+//
+// The zero value is usable, and uses the defaults as listed below.
+type Subnets struct {
+	// Prefix length for IPv4 and IPv6 subnets; the defaults are 24 and 48.
+	Bits4, Bits6 int
+
+	// Time window for MaxRate and MaxClients; the default is 1 minute.
+	Window time.Duration
+
+	// Maximum number of requests from a subnet in Window; the default is 300.
+	MaxRate int
+
+	// Maximum number of different clients from a subnet in Window; the default
+	// is 50. Note that mobile networks may have many clients in the same
+	// subnet.
+	MaxClients int
+
+	// How long to flag a subnet for; the default is 1 hour.
+	Expire time.Duration
+
+	// Maximum number of subnets to keep track of; the subnets that were seen
+	// least recently are removed if there are more. The default is 100,000.
+	// This is only used if Store is nil.
+	MaxSubnets int
+
+	// Store to keep state in; the default is a MemoryStore.
+	Store Store
+
+	once  sync.Once
+	store Store
+	now   func() time.Time
+}
+
+// Check implements Checker.
+func (s *Subnets) Check(_ *http.Request, addr netip.Addr) Result {
+	return s.Track(addr)
+}
+
+// Track a request from this address.
+//
+// This returns BotSubnet if the subnet for this address is flagged, or
+// NoBotNoMatch if it's not.
+func (s *Subnets) Track(addr netip.Addr) Result {
+	p, ok := s.subnet(addr)
+	if !ok {
+		return NoBotNoMatch
+	}
+
+	var (
+		window = cmp.Or(s.Window, time.Minute)
+		k      = p.String()
+		kw     = k + ":" + strconv.FormatInt(s.now().UnixNano()/int64(window), 36)
+	)
+	n := incr(s.store, "subnet:n:"+kw, 1, window)
+	clients := add(s.store, "subnet:clients:"+kw, clientKey(addr), window)
+	if n > int64(cmp.Or(s.MaxRate, 300)) || clients > cmp.Or(s.MaxClients, 50) {
+		add(s.store, "subnet:flagged", k, cmp.Or(s.Expire, time.Hour))
+		return BotSubnet
+	}
+	if has(s.store, "subnet:flagged", k) {
+		return BotSubnet
+	}
+	return NoBotNoMatch
+}
+
+// Lookup implements Ranges.
+func (s *Subnets) Lookup(addr netip.Addr) (Range, bool) {
+	p, ok := s.subnet(addr)
+	if !ok || !has(s.store, "subnet:flagged", p.String()) {
+		return Range{}, false
+	}
+	return Range{Prefix: p, Result: BotSubnet, Name: "Subnets"}, true
+}
+
+func (s *Subnets) subnet(addr netip.Addr) (netip.Prefix, bool) {
+	s.once.Do(func() {
+		s.store = storeOr(s.Store, 3*cmp.Or(s.MaxSubnets, 100_000))
+		if s.now == nil {
+			s.now = time.Now
+		}
+	})
+	addr = addr.Unmap()
+	if !addr.IsValid() {
+		return netip.Prefix{}, false
+	}
+	bits := cmp.Or(s.Bits6, 48)
+	if addr.Is4() {
+		bits = cmp.Or(s.Bits4, 24)
+	}
+	p, err := addr.Prefix(bits)
+	return p, err == nil
+}
@@ -0,0 +1,91 @@
+package isbot
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestSubnets(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	clock := func(s *Subnets) *Subnets {
+		ms := fixedStore(&now)
+		s.Store, s.now = ms, ms.now
+		return s
+	}
+
+	t.Run("clients", func(t *testing.T) {
+		s := clock(&Subnets{})
+		for i := range 50 {
+			if got := s.Track(netip.MustParseAddr(fmt.Sprintf("192.0.2.%d", i))); got != NoBotNoMatch {
+				t.Fatalf("%d: %s", i, got)
+			}
+		}
+		if got := s.Track(netip.MustParseAddr("192.0.2.100")); got != BotSubnet {
+			t.Fatalf("got %s", got)
+		}
+		if got := s.Track(netip.MustParseAddr("192.0.2.1")); got != BotSubnet {
+			t.Fatalf("got %s", got)
+		}
+		if got := s.Track(netip.MustParseAddr("192.0.3.1")); got != NoBotNoMatch {
+			t.Fatalf("got %s", got)
+		}
+
+		rng, ok := s.Lookup(netip.MustParseAddr("192.0.2.200"))
+		if !ok || rng.Prefix != netip.MustParsePrefix("192.0.2.0/24") || rng.Result != BotSubnet {
+			t.Errorf("Lookup: %v %v", rng, ok)
+		}
+		if _, ok := s.Lookup(netip.MustParseAddr("192.0.3.1")); ok {
+			t.Error("Lookup: flagged 192.0.3.1")
+		}
+
+		d := &Detector{Ranges: []Ranges{s}}
+		r := &http.Request{Header: make(http.Header), RemoteAddr: "192.0.2.222:4242"}
+		r.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:75.0) Gecko/20100101 Firefox/75.0")
+		if got := d.Bot(r); got != BotSubnet {
+			t.Errorf("Detector: %s", got)
+		}
+	})
+
+	t.Run("rate", func(t *testing.T) {
+		s := clock(&Subnets{Bits6: 56, MaxRate: 10})
+		var got Result
+		for i := range 11 {
+			got = s.Track(netip.MustParseAddr(fmt.Sprintf("2001:db8:0:%x::1", i%3)))
+		}
+		if got != BotSubnet {
+			t.Fatalf("got %s", got)
+		}
+		if _, ok := s.Lookup(netip.MustParseAddr("2001:db8:0:ff::1")); !ok {
+			t.Error("not in /56")
+		}
+		if _, ok := s.Lookup(netip.MustParseAddr("2001:db8:0:100::1")); ok {
+			t.Error("in /56")
+		}
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		now = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		s := clock(&Subnets{MaxRate: 5})
+		for range 5 {
+			now = now.Add(10 * time.Second)
+			s.Track(netip.MustParseAddr("192.0.2.1"))
+		}
+		now = now.Add(time.Minute) // New window.
+		if got := s.Track(netip.MustParseAddr("192.0.2.1")); got != NoBotNoMatch {
+			t.Fatalf("got %s", got)
+		}
+		for range 5 {
+			s.Track(netip.MustParseAddr("192.0.2.1"))
+		}
+		if _, ok := s.Lookup(netip.MustParseAddr("192.0.2.1")); !ok {
+			t.Fatal("not flagged")
+		}
+		now = now.Add(time.Hour + time.Second)
+		if _, ok := s.Lookup(netip.MustParseAddr("192.0.2.1")); ok {
+			t.Fatal("still flagged after Expire")
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package isbot
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// signToken creates a token with data, which is valid until exp.
+//
+// The purpose is included in the signature, so that a token created for one
+// purpose can't be used for another.
+func signToken(key []byte, purpose string, exp time.Time, data string) string {
+	p := strconv.FormatInt(exp.Unix(), 36) + "." + base64.RawURLEncoding.EncodeToString([]byte(data))
+	return p + "." + base64.RawURLEncoding.EncodeToString(tokenMAC(key, purpose, p))
+}
+
+// verifyToken verifies a token created with signToken(), returning the data.
+func verifyToken(key []byte, purpose, tok string, now time.Time) (string, bool) {
+	i := strings.LastIndexByte(tok, '.')
+	if i == -1 {
+		return "", false
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(tok[i+1:])
+	if err != nil || !hmac.Equal(mac, tokenMAC(key, purpose, tok[:i])) {
+		return "", false
+	}
+
+	exp, data, _ := strings.Cut(tok[:i], ".")
+	e, err := strconv.ParseInt(exp, 36, 64)
+	if err != nil || now.Unix() > e {
+		return "", false
+	}
+	d, err := base64.RawURLEncoding.DecodeString(data)
+	if err != nil {
+		return "", false
+	}
+	return string(d), true
+}
+
+func tokenMAC(key []byte, purpose, payload string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(purpose))
+	h.Write([]byte{0})
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
+
+// clientBinding gets a value to bind a token to a client: the /24 (IPv4) or
+// /64 (IPv6) prefix of the address, and the User-Agent.
+func clientBinding(addr netip.Addr, ua string) string {
+	addr = addr.Unmap()
+	bits := 64
+	if addr.Is4() {
+		bits = 24
+	}
+	p, _ := addr.Prefix(bits)
+	h := sha256.Sum256([]byte(p.String() + "\x00" + ua))
+	return base64.RawURLEncoding.EncodeToString(h[:12])
+}
@@ -0,0 +1,142 @@
+package isbot
+
+import (
+	"cmp"
+	"math"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Tracker tracks the request rate per client.
+//
+// Clients are identified by their IP address, or the /64 prefix for IPv6
+// addresses, as that's what people usually get from their ISP.
+//
+// This is best used for page views only, rather than for all requests: browsers
+// load images, CSS, and the like in bursts.
+//
+// The zero value is usable, and uses the defaults as listed below.
+type Tracker struct {
+	// Time window for MaxRate and regular intervals; the default is 1 minute.
+	Window time.Duration
+
+	// Maximum number of requests in Window; the default is 60.
+	MaxRate int
+
+	// Time window for MaxBurst; the default is 1 second.
+	Burst time.Duration
+
+	// Maximum number of requests in Burst; the default is 10.
+	MaxBurst int
+
+	// Minimum number of requests in Window before checking if requests are
+	// sent at regular intervals; the default is 10.
+	MinRegular int
+
+	// Maximum number of clients to keep track of; the clients that were seen
+	// least recently are removed if there are more. The default is 100,000.
+	// This is only used if Store is nil.
+	MaxClients int
+
+	// Store to keep state in; the default is a MemoryStore.
+	Store Store
+
+	once  sync.Once
+	store Store
+	now   func() time.Time
+}
+
+// Check implements Checker.
+func (t *Tracker) Check(_ *http.Request, addr netip.Addr) Result {
+	return t.Track(addr)
+}
+
+// Track a request from this address.
+//
+// This returns BotRate, BotBurst, or BotRegular if the client sends too many
+// requests, or sends them at inhumanly regular intervals. It returns
+// NoBotNoMatch otherwise.
+func (t *Tracker) Track(addr netip.Addr) Result {
+	t.once.Do(func() {
+		t.store = storeOr(t.Store, 8*cmp.Or(t.MaxClients, 100_000))
+		if t.now == nil {
+			t.now = time.Now
+		}
+	})
+	if !addr.IsValid() {
+		return NoBotNoMatch
+	}
+
+	var (
+		k      = clientKey(addr) + ":"
+		now    = t.now()
+		window = cmp.Or(t.Window, time.Minute)
+		burst  = cmp.Or(t.Burst, time.Second)
+		cur    = now.UnixNano() / int64(window)
+	)
+
+	// Approximate a sliding window from the counts of the current and
+	// previous windows.
+	n := incr(t.store, "rate:"+k+strconv.FormatInt(cur, 36), 1, 2*window)
+	prev := incr(t.store, "rate:"+k+strconv.FormatInt(cur-1, 36), 0, 2*window)
+	elapsed := float64(now.UnixNano()%int64(window)) / float64(window)
+	if float64(n)+float64(prev)*(1-elapsed) > float64(cmp.Or(t.MaxRate, 60)) {
+		return BotRate
+	}
+
+	b := incr(t.store, "burst:"+k+strconv.FormatInt(now.UnixNano()/int64(burst), 36), 1, burst)
+	if b > int64(cmp.Or(t.MaxBurst, 10)) {
+		return BotBurst
+	}
+
+	if t.regular(k+strconv.FormatInt(cur, 36), now.Sub(time.Unix(0, cur*int64(window))), window) {
+		return BotRegular
+	}
+	return NoBotNoMatch
+}
+
+// regular reports if the requests in this window are at regular intervals.
+//
+// This fits a line through the request times (t = a + b·i for the i-th
+// request), and considers it regular if the times deviate very little from
+// that line compared to the interval b. This only needs sums, which can be
+// updated atomically with Store.Incr().
+func (t *Tracker) regular(k string, since, window time.Duration) bool {
+	var (
+		ms  = since.Milliseconds()
+		i   = incr(t.store, "reg:n:"+k, 1, window)
+		st  = float64(incr(t.store, "reg:t:"+k, ms, window))
+		stt = float64(incr(t.store, "reg:tt:"+k, ms*ms, window))
+		sit = float64(incr(t.store, "reg:it:"+k, i*ms, window))
+	)
+	if i < int64(cmp.Or(t.MinRegular, 10)) {
+		return false
+	}
+
+	var (
+		n   = float64(i)
+		si  = n * (n + 1) / 2
+		sii = n * (n + 1) * (2*n + 1) / 6
+		b   = (n*sit - si*st) / (n*sii - si*si)
+		a   = (st - b*si) / n
+		res = stt - a*st - b*sit // Sum of squared residuals.
+	)
+	if b <= 0 {
+		return false
+	}
+	return math.Sqrt(max(0, res)/(n-2)) < b/10
+}
+
+// clientKey gets the key for this client: the address for IPv4, or the /64
+// prefix for IPv6.
+func clientKey(addr netip.Addr) string {
+	addr = addr.Unmap()
+	if addr.Is4() {
+		return addr.String()
+	}
+	p, _ := addr.Prefix(64)
+	return p.String()
+}
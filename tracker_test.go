@@ -0,0 +1,86 @@
+package isbot
+
+import (
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestTracker(t *testing.T) {
+	track := func(tr *Tracker, addr string, reqs int, interval func(int) time.Duration) Result {
+		t.Helper()
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		ms := fixedStore(&now)
+		tr.Store, tr.now = ms, ms.now
+
+		var res Result
+		for i := range reqs {
+			res = tr.Track(netip.MustParseAddr(addr))
+			if Is(res) {
+				return res
+			}
+			now = now.Add(interval(i))
+		}
+		return res
+	}
+	every := func(d time.Duration) func(int) time.Duration {
+		return func(int) time.Duration { return d }
+	}
+	// Somewhat random, but deterministic.
+	human := func(i int) time.Duration {
+		return time.Duration(1000+(i*7919)%9000) * time.Millisecond
+	}
+
+	tests := []struct {
+		name     string
+		tracker  *Tracker
+		addr     string
+		reqs     int
+		interval func(int) time.Duration
+		want     Result
+	}{
+		{"human", &Tracker{}, "1.2.3.4", 100, human, NoBotNoMatch},
+		{"rate", &Tracker{MinRegular: 1000}, "1.2.3.4", 100, every(200 * time.Millisecond), BotRate},
+		{"burst", &Tracker{MinRegular: 1000}, "1.2.3.4", 20, every(10 * time.Millisecond), BotBurst},
+		{"regular", &Tracker{}, "1.2.3.4", 20, every(2 * time.Second), BotRegular},
+		{"regular jitter", &Tracker{}, "1.2.3.4", 20, func(i int) time.Duration {
+			return 2*time.Second + time.Duration(i%3)*10*time.Millisecond
+		}, BotRegular},
+		{"ipv6", &Tracker{MinRegular: 1000}, "2001:db8::1", 100, every(200 * time.Millisecond), BotRate},
+		{"max rate", &Tracker{MaxRate: 200, MinRegular: 1000}, "1.2.3.4", 100, every(200 * time.Millisecond), NoBotNoMatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := track(tt.tracker, tt.addr, tt.reqs, tt.interval)
+			if got != tt.want {
+				t.Errorf("got %s; want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrackerIPv6(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	ms := fixedStore(&now)
+	tr := &Tracker{MaxBurst: 5, Store: ms, now: ms.now}
+
+	// All addresses in a /64 are the same client.
+	for i := range 10 {
+		got := tr.Track(netip.MustParseAddr("2001:db8::" + strconv.Itoa(i+1)))
+		want := Result(NoBotNoMatch)
+		if i >= 5 {
+			want = BotBurst
+		}
+		if got != want {
+			t.Fatalf("%d: got %s; want %s", i, got, want)
+		}
+	}
+
+	// But a different /64 isn't.
+	for i := range 5 {
+		if got := tr.Track(netip.MustParseAddr("2001:db8:0:1::" + strconv.Itoa(i+1))); got != NoBotNoMatch {
+			t.Fatalf("%d: got %s; want %s", i, got, Result(NoBotNoMatch))
+		}
+	}
+}
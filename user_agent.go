@@ -5,7 +5,11 @@ import "strings"
 // UserAgent checks if this User-Agent header looks like a bot.
 //
 // It returns one of the constants as the reason we think this is a bot.
-func UserAgent(ua string) Result {
+func UserAgent(ua string) Result { return ActiveDB().UserAgent(ua) }
+
+// UserAgent checks if this User-Agent header looks like a bot, using the lists
+// from this DB.
+func (d *DB) UserAgent(ua string) Result {
 	// TODO: it's not uncommon to not have a User-Agent at all ... not sure what
 	// we want to do with that; a quick looks reveals they *may* be regular
 	// users who cleared it? Not sure...
@@ -21,8 +25,8 @@ func UserAgent(ua string) Result {
 	// 	return BotBoty
 	// }
 
-	for i := range knownBrowsers {
-		if strings.Contains(ua, knownBrowsers[i]) {
+	for i := range d.browsers {
+		if strings.Contains(ua, d.browsers[i]) {
 			return NoBotKnown
 		}
 	}
@@ -32,14 +36,14 @@ func UserAgent(ua string) Result {
 		return BotLink
 	}
 
-	for i := range clientLibraries {
-		if strings.Contains(ua, clientLibraries[i]) {
+	for i := range d.clients {
+		if strings.Contains(ua, d.clients[i]) {
 			return BotClientLibrary
 		}
 	}
 
-	for i := range knownBots {
-		if strings.Contains(ua, knownBots[i]) {
+	for i := range d.bots {
+		if strings.Contains(ua, d.bots[i]) {
 			return BotKnownBot
 		}
 	}
@@ -56,6 +60,35 @@ func UserAgent(ua string) Result {
 	return NoBotNoMatch
 }
 
+// botName gets the name of a bot from the User-Agent, such as "Googlebot" for
+// "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)".
+//
+// It returns an empty string if this doesn't look like a bot that identifies
+// itself.
+func botName(ua string) string {
+	bots := ActiveDB().bots
+	for i := range bots {
+		if strings.Contains(ua, bots[i]) {
+			return strings.TrimRight(bots[i], "/ ")
+		}
+	}
+
+	for f := range strings.FieldsFuncSeq(ua, func(r rune) bool {
+		return r == ' ' || r == ';' || r == '(' || r == ')' || r == ','
+	}) {
+		if f[0] == '+' || strings.Contains(f, ":") {
+			continue
+		}
+		name, _, _ := strings.Cut(f, "/")
+		name = strings.TrimRight(name, "+-_.")
+		l := strings.ToLower(name)
+		if strings.Contains(l, "bot") || strings.Contains(l, "crawler") || strings.Contains(l, "spider") {
+			return name
+		}
+	}
+	return ""
+}
+
 var clientLibraries = []string{
 	"Go-http-client/",
 	"HttpClient/",